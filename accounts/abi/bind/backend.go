@@ -124,3 +124,27 @@ type ContractBackend interface {
 	ContractTransactor
 	ContractFilterer
 }
+
+// SuaveBackend defines the methods needed to send the confidential compute requests
+// of the SUAVE bindings to a kettle.
+type SuaveBackend interface {
+	// SendConfidentialRequest sends a confidential compute request calling the
+	// contract with the given input data and confidential inputs.
+	SendConfidentialRequest(ctx context.Context, contract common.Address, data []byte, confidentialInputs []byte) (SuaveTransaction, error)
+
+	// DeployContract sends a transaction creating a contract with the given code.
+	DeployContract(ctx context.Context, code []byte) (SuaveTransaction, error)
+}
+
+// SuaveTransaction is a transaction sent through a SuaveBackend.
+type SuaveTransaction interface {
+	// Hash returns the hash of the transaction.
+	Hash() common.Hash
+
+	// Wait waits for the transaction to be included and returns its receipt.
+	Wait() (*types.Receipt, error)
+
+	// ComputeResult waits for the SUAVE transaction of a confidential compute request
+	// and returns its confidential compute result.
+	ComputeResult() ([]byte, error)
+}
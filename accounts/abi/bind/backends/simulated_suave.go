@@ -0,0 +1,266 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backends
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	suavebackends "github.com/ethereum/go-ethereum/suave/backends"
+	suave "github.com/ethereum/go-ethereum/suave/core"
+	"github.com/ethereum/go-ethereum/suave/cstore"
+)
+
+// This nil assignment ensures at compile time that SimulatedSuaveBackend implements bind.SuaveBackend.
+var _ bind.SuaveBackend = (*SimulatedSuaveBackend)(nil)
+
+// SimulatedSuaveBackend implements bind.SuaveBackend on a simulated blockchain. The
+// requests are run by a local kettle, with an in-memory confidential store and a
+// mocked eth backend, and its SUAVE transactions are committed to the simulated
+// blockchain right away, each in its own block.
+type SimulatedSuaveBackend struct {
+	*SimulatedBackend
+
+	key       *ecdsa.PrivateKey // Key of the account sending the transactions
+	kettleKey *ecdsa.PrivateKey // Key of the local kettle signing the SUAVE transactions
+	engine    *cstore.CStoreEngine
+}
+
+// NewSimulatedSuaveBackend creates a SUAVE binding backend on the simulated
+// blockchain, sending the transactions from the account of the key.
+func NewSimulatedSuaveBackend(backend *SimulatedBackend, key *ecdsa.PrivateKey) (*SimulatedSuaveBackend, error) {
+	kettleKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	engine := cstore.NewEngine(cstore.NewLocalConfidentialStore(), &cstore.MockTransport{}, cstore.MockSigner{}, types.NewSuaveSigner(backend.config.ChainID))
+	if err := engine.Start(); err != nil {
+		return nil, err
+	}
+	return &SimulatedSuaveBackend{
+		SimulatedBackend: backend,
+		key:              key,
+		kettleKey:        kettleKey,
+		engine:           engine,
+	}, nil
+}
+
+// Close stops the confidential store of the kettle, leaving the simulated
+// blockchain running.
+func (b *SimulatedSuaveBackend) Close() error {
+	return b.engine.Stop()
+}
+
+// KettleAddress returns the address of the local kettle.
+func (b *SimulatedSuaveBackend) KettleAddress() common.Address {
+	return crypto.PubkeyToAddress(b.kettleKey.PublicKey)
+}
+
+// SendConfidentialRequest runs a confidential compute request calling the contract
+// on the local kettle, and commits its SUAVE transaction to the simulated blockchain.
+func (b *SimulatedSuaveBackend) SendConfidentialRequest(ctx context.Context, contract common.Address, data []byte, confidentialInputs []byte) (bind.SuaveTransaction, error) {
+	nonce, gasPrice, err := b.nextTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signer := types.NewSuaveSigner(b.config.ChainID)
+	request, err := types.SignTx(types.NewTx(&types.ConfidentialComputeRequest{
+		ConfidentialComputeRecord: types.ConfidentialComputeRecord{
+			KettleAddress: b.KettleAddress(),
+			Nonce:         nonce,
+			To:            &contract,
+			GasPrice:      gasPrice,
+			Gas:           b.Blockchain().CurrentBlock().GasLimit,
+			Data:          data,
+		},
+		ConfidentialInputs: confidentialInputs,
+	}), signer, b.key)
+	if err != nil {
+		return nil, err
+	}
+	result, finalize, err := b.runConfidentialRequest(request, signer)
+	if err != nil {
+		return nil, err
+	}
+	ccr, _ := types.CastTxInner[*types.ConfidentialComputeRequest](request)
+	tx, err := types.SignTx(types.NewTx(&types.SuaveTransaction{
+		ConfidentialComputeRequest: ccr.ConfidentialComputeRecord,
+		ConfidentialComputeResult:  result,
+	}), signer, b.kettleKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.commitTransaction(ctx, tx); err != nil {
+		return nil, err
+	}
+	if err := finalize(); err != nil {
+		return nil, fmt.Errorf("failed to finalize the confidential store: %w", err)
+	}
+	return &simulatedSuaveTransaction{backend: b, tx: tx}, nil
+}
+
+// DeployContract commits a transaction creating a contract with the code to the
+// simulated blockchain.
+func (b *SimulatedSuaveBackend) DeployContract(ctx context.Context, code []byte) (bind.SuaveTransaction, error) {
+	nonce, gasPrice, err := b.nextTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gas, err := b.EstimateGas(ctx, ethereum.CallMsg{
+		From:     crypto.PubkeyToAddress(b.key.PublicKey),
+		GasPrice: gasPrice,
+		Data:     code,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tx, err := types.SignTx(types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      gas,
+		Data:     code,
+	}), types.NewSuaveSigner(b.config.ChainID), b.key)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.commitTransaction(ctx, tx); err != nil {
+		return nil, err
+	}
+	return &simulatedSuaveTransaction{backend: b, tx: tx}, nil
+}
+
+// nextTransaction returns the nonce and gas price of the next transaction sent
+// from the account of the backend.
+func (b *SimulatedSuaveBackend) nextTransaction(ctx context.Context) (uint64, *big.Int, error) {
+	nonce, err := b.PendingNonceAt(ctx, crypto.PubkeyToAddress(b.key.PublicKey))
+	if err != nil {
+		return 0, nil, err
+	}
+	gasPrice, err := b.SuggestGasPrice(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	return nonce, gasPrice, nil
+}
+
+// commitTransaction sends the transaction and commits it in a new block.
+func (b *SimulatedSuaveBackend) commitTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	b.Commit()
+	return nil
+}
+
+// runConfidentialRequest executes the confidential compute request on the pending
+// state as a kettle does, and returns its confidential compute result along with
+// the function finalizing its confidential store writes.
+func (b *SimulatedSuaveBackend) runConfidentialRequest(request *types.Transaction, signer types.Signer) ([]byte, func() error, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ccr, _ := types.CastTxInner[*types.ConfidentialComputeRequest](request)
+	header := b.pendingBlock.Header()
+	msg, err := core.TransactionToMessage(request, signer, header.BaseFee)
+	if err != nil {
+		return nil, nil, err
+	}
+	msg.SkipAccountChecks = true
+
+	stateDB := b.pendingState.Copy()
+	stateDB.SetTxContext(common.Hash{}, 0)
+
+	store := b.engine.NewTransactionalStore(request)
+	suaveCtx := vm.SuaveContext{
+		Backend: &vm.SuaveExecutionBackend{
+			EthBundleSigningKey:    b.kettleKey,
+			ConfidentialStore:      store,
+			ConfidentialEthBackend: &suavebackends.EthMock{},
+		},
+		Context: map[string][]byte{
+			"confidentialInputs": ccr.ConfidentialInputs,
+			"kettleAddress":      b.KettleAddress().Bytes(),
+		},
+		CallerStack: []*common.Address{},
+	}
+	evmContext := core.NewEVMBlockContext(header, b.blockchain, nil)
+	evm := vm.NewConfidentialEVM(suaveCtx, evmContext, core.NewEVMTxContext(msg), stateDB, b.config, vm.Config{IsConfidential: true})
+
+	result, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(math.MaxUint64))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(result.Revert()) > 0 {
+		return nil, nil, newRevertError(result)
+	}
+	if result.Failed() {
+		return nil, nil, result.Err
+	}
+
+	// The compute result is the calldata of the callback, returned as bytes
+	computeResult := result.ReturnData
+	args := abi.Arguments{abi.Argument{Type: abi.Type{T: abi.BytesTy}}}
+	if unpacked, err := args.Unpack(result.ReturnData); err == nil && len(unpacked[0].([]byte))%32 == 4 {
+		computeResult = unpacked[0].([]byte)
+	}
+	if logs := stateDB.GetLogs(common.Hash{}, 0, common.Hash{}); len(logs) != 0 {
+		encoded, err := (&suave.ExecResult{Logs: logs}).EncodeABI()
+		if err != nil {
+			return nil, nil, err
+		}
+		computeResult = append(append(computeResult, suave.ExecResultMagic...), encoded...)
+	}
+	return computeResult, store.Finalize, nil
+}
+
+// simulatedSuaveTransaction is a transaction committed by the SimulatedSuaveBackend.
+type simulatedSuaveTransaction struct {
+	backend *SimulatedSuaveBackend
+	tx      *types.Transaction
+}
+
+// Hash returns the hash of the transaction.
+func (t *simulatedSuaveTransaction) Hash() common.Hash {
+	return t.tx.Hash()
+}
+
+// Wait returns the receipt of the transaction, committed as it was sent.
+func (t *simulatedSuaveTransaction) Wait() (*types.Receipt, error) {
+	return bind.WaitMined(context.Background(), t.backend, t.tx)
+}
+
+// ComputeResult returns the confidential compute result of the SUAVE transaction,
+// without the logs emitted while computing it.
+func (t *simulatedSuaveTransaction) ComputeResult() ([]byte, error) {
+	if t.tx.Type() != types.SuaveTxType {
+		return nil, errors.New("not a confidential compute request")
+	}
+	result, _ := suave.SplitComputeResult(t.tx.Data())
+	return result, nil
+}
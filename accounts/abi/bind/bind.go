@@ -82,6 +82,22 @@ func isKeyWord(arg string) bool {
 // enforces compile time type safety and naming convention opposed to having to
 // manually maintain hard coded strings that break on runtime.
 func Bind(types []string, abis []string, bytecodes []string, fsigs []map[string]string, pkg string, lang Lang, libs map[string]string, aliases map[string]string) (string, error) {
+	return bind(types, abis, bytecodes, fsigs, pkg, lang, libs, aliases, false, nil)
+}
+
+// BindSuave generates a Go wrapper around a SUAVE contract ABI. On top of the
+// regular bindings, which run against the simulated backend in tests, every
+// contract gets a Suave binding that sends its methods as confidential compute
+// requests to a kettle and decodes the results.
+//
+// The confidential map optionally assigns typed confidential inputs to methods,
+// mapping the method name to the list of solidity types the contract decodes
+// its confidential inputs into.
+func BindSuave(types []string, abis []string, bytecodes []string, fsigs []map[string]string, pkg string, libs map[string]string, aliases map[string]string, confidential map[string][]string) (string, error) {
+	return bind(types, abis, bytecodes, fsigs, pkg, LangGo, libs, aliases, true, confidential)
+}
+
+func bind(types []string, abis []string, bytecodes []string, fsigs []map[string]string, pkg string, lang Lang, libs map[string]string, aliases map[string]string, suave bool, confidential map[string][]string) (string, error) {
 	var (
 		// contracts is the map of each individual contract requested binding
 		contracts = make(map[string]*tmplContract)
@@ -91,6 +107,9 @@ func Bind(types []string, abis []string, bytecodes []string, fsigs []map[string]
 
 		// isLib is the map used to flag each encountered library as such
 		isLib = make(map[string]struct{})
+
+		// confidentialUsed tracks the typed confidential inputs matched to a method
+		confidentialUsed = make(map[string]bool)
 	)
 	for i := 0; i < len(types); i++ {
 		// Parse the actual ABI to generate the binding for
@@ -172,11 +191,23 @@ func Bind(types []string, abis []string, bytecodes []string, fsigs []map[string]
 					bindStructType[lang](output.Type, structs)
 				}
 			}
+			// Resolve the typed confidential inputs of the method, if any
+			var confidentialInputs abi.Arguments
+			if kinds, ok := confidential[original.Name]; ok && !original.IsConstant() {
+				for j, kind := range kinds {
+					typ, err := abi.NewType(kind, "", nil)
+					if err != nil {
+						return "", fmt.Errorf("invalid confidential input type \"%s\" of method \"%s\": %v", kind, original.Name, err)
+					}
+					confidentialInputs = append(confidentialInputs, abi.Argument{Name: fmt.Sprintf("confidentialInput%d", j), Type: typ})
+				}
+				confidentialUsed[original.Name] = true
+			}
 			// Append the methods to the call or transact lists
 			if original.IsConstant() {
 				calls[original.Name] = &tmplMethod{Original: original, Normalized: normalized, Structured: structured(original.Outputs)}
 			} else {
-				transacts[original.Name] = &tmplMethod{Original: original, Normalized: normalized, Structured: structured(original.Outputs)}
+				transacts[original.Name] = &tmplMethod{Original: original, Normalized: normalized, Structured: structured(original.Outputs), Confidential: confidentialInputs}
 			}
 		}
 		for _, original := range evmABI.Events {
@@ -270,12 +301,19 @@ func Bind(types []string, abis []string, bytecodes []string, fsigs []map[string]
 		_, ok := isLib[types[i]]
 		contracts[types[i]].Library = ok
 	}
+	// Ensure all the typed confidential inputs were bound to a transact method
+	for name := range confidential {
+		if !confidentialUsed[name] {
+			return "", fmt.Errorf("confidential inputs for unknown transact method \"%s\"", name)
+		}
+	}
 	// Generate the contract template data content and render it
 	data := &tmplData{
 		Package:   pkg,
 		Contracts: contracts,
 		Libraries: libs,
 		Structs:   structs,
+		Suave:     suave,
 	}
 	buffer := new(bytes.Buffer)
 
@@ -287,6 +325,9 @@ func Bind(types []string, abis []string, bytecodes []string, fsigs []map[string]
 		"decapitalise":  decapitalise,
 	}
 	tmpl := template.Must(template.New("").Funcs(funcs).Parse(tmplSource[lang]))
+	if suave {
+		tmpl = template.Must(tmpl.Parse(tmplSourceGoSuave))
+	}
 	if err := tmpl.Execute(buffer, data); err != nil {
 		return "", err
 	}
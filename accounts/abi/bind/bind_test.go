@@ -28,6 +28,205 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// Tests that SUAVE bindings generated by the binder can be successfully compiled
+// and decode the confidential compute results and logs of the contract.
+func TestGolangSuaveBindings(t *testing.T) {
+	// Skip the test if no Go command can be found
+	gocmd := runtime.GOROOT() + "/bin/go"
+	if !common.FileExist(gocmd) {
+		t.Skip("go sdk not found for testing")
+	}
+	pkg := filepath.Join(t.TempDir(), "bindtest")
+	if err := os.MkdirAll(pkg, 0700); err != nil {
+		t.Fatalf("failed to create package: %v", err)
+	}
+	bind, err := BindSuave([]string{"Counter"}, []string{suaveCounterABI}, []string{""}, nil, "bindtest", nil, nil, map[string][]string{"offchain": {"uint64", "bytes"}})
+	if err != nil {
+		t.Fatalf("failed to generate binding: %v", err)
+	}
+	if err = os.WriteFile(filepath.Join(pkg, "counter.go"), []byte(bind), 0600); err != nil {
+		t.Fatalf("failed to write binding: %v", err)
+	}
+	code := `
+		package bindtest
+
+		import (
+			"math/big"
+			"testing"
+
+			"github.com/ethereum/go-ethereum/common"
+			"github.com/ethereum/go-ethereum/core/types"
+		)
+
+		func TestCounterSuave(t *testing.T) {
+			counter, err := NewCounterSuave(common.Address{0x1}, nil)
+			if err != nil {
+				t.Fatalf("failed to bind contract: %v", err)
+			}
+			// Typed confidential inputs are part of the request signature
+			var _ func(uint64, []byte) (*CounterSuaveResult, error) = counter.Offchain
+			var _ func(*big.Int, []byte) (*CounterSuaveResult, error) = counter.Onchain
+
+			// Compute results decode into the callback method and its arguments
+			result, err := counter.abi.Pack("onchain", big.NewInt(42))
+			if err != nil {
+				t.Fatalf("failed to pack callback: %v", err)
+			}
+			method, args, err := counter.UnpackComputeResult(result)
+			if err != nil {
+				t.Fatalf("failed to unpack compute result: %v", err)
+			}
+			if method.Name != "onchain" || len(args) != 1 || args[0].(*big.Int).Int64() != 42 {
+				t.Fatalf("compute result mismatch: have %s %v", method.Name, args)
+			}
+			if method, args, err := counter.UnpackComputeResult(nil); method != nil || args != nil || err != nil {
+				t.Fatalf("empty compute result mismatch: have %v %v %v", method, args, err)
+			}
+			if _, _, err := counter.UnpackComputeResult([]byte{0x1}); err == nil {
+				t.Fatalf("short compute result unpacked")
+			}
+			// Logs parse through the embedded filterer
+			data, err := counter.abi.Events["Updated"].Inputs.NonIndexed().Pack(big.NewInt(42))
+			if err != nil {
+				t.Fatalf("failed to pack event: %v", err)
+			}
+			event, err := counter.ParseUpdated(types.Log{
+				Address: common.Address{0x1},
+				Topics:  []common.Hash{counter.abi.Events["Updated"].ID, common.BytesToHash(common.Address{0x2}.Bytes())},
+				Data:    data,
+			})
+			if err != nil {
+				t.Fatalf("failed to parse event: %v", err)
+			}
+			if event.Sender != (common.Address{0x2}) || event.Value.Int64() != 42 {
+				t.Fatalf("event mismatch: have %v %v", event.Sender, event.Value)
+			}
+		}
+	`
+	if err := os.WriteFile(filepath.Join(pkg, "counter_test.go"), []byte(code), 0600); err != nil {
+		t.Fatalf("failed to write tests: %v", err)
+	}
+	runGolangBindingsTest(t, gocmd, pkg)
+}
+
+// Tests that SUAVE bindings generated by the binder send their confidential compute
+// requests through the simulated backend, and decode their results.
+func TestGolangSuaveBindingsSimulated(t *testing.T) {
+	// Skip the test if no Go command can be found
+	gocmd := runtime.GOROOT() + "/bin/go"
+	if !common.FileExist(gocmd) {
+		t.Skip("go sdk not found for testing")
+	}
+	pkg := filepath.Join(t.TempDir(), "bindtest")
+	if err := os.MkdirAll(pkg, 0700); err != nil {
+		t.Fatalf("failed to create package: %v", err)
+	}
+	bind, err := BindSuave([]string{"Counter"}, []string{suaveCounterABI}, []string{suaveCounterBin}, nil, "bindtest", nil, nil, map[string][]string{"offchain": {"uint256"}})
+	if err != nil {
+		t.Fatalf("failed to generate binding: %v", err)
+	}
+	if err = os.WriteFile(filepath.Join(pkg, "counter.go"), []byte(bind), 0600); err != nil {
+		t.Fatalf("failed to write binding: %v", err)
+	}
+	code := `
+		package bindtest
+
+		import (
+			"math/big"
+			"testing"
+
+			"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+			"github.com/ethereum/go-ethereum/core"
+			"github.com/ethereum/go-ethereum/crypto"
+		)
+
+		func TestCounterSuaveSimulated(t *testing.T) {
+			key, _ := crypto.GenerateKey()
+			addr := crypto.PubkeyToAddress(key.PublicKey)
+
+			sim := backends.NewSimulatedBackend(core.GenesisAlloc{addr: {Balance: big.NewInt(1000000000000000000)}}, 10000000)
+			defer sim.Close()
+
+			backend, err := backends.NewSimulatedSuaveBackend(sim, key)
+			if err != nil {
+				t.Fatalf("failed to create SUAVE backend: %v", err)
+			}
+			defer backend.Close()
+
+			address, _, counter, err := DeployCounterSuave(backend)
+			if err != nil {
+				t.Fatalf("failed to deploy contract: %v", err)
+			}
+			// The request computes the callback from its confidential inputs
+			res, err := counter.Offchain(big.NewInt(42))
+			if err != nil {
+				t.Fatalf("failed to send confidential request: %v", err)
+			}
+			method, args, err := res.Callback()
+			if err != nil {
+				t.Fatalf("failed to decode callback: %v", err)
+			}
+			if method.Name != "onchain" || len(args) != 1 || args[0].(*big.Int).Int64() != 42 {
+				t.Fatalf("callback mismatch: have %s %v", method.Name, args)
+			}
+			// and the callback runs on chain once the request is included
+			events, err := res.UpdatedEvents()
+			if err != nil {
+				t.Fatalf("failed to parse events: %v", err)
+			}
+			if len(events) != 1 || events[0].Sender != addr || events[0].Value.Int64() != 42 {
+				t.Fatalf("events mismatch: have %v", events)
+			}
+			caller, err := NewCounterCaller(address, sim)
+			if err != nil {
+				t.Fatalf("failed to bind caller: %v", err)
+			}
+			if value, err := caller.Value(nil); err != nil || value.Int64() != 42 {
+				t.Fatalf("value mismatch: have %v, %v", value, err)
+			}
+		}
+	`
+	if err := os.WriteFile(filepath.Join(pkg, "counter_test.go"), []byte(code), 0600); err != nil {
+		t.Fatalf("failed to write tests: %v", err)
+	}
+	runGolangBindingsTest(t, gocmd, pkg)
+}
+
+// Tests that typed confidential inputs of the SUAVE bindings are validated.
+func TestBindSuaveConfidentialInputs(t *testing.T) {
+	types := []string{"Counter"}
+	abis := []string{suaveCounterABI}
+
+	if _, err := BindSuave(types, abis, []string{""}, nil, "bindtest", nil, nil, map[string][]string{"missing": {"uint64"}}); err == nil {
+		t.Fatalf("confidential inputs of an unknown method bound")
+	}
+	if _, err := BindSuave(types, abis, []string{""}, nil, "bindtest", nil, nil, map[string][]string{"value": {"uint64"}}); err == nil {
+		t.Fatalf("confidential inputs of a call bound")
+	}
+	if _, err := BindSuave(types, abis, []string{""}, nil, "bindtest", nil, nil, map[string][]string{"offchain": {"unknown"}}); err == nil {
+		t.Fatalf("invalid confidential input type bound")
+	}
+	if _, err := BindSuave(types, abis, []string{""}, nil, "bindtest", nil, nil, nil); err != nil {
+		t.Fatalf("failed to generate binding: %v", err)
+	}
+}
+
+// suaveCounterABI is the ABI of a SUAVE contract with an offchain method calling
+// back into onchain with the compute result:
+//
+//	contract Counter {
+//		uint256 public value;
+//		event Updated(address indexed sender, uint256 value);
+//
+//		function onchain(uint256 value) external { ... }
+//		function offchain() external returns (bytes memory) { ... }
+//	}
+const suaveCounterABI = `[{"inputs":[],"name":"offchain","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"value","type":"uint256"}],"name":"onchain","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"value","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"value","type":"uint256"}],"name":"Updated","type":"event"}]`
+
+// suaveCounterBin is the hand assembled bytecode of the Counter contract, whose offchain
+// method calls back into onchain with the value read from the confidential inputs.
+const suaveCounterBin = `61009e80600c6000396000f360003560e01c80630251acae1461002b5780636c3bcc8b1461005f5780633fa4f2451461009257600080fd5b636c3bcc8b60e01b604052602060446000600063420100015afa1561005a576020600052602460205260806000f35b600080fd5b60043580600055600052337f3eaf52d76d675c02ceed20f7ebcafbd308b4b8f24b33233e3c631749f1fbf23a60206000a2005b60005460005260206000f3`
+
 var bindTests = []struct {
 	name     string
 	contract string
@@ -2114,6 +2313,12 @@ func TestGolangBindings(t *testing.T) {
 			}
 		})
 	}
+	runGolangBindingsTest(t, gocmd, pkg)
+}
+
+// runGolangBindingsTest converts the generated binding package into a Go module
+// using the current go-ethereum source tree and runs its tests.
+func runGolangBindingsTest(t *testing.T, gocmd string, pkg string) {
 	// Convert the package to go modules and use the current source for go-ethereum
 	moder := exec.Command(gocmd, "mod", "init", "bindtest")
 	moder.Dir = pkg
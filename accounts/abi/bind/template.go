@@ -24,6 +24,7 @@ type tmplData struct {
 	Contracts map[string]*tmplContract // List of contracts to generate into this file
 	Libraries map[string]string        // Map the bytecode's link pattern to the library name
 	Structs   map[string]*tmplStruct   // Contract struct type definitions
+	Suave     bool                     // Whether to generate the SUAVE confidential request bindings
 }
 
 // tmplContract contains the data needed to generate an individual contract binding.
@@ -48,6 +49,8 @@ type tmplMethod struct {
 	Original   abi.Method // Original method as parsed by the abi package
 	Normalized abi.Method // Normalized version of the parsed method (capitalized names, non-anonymous args/returns)
 	Structured bool       // Whether the returns should be accumulated into a struct
+
+	Confidential abi.Arguments // Typed confidential inputs of a SUAVE confidential request
 }
 
 // tmplEvent is a wrapper around an abi.Event that contains a few preprocessed
@@ -90,6 +93,7 @@ import (
 	"math/big"
 	"strings"
 	"errors"
+	{{if .Suave}}"context"{{end}}
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -97,6 +101,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
+	{{if .Suave}}"github.com/ethereum/go-ethereum/suave/sdk"{{end}}
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -110,6 +115,8 @@ var (
 	_ = types.BloomLookup
 	_ = event.NewSubscription
 	_ = abi.ConvertType
+	{{if .Suave}}_ = context.Background
+	_ = sdk.PackConfidentialInputs{{end}}
 )
 
 {{$structs := .Structs}}
@@ -568,4 +575,154 @@ var (
 
  	{{end}}
 {{end}}
+
+{{if .Suave}}{{template "suave" .}}{{end}}
+`
+
+// tmplSourceGoSuave is the Go source template of the SUAVE confidential request
+// bindings, rendered after the regular Go contract bindings.
+const tmplSourceGoSuave = `
+{{define "suave"}}
+{{$structs := .Structs}}
+{{range $contract := .Contracts}}
+	// {{.Type}}Suave is an auto generated Go binding around a SUAVE contract, sending
+	// its methods as confidential compute requests to a kettle.
+	type {{.Type}}Suave struct {
+		address common.Address    // Address of the contract the requests are sent to
+		backend bind.SuaveBackend // Backend sending the confidential requests
+		abi     *abi.ABI          // Parsed contract ABI to pack the requests and decode the compute results with
+		{{.Type}}Filterer         // Log parser for the events emitted by the contract
+	}
+
+	// New{{.Type}}Suave creates a new SUAVE binding of {{.Type}}, bound to a specific deployed contract.
+	func New{{.Type}}Suave(address common.Address, backend bind.SuaveBackend) (*{{.Type}}Suave, error) {
+		parsed, err := {{.Type}}MetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		filterer, err := New{{.Type}}Filterer(address, nil)
+		if err != nil {
+			return nil, err
+		}
+		return &{{.Type}}Suave{address: address, backend: backend, abi: parsed, {{.Type}}Filterer: *filterer}, nil
+	}
+
+	{{if and .InputBin (not .Libraries)}}
+		// Deploy{{.Type}}Suave deploys a new SUAVE contract through the backend, binding
+		// an instance of {{.Type}}Suave to it once the deployment is included.
+		func Deploy{{.Type}}Suave(backend bind.SuaveBackend {{range .Constructor.Inputs}}, {{.Name}} {{bindtype .Type $structs}}{{end}}) (common.Address, bind.SuaveTransaction, *{{.Type}}Suave, error) {
+			parsed, err := {{.Type}}MetaData.GetAbi()
+			if err != nil {
+				return common.Address{}, nil, nil, err
+			}
+			input, err := parsed.Pack("" {{range .Constructor.Inputs}}, {{.Name}}{{end}})
+			if err != nil {
+				return common.Address{}, nil, nil, err
+			}
+			res, err := backend.DeployContract(context.Background(), append(common.FromHex({{.Type}}Bin), input...))
+			if err != nil {
+				return common.Address{}, nil, nil, err
+			}
+			receipt, err := res.Wait()
+			if err != nil {
+				return common.Address{}, res, nil, err
+			}
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				return common.Address{}, res, nil, errors.New("contract deployment failed")
+			}
+			contract, err := New{{.Type}}Suave(receipt.ContractAddress, backend)
+			if err != nil {
+				return common.Address{}, res, nil, err
+			}
+			return receipt.ContractAddress, res, contract, nil
+		}
+	{{end}}
+
+	// UnpackComputeResult decodes the confidential compute result of a request into
+	// the contract method the kettle calls back into and the arguments of the call.
+	// An empty result, with no callback to run, decodes into a nil method.
+	func (_{{$contract.Type}} *{{$contract.Type}}Suave) UnpackComputeResult(result []byte) (*abi.Method, []interface{}, error) {
+		if len(result) == 0 {
+			return nil, nil, nil
+		}
+		if len(result) < 4 {
+			return nil, nil, errors.New("compute result too short")
+		}
+		method, err := _{{$contract.Type}}.abi.MethodById(result[:4])
+		if err != nil {
+			return nil, nil, err
+		}
+		args, err := method.Inputs.Unpack(result[4:])
+		if err != nil {
+			return nil, nil, err
+		}
+		return method, args, nil
+	}
+
+	{{range .Transacts}}
+		// {{.Normalized.Name}} sends a confidential compute request invoking the contract method 0x{{printf "%x" .Original.ID}}.
+		//
+		// Solidity: {{.Original.String}}
+		func (_{{$contract.Type}} *{{$contract.Type}}Suave) {{.Normalized.Name}}({{range .Normalized.Inputs}}{{.Name}} {{bindtype .Type $structs}}, {{end}}{{if .Confidential}}{{range .Confidential}}{{.Name}} {{bindtype .Type $structs}}, {{end}}{{else}}confidentialInputs []byte{{end}}) (*{{$contract.Type}}SuaveResult, error) {
+			{{- if .Confidential}}
+			confidentialInputs, err := sdk.PackConfidentialInputs([]string{ {{range .Confidential}}"{{.Type.String}}",{{end}} } {{range .Confidential}}, {{.Name}}{{end}})
+			if err != nil {
+				return nil, err
+			}{{end}}
+			input, err := _{{$contract.Type}}.abi.Pack("{{.Original.Name}}" {{range .Normalized.Inputs}}, {{.Name}}{{end}})
+			if err != nil {
+				return nil, err
+			}
+			res, err := _{{$contract.Type}}.backend.SendConfidentialRequest(context.Background(), _{{$contract.Type}}.address, input, confidentialInputs)
+			if err != nil {
+				return nil, err
+			}
+			return &{{$contract.Type}}SuaveResult{SuaveTransaction: res, contract: _{{$contract.Type}}}, nil
+		}
+	{{end}}
+
+	// {{.Type}}SuaveResult is the outcome of a confidential compute request sent to
+	// the {{.Type}} contract.
+	type {{.Type}}SuaveResult struct {
+		bind.SuaveTransaction
+		contract *{{.Type}}Suave
+	}
+
+	// Callback waits for the request to be included and decodes its confidential
+	// compute result into the contract method called back into and its arguments.
+	func (_{{$contract.Type}}SuaveResult *{{$contract.Type}}SuaveResult) Callback() (*abi.Method, []interface{}, error) {
+		result, err := _{{$contract.Type}}SuaveResult.ComputeResult()
+		if err != nil {
+			return nil, nil, err
+		}
+		return _{{$contract.Type}}SuaveResult.contract.UnpackComputeResult(result)
+	}
+
+	{{range .Events}}
+		// {{.Normalized.Name}}Events waits for the request to be included and parses the
+		// {{.Normalized.Name}} events the contract emitted in it.
+		func (_{{$contract.Type}}SuaveResult *{{$contract.Type}}SuaveResult) {{.Normalized.Name}}Events() ([]*{{$contract.Type}}{{.Normalized.Name}}, error) {
+			receipt, err := _{{$contract.Type}}SuaveResult.Wait()
+			if err != nil {
+				return nil, err
+			}
+			var (
+				contract = _{{$contract.Type}}SuaveResult.contract
+				events   []*{{$contract.Type}}{{.Normalized.Name}}
+			)
+			for _, log := range receipt.Logs {
+				if log.Address != contract.address || len(log.Topics) == 0 || log.Topics[0] != contract.abi.Events["{{.Original.Name}}"].ID {
+					continue
+				}
+				event, err := contract.Parse{{.Normalized.Name}}(*log)
+				if err != nil {
+					return nil, err
+				}
+				events = append(events, event)
+			}
+			return events, nil
+		}
+	{{end}}
+{{end}}
+{{end}}
 `
@@ -25,7 +25,6 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/trie"
 )
 
@@ -255,15 +254,18 @@ func ExecutableDataToBlock(params ExecutableData, versionedHashes []common.Hash,
 }
 
 // BlobTxSidecar contains the blobs of a blob transaction.
-type BlobTxSidecar struct {
-	Blobs       []kzg4844.Blob       // Blobs needed by the blob pool
-	Commitments []kzg4844.Commitment // Commitments needed by the blob pool
-	Proofs      []kzg4844.Proof      // Proofs needed by the blob pool
-}
+type BlobTxSidecar = types.BlobTxSidecar
 
 // BlockToExecutableData constructs the ExecutableData structure by filling the
 // fields from the given block. It assumes the given block is post-merge block.
 func BlockToExecutableData(block *types.Block, fees *big.Int, sidecars []*BlobTxSidecar) *ExecutionPayloadEnvelope {
+	var blobGasUsed, excessBlobGas uint64
+	for _, tx := range block.Transactions() {
+		blobGasUsed += tx.BlobGas()
+	}
+	if excessDataGas := block.Header().ExcessDataGas; excessDataGas != nil {
+		excessBlobGas = excessDataGas.Uint64()
+	}
 	data := &ExecutableData{
 		BlockHash:     block.Hash(),
 		ParentHash:    block.ParentHash(),
@@ -280,8 +282,8 @@ func BlockToExecutableData(block *types.Block, fees *big.Int, sidecars []*BlobTx
 		Random:        block.MixDigest(),
 		ExtraData:     block.Extra(),
 		Withdrawals:   block.Withdrawals(),
-		BlobGasUsed:   nil,
-		ExcessBlobGas: nil,
+		BlobGasUsed:   &blobGasUsed,
+		ExcessBlobGas: &excessBlobGas,
 	}
 	bundle := BlobsBundleV1{
 		Commitments: make([]hexutil.Bytes, 0),
@@ -72,6 +72,14 @@ var (
 		Name:  "alias",
 		Usage: "Comma separated aliases for function and event renaming, e.g. original1=alias1, original2=alias2",
 	}
+	suaveFlag = &cli.BoolFlag{
+		Name:  "suave",
+		Usage: "Generate SUAVE bindings sending confidential compute requests (go only)",
+	}
+	confidentialFlag = &cli.StringFlag{
+		Name:  "confidential",
+		Usage: "Comma separated typed confidential inputs of SUAVE methods, e.g. method1=uint64;bytes, method2=address",
+	}
 )
 
 var app = flags.NewApp("Ethereum ABI wrapper code generator")
@@ -88,6 +96,8 @@ func init() {
 		outFlag,
 		langFlag,
 		aliasFlag,
+		suaveFlag,
+		confidentialFlag,
 	}
 	app.Action = abigen
 }
@@ -215,8 +225,31 @@ func abigen(c *cli.Context) error {
 			aliases[match[1]] = match[2]
 		}
 	}
+	// Extract the typed confidential inputs of the SUAVE methods
+	confidential := make(map[string][]string)
+	if c.IsSet(confidentialFlag.Name) {
+		if !c.Bool(suaveFlag.Name) {
+			utils.Fatalf("Confidential inputs require SUAVE bindings (--suave)")
+		}
+		re := regexp.MustCompile(`(?:(\w+)[:=]([\w\[\];]+))`)
+		submatches := re.FindAllStringSubmatch(c.String(confidentialFlag.Name), -1)
+		for _, match := range submatches {
+			confidential[match[1]] = strings.Split(match[2], ";")
+		}
+	}
 	// Generate the contract binding
-	code, err := bind.Bind(types, abis, bins, sigs, c.String(pkgFlag.Name), lang, libs, aliases)
+	var (
+		code string
+		err  error
+	)
+	if c.Bool(suaveFlag.Name) {
+		if lang != bind.LangGo {
+			utils.Fatalf("SUAVE bindings are only supported for go (--lang)")
+		}
+		code, err = bind.BindSuave(types, abis, bins, sigs, c.String(pkgFlag.Name), libs, aliases, confidential)
+	} else {
+		code, err = bind.Bind(types, abis, bins, sigs, c.String(pkgFlag.Name), lang, libs, aliases)
+	}
 	if err != nil {
 		utils.Fatalf("Failed to generate ABI binding: %v", err)
 	}
@@ -12,15 +12,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/core/vm"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/suave/artifacts"
-	suave_backends "github.com/ethereum/go-ethereum/suave/backends"
-	suave "github.com/ethereum/go-ethereum/suave/core"
-	"github.com/flashbots/go-boost-utils/bls"
 	"github.com/naoina/toml"
 	"github.com/urfave/cli/v2"
 )
@@ -44,6 +39,24 @@ var (
 		Name:  "eth-backend",
 		Usage: `The endpoint of the confidential eth backend`,
 	}
+	confStoreDirForgeFlag = &cli.StringFlag{
+		Name:  "conf-store-dir",
+		Usage: `The directory to persist the local confidential store in between runs, in memory if not set`,
+	}
+	callerForgeFlag = &cli.StringFlag{
+		Name:  "caller",
+		Usage: `The address of the contract calling the precompile locally, checked against the allowed peekers of the records`,
+	}
+	urlForgeFlag = &cli.StringFlag{
+		Name:  "url",
+		Usage: `The endpoint of the suave node to run the precompiles on`,
+		Value: defaultRemoteSuaveHost,
+	}
+	serverForgeFlag = &cli.StringFlag{
+		Name:    "server",
+		Usage:   `The endpoint of a geth forge serve to run the precompiles on`,
+		EnvVars: []string{"SUAVE_FORGE_SERVER"},
+	}
 	tomlConfigForgeFlag = &cli.StringFlag{
 		Name:  "config",
 		Usage: `The path to the forge toml config file`,
@@ -54,9 +67,11 @@ type suaveForgeConfig struct {
 	Whitelist    []string          `toml:"whitelist"`
 	ServiceAlias map[string]string `toml:"service_alias"`
 	EthBackend   string            `toml:"eth_backend"`
+	ConfStoreDir string            `toml:"conf_store_dir"`
+	Caller       string            `toml:"caller"`
 }
 
-func readContext(ctx *cli.Context) (*vm.SuaveContext, error) {
+func readConfig(ctx *cli.Context) (*suaveForgeConfig, error) {
 	// try to read the config from the toml config file
 	cfg := &suaveForgeConfig{}
 
@@ -92,6 +107,15 @@ func readContext(ctx *cli.Context) (*vm.SuaveContext, error) {
 	if ctx.IsSet(ethBackendForgeFlag.Name) {
 		cfg.EthBackend = ctx.String(ethBackendForgeFlag.Name)
 	}
+	if ctx.IsSet(confStoreDirForgeFlag.Name) {
+		cfg.ConfStoreDir = ctx.String(confStoreDirForgeFlag.Name)
+	}
+	if ctx.IsSet(callerForgeFlag.Name) {
+		cfg.Caller = ctx.String(callerForgeFlag.Name)
+	}
+	if cfg.Caller != "" && !common.IsHexAddress(cfg.Caller) {
+		return nil, fmt.Errorf("invalid caller address: %s", cfg.Caller)
+	}
 	if ctx.IsSet(whiteListForgeFlag.Name) {
 		cfg.Whitelist = ctx.StringSlice(whiteListForgeFlag.Name)
 	}
@@ -109,35 +133,7 @@ func readContext(ctx *cli.Context) (*vm.SuaveContext, error) {
 		cfg.ServiceAlias = registry
 	}
 
-	// create the suave context
-	var suaveEthBackend suave.ConfidentialEthBackend
-	if cfg.EthBackend != "" {
-		suaveEthBackend = suave_backends.NewRemoteEthBackend(cfg.EthBackend)
-	} else {
-		suaveEthBackend = &suave_backends.EthMock{}
-	}
-
-	ecdsaKey, err := crypto.GenerateKey()
-	if err != nil {
-		return nil, err
-	}
-	blsKey, err := bls.GenerateRandomSecretKey()
-	if err != nil {
-		return nil, err
-	}
-
-	// NOTE: the confidential store precompiles are not enabled since they are stateless
-	backend := &vm.SuaveExecutionBackend{
-		ExternalWhitelist:      cfg.Whitelist,
-		ConfidentialEthBackend: suaveEthBackend,
-		ServiceAliasRegistry:   cfg.ServiceAlias,
-		EthBlockSigningKey:     blsKey,
-		EthBundleSigningKey:    ecdsaKey,
-	}
-	suaveCtx := &vm.SuaveContext{
-		Backend: backend,
-	}
-	return suaveCtx, nil
+	return cfg, nil
 }
 
 var (
@@ -151,11 +147,16 @@ var (
 			whiteListForgeFlag,
 			serviceAliasForgeFlag,
 			ethBackendForgeFlag,
+			confStoreDirForgeFlag,
+			callerForgeFlag,
+			urlForgeFlag,
+			serverForgeFlag,
 			tomlConfigForgeFlag,
 		},
 		Subcommands: []*cli.Command{
 			forgeStatusCmd,
 			resetConfStore,
+			forgeServeCmd,
 		},
 		Action: func(ctx *cli.Context) error {
 			args := ctx.Args()
@@ -167,13 +168,9 @@ var (
 			// contract to be called, it can either be:
 			// 1. The address of the precompile
 			// 2. The name of the precompile.
-			addr := args.Get(0)
-			if !strings.HasPrefix(addr, "0x") {
-				mAddr, ok := artifacts.SuaveMethods[addr]
-				if !ok {
-					return fmt.Errorf("unknown precompile name '%s'", addr)
-				}
-				addr = mAddr.Hex()
+			precompile, err := resolvePrecompile(args.Get(0))
+			if err != nil {
+				return err
 			}
 
 			inputStr := "0x"
@@ -186,20 +183,36 @@ var (
 				return fmt.Errorf("failed to decode input: %w", err)
 			}
 
-			if ctx.IsSet(isLocalForgeFlag.Name) {
-				suaveCtx, err := readContext(ctx)
+			if ctx.IsSet(serverForgeFlag.Name) {
+				rpcClient, err := rpc.Dial(ctx.String(serverForgeFlag.Name))
+				if err != nil {
+					return fmt.Errorf("failed to dial forge server: %w", err)
+				}
+				defer rpcClient.Close()
+
+				var result hexutil.Bytes
+				if err := rpcClient.Call(&result, "forge_run", precompile.Hex(), hexutil.Bytes(input)); err != nil {
+					return err
+				}
+				fmt.Println(hex.EncodeToString(result))
+			} else if ctx.IsSet(isLocalForgeFlag.Name) {
+				cfg, err := readConfig(ctx)
+				if err != nil {
+					return fmt.Errorf("failed to read config: %w", err)
+				}
+				local, err := newLocalForge(cfg)
 				if err != nil {
-					return fmt.Errorf("failed to read context: %w", err)
+					return fmt.Errorf("failed to start the local kettle: %w", err)
 				}
+				defer local.Close()
 
-				result, err := vm.NewSuavePrecompiledContractWrapper(common.HexToAddress(addr), suaveCtx).Run(input)
+				result, err := local.Run(precompile, input)
 				if err != nil {
-					// the actual error messageis returned in 'result', err only contains the ErrExecutionReverted error
-					return fmt.Errorf(string(result))
+					return err
 				}
 				fmt.Println(hex.EncodeToString(result))
 			} else {
-				rpcClient, err := rpc.Dial(defaultRemoteSuaveHost)
+				rpcClient, err := rpc.Dial(ctx.String(urlForgeFlag.Name))
 				if err != nil {
 					return fmt.Errorf("failed to dial rpc: %w", err)
 				}
@@ -212,7 +225,7 @@ var (
 				}
 
 				chainId := hexutil.Big(*chainIdRaw)
-				toAddr := common.HexToAddress(addr)
+				toAddr := precompile
 
 				callArgs := ethapi.TransactionArgs{
 					To:             &toAddr,
@@ -234,6 +247,19 @@ var (
 	}
 )
 
+// resolvePrecompile returns the address of a precompile given by its name or
+// its address.
+func resolvePrecompile(precompile string) (common.Address, error) {
+	if strings.HasPrefix(precompile, "0x") {
+		return common.HexToAddress(precompile), nil
+	}
+	addr, ok := artifacts.SuaveMethods[precompile]
+	if !ok {
+		return common.Address{}, fmt.Errorf("unknown precompile name '%s'", precompile)
+	}
+	return addr, nil
+}
+
 func setTxArgsDefaults(args ethapi.TransactionArgs) ethapi.TransactionArgs {
 	gas := hexutil.Uint64(1000000)
 	args.Gas = &gas
@@ -262,7 +288,7 @@ var forgeStatusCmd = &cli.Command{
 			return nil
 		}
 
-		rpcClient, err := rpc.Dial(defaultRemoteSuaveHost)
+		rpcClient, err := rpc.Dial(ctx.String(urlForgeFlag.Name))
 		if err != nil {
 			return handleErr(err)
 		}
@@ -280,7 +306,26 @@ var resetConfStore = &cli.Command{
 	Name:  "reset-conf-store",
 	Usage: "Internal command to reset the confidential store",
 	Action: func(ctx *cli.Context) error {
-		rpcClient, err := rpc.Dial(defaultRemoteSuaveHost)
+		if ctx.IsSet(serverForgeFlag.Name) {
+			rpcClient, err := rpc.Dial(ctx.String(serverForgeFlag.Name))
+			if err != nil {
+				return err
+			}
+			return rpcClient.Call(nil, "forge_resetConfStore")
+		}
+		if ctx.IsSet(isLocalForgeFlag.Name) {
+			// the local store is in memory unless persisted in a directory
+			cfg, err := readConfig(ctx)
+			if err != nil {
+				return err
+			}
+			if cfg.ConfStoreDir != "" {
+				return os.RemoveAll(cfg.ConfStoreDir)
+			}
+			return nil
+		}
+
+		rpcClient, err := rpc.Dial(ctx.String(urlForgeFlag.Name))
 		if err != nil {
 			return err
 		}
@@ -5,6 +5,10 @@ import (
 	"io"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/suave/artifacts"
 	suave_backends "github.com/ethereum/go-ethereum/suave/backends"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli/v2"
@@ -27,49 +31,117 @@ func TestForgeReadConfig(t *testing.T) {
 
 	ctx := cli.NewContext(nil, flagSet(t, forgeCommand.Flags), nil)
 
-	// read context from non-existent config file
+	// read config from non-existent config file
 	ctx.Set("config", "./testdata/forge_not_exists.toml")
 
-	_, err := readContext(ctx)
+	_, err := readConfig(ctx)
 	require.Error(t, err)
 
-	// read context from valid config toml file WITHOUT suave section
+	// read config from valid config toml file WITHOUT suave section
 	// it should fallback to the default values
 	ctx.Set("config", "./testdata/forge_noconfig.toml")
 
-	sCtx, err := readContext(ctx)
+	cfg, err := readConfig(ctx)
 	require.NoError(t, err)
 
-	require.Len(t, sCtx.Backend.ExternalWhitelist, 0)
-	require.Len(t, sCtx.Backend.ServiceAliasRegistry, 0)
+	require.Len(t, cfg.Whitelist, 0)
+	require.Len(t, cfg.ServiceAlias, 0)
 
-	// read context from config toml file
+	// read config from config toml file
 	ctx.Set("config", "./testdata/forge.toml")
 
-	sCtx, err = readContext(ctx)
+	cfg, err = readConfig(ctx)
 	require.NoError(t, err)
-	require.Equal(t, []string{"a", "b"}, sCtx.Backend.ExternalWhitelist)
-	require.Equal(t, map[string]string{"a": "b", "c": "d"}, sCtx.Backend.ServiceAliasRegistry)
-	require.Equal(t, "suave", sCtx.Backend.ConfidentialEthBackend.(*suave_backends.RemoteEthBackend).Endpoint())
+	require.Equal(t, []string{"a", "b"}, cfg.Whitelist)
+	require.Equal(t, map[string]string{"a": "b", "c": "d"}, cfg.ServiceAlias)
+	require.Equal(t, "suave", cfg.EthBackend)
 
 	// override the config if the flags are set
 	ctx.Set("eth-backend", "http://localhost:8545")
 	ctx.Set("whitelist", "c,d")
 	ctx.Set("service-alias", "e=f,g=h")
+	ctx.Set("conf-store-dir", "/tmp/store")
+	ctx.Set("caller", "0x0000000000000000000000000000000000000001")
 
-	sCtx, err = readContext(ctx)
+	cfg, err = readConfig(ctx)
 	require.NoError(t, err)
-	require.Equal(t, []string{"c", "d"}, sCtx.Backend.ExternalWhitelist)
-	require.Equal(t, map[string]string{"e": "f", "g": "h"}, sCtx.Backend.ServiceAliasRegistry)
-	require.Equal(t, "http://localhost:8545", sCtx.Backend.ConfidentialEthBackend.(*suave_backends.RemoteEthBackend).Endpoint())
+	require.Equal(t, []string{"c", "d"}, cfg.Whitelist)
+	require.Equal(t, map[string]string{"e": "f", "g": "h"}, cfg.ServiceAlias)
+	require.Equal(t, "http://localhost:8545", cfg.EthBackend)
+	require.Equal(t, "/tmp/store", cfg.ConfStoreDir)
+
+	cfg.ConfStoreDir = ""
+	local, err := newLocalForge(cfg)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8545", local.ethBackend.(*suave_backends.RemoteEthBackend).Endpoint())
+	local.Close()
+
+	// the caller must be an address
+	ctx.Set("caller", "a")
+	_, err = readConfig(ctx)
+	require.Error(t, err)
 
 	// set flags to null and use default values
 	ctx = cli.NewContext(nil, flagSet(t, forgeCommand.Flags), nil)
 
-	sCtx, err = readContext(ctx)
+	cfg, err = readConfig(ctx)
+	require.NoError(t, err)
+	require.Len(t, cfg.Whitelist, 0)
+
+	local, err = newLocalForge(cfg)
 	require.NoError(t, err)
-	require.Len(t, sCtx.Backend.ExternalWhitelist, 0)
+	defer local.Close()
 
-	_, ok := sCtx.Backend.ConfidentialEthBackend.(*suave_backends.EthMock)
+	_, ok := local.ethBackend.(*localEthBackend)
 	require.True(t, ok)
 }
+
+func TestForgeLocal(t *testing.T) {
+	caller := common.Address{0x1}
+	cfg := &suaveForgeConfig{
+		ConfStoreDir: t.TempDir(),
+		Caller:       caller.Hex(),
+	}
+
+	run := func(local *localForge, name string, args ...interface{}) []interface{} {
+		method := artifacts.SuaveAbi.Methods[name]
+		input, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+
+		output, err := local.Run(artifacts.SuaveMethods[name], input)
+		require.NoError(t, err)
+
+		res, err := method.Outputs.Unpack(output)
+		require.NoError(t, err)
+		return res
+	}
+
+	local, err := newLocalForge(cfg)
+	require.NoError(t, err)
+
+	// the records and their values are persisted between the runs
+	res := run(local, "newDataRecord", uint64(0), []common.Address{caller}, []common.Address{}, "a")
+	record := *abi.ConvertType(res[0], new(types.DataRecord)).(*types.DataRecord)
+	run(local, "confidentialStore", record.Id, "key", []byte{0x1})
+
+	// the builder sessions run on the local chain
+	res = run(local, "newBuilder")
+	require.NotEmpty(t, res[0])
+	local.Close()
+
+	local, err = newLocalForge(cfg)
+	require.NoError(t, err)
+	defer local.Close()
+
+	// the output of confidentialRetrieve is not abi encoded
+	input, err := artifacts.SuaveAbi.Methods["confidentialRetrieve"].Inputs.Pack(record.Id, "key")
+	require.NoError(t, err)
+	output, err := local.Run(artifacts.SuaveMethods["confidentialRetrieve"], input)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x1}, output)
+
+	// the caller must be allowed to peek into the record
+	local.callers = []common.Address{{0x2}}
+	_, err = local.Run(artifacts.SuaveMethods["confidentialRetrieve"], input)
+	require.Error(t, err)
+}
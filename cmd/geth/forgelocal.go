@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	suave_backends "github.com/ethereum/go-ethereum/suave/backends"
+	suave_builder "github.com/ethereum/go-ethereum/suave/builder"
+	suave_builder_api "github.com/ethereum/go-ethereum/suave/builder/api"
+	suave "github.com/ethereum/go-ethereum/suave/core"
+	"github.com/ethereum/go-ethereum/suave/cstore"
+	"github.com/flashbots/go-boost-utils/bls"
+)
+
+// localForge is the kettle of the local forge runs. It executes the precompiles
+// with a confidential store, in memory or persisted in a pebble database between
+// the runs, and a builder on an in-memory chain.
+type localForge struct {
+	cfg *suaveForgeConfig
+
+	engine   *cstore.CStoreEngine
+	chain    *core.BlockChain
+	sessions *suave_builder.SessionManager
+
+	ethBackend suave.ConfidentialEthBackend
+	ecdsaKey   *ecdsa.PrivateKey
+	blsKey     *bls.SecretKey
+	kettle     common.Address
+	sourceTx   *types.Transaction
+
+	// callers is the caller stack of the precompiles, the last one is the closest caller
+	callers []common.Address
+	// http answers the mocked http requests of the precompiles
+	http *mockHTTPTransport
+	// builtBlobs keeps the blobs of the built blocks for their submission
+	builtBlobs *vm.BuiltBlobs
+}
+
+// localEthBackend serves the builder API with the session manager of the
+// local chain, and mocks the rest of the eth backend.
+type localEthBackend struct {
+	*suave_backends.EthMock
+	*suave_builder_api.Server
+}
+
+var _ suave.ConfidentialEthBackend = &localEthBackend{}
+
+// localChainSigner accepts the fake source transaction of the local runs, so
+// that the confidential store writes are finalized.
+type localChainSigner struct {
+	kettle common.Address
+}
+
+func (s localChainSigner) Sender(tx *types.Transaction) (common.Address, error) {
+	return s.kettle, nil
+}
+
+func newLocalForge(cfg *suaveForgeConfig) (*localForge, error) {
+	ecdsaKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	blsKey, err := bls.GenerateRandomSecretKey()
+	if err != nil {
+		return nil, err
+	}
+	l := &localForge{
+		cfg:      cfg,
+		ecdsaKey: ecdsaKey,
+		blsKey:   blsKey,
+		kettle:   crypto.PubkeyToAddress(ecdsaKey.PublicKey),
+		http:     newMockHTTPTransport(),
+
+		builtBlobs: vm.NewBuiltBlobs(),
+	}
+	if cfg.Caller != "" {
+		l.callers = []common.Address{common.HexToAddress(cfg.Caller)}
+	}
+
+	var storeBackend cstore.ConfidentialStorageBackend
+	if cfg.ConfStoreDir != "" {
+		if storeBackend, err = cstore.NewPebbleStoreBackend(cfg.ConfStoreDir); err != nil {
+			return nil, err
+		}
+	} else {
+		storeBackend = cstore.NewLocalConfidentialStore()
+	}
+	l.engine = cstore.NewEngine(storeBackend, &cstore.MockTransport{}, cstore.MockSigner{}, localChainSigner{kettle: l.kettle})
+	if err := l.engine.Start(); err != nil {
+		return nil, err
+	}
+
+	genesis := core.DeveloperGenesisBlock(0, 30_000_000, l.kettle)
+	l.sourceTx = types.NewTx(&types.ConfidentialComputeRequest{
+		ConfidentialComputeRecord: types.ConfidentialComputeRecord{
+			KettleAddress: l.kettle,
+			ChainID:       genesis.Config.ChainID,
+		},
+	})
+
+	if cfg.EthBackend != "" {
+		l.ethBackend = suave_backends.NewRemoteEthBackend(cfg.EthBackend)
+	} else {
+		l.chain, err = core.NewBlockChain(rawdb.NewMemoryDatabase(), nil, genesis, nil, ethash.NewFaker(), vm.Config{}, nil, nil)
+		if err != nil {
+			l.Close()
+			return nil, fmt.Errorf("failed to create the local chain: %w", err)
+		}
+		l.sessions = suave_builder.NewSessionManager(l.chain, &suave_builder.Config{})
+		l.ethBackend = &localEthBackend{
+			EthMock: &suave_backends.EthMock{},
+			Server:  suave_builder_api.NewServer(l.sessions),
+		}
+	}
+	return l, nil
+}
+
+// Run executes a precompile, and finalizes its confidential store writes if
+// it succeeds. A revert returns the revert data as an error message.
+func (l *localForge) Run(addr common.Address, input []byte) ([]byte, error) {
+	store := l.engine.NewTransactionalStore(l.sourceTx)
+	suaveCtx := &vm.SuaveContext{
+		Backend: &vm.SuaveExecutionBackend{
+			ExternalWhitelist:      l.cfg.Whitelist,
+			ServiceAliasRegistry:   l.cfg.ServiceAlias,
+			ConfidentialStore:      store,
+			ConfidentialEthBackend: l.ethBackend,
+			EthBlockSigningKey:     l.blsKey,
+			EthBundleSigningKey:    l.ecdsaKey,
+			HttpTransport:          l.http,
+			BuiltBlobs:             l.builtBlobs,
+		},
+		Context: map[string][]byte{
+			"kettleAddress": l.kettle.Bytes(),
+		},
+		CallerStack: []*common.Address{},
+	}
+	for i := range l.callers {
+		suaveCtx.CallerStack = append(suaveCtx.CallerStack, &l.callers[i])
+	}
+
+	result, err := vm.NewSuavePrecompiledContractWrapper(addr, suaveCtx).Run(input)
+	if err != nil {
+		// the revert data is returned in 'result', err only contains the ErrExecutionReverted error.
+		// It is either a typed error or the error message.
+		if typedErr := vm.UnpackSuaveError(result); typedErr != nil {
+			return nil, typedErr
+		}
+		return nil, fmt.Errorf("%s", result)
+	}
+	if err := store.Finalize(); err != nil {
+		return nil, fmt.Errorf("failed to finalize the confidential store: %w", err)
+	}
+	return result, nil
+}
+
+// Close stops the confidential store and the builder.
+func (l *localForge) Close() {
+	if l.sessions != nil {
+		l.sessions.Close()
+	}
+	if l.chain != nil {
+		l.chain.Stop()
+	}
+	l.engine.Stop()
+}
+
+// mockHTTPTransport answers the requests with a mocked response, and sends
+// the other ones with the default transport.
+type mockHTTPTransport struct {
+	lock      sync.RWMutex
+	responses map[string]*mockHTTPResponse
+}
+
+type mockHTTPResponse struct {
+	status int
+	body   []byte
+}
+
+func newMockHTTPTransport() *mockHTTPTransport {
+	return &mockHTTPTransport{responses: make(map[string]*mockHTTPResponse)}
+}
+
+// mockHTTPKey is the key of the response of a request, an empty method mocks
+// the requests of any method.
+func mockHTTPKey(method, url string) string {
+	return strings.ToUpper(method) + " " + url
+}
+
+// Set mocks the response of the requests to a url.
+func (m *mockHTTPTransport) Set(method, url string, status int, body []byte) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.responses[mockHTTPKey(method, url)] = &mockHTTPResponse{status: status, body: body}
+}
+
+// Clear removes the mocked responses.
+func (m *mockHTTPTransport) Clear() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.responses = make(map[string]*mockHTTPResponse)
+}
+
+func (m *mockHTTPTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	m.lock.RLock()
+	resp, ok := m.responses[mockHTTPKey(req.Method, req.URL.String())]
+	if !ok {
+		resp, ok = m.responses[mockHTTPKey("", req.URL.String())]
+	}
+	m.lock.RUnlock()
+
+	if !ok {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.status, http.StatusText(resp.status)),
+		StatusCode:    resp.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        make(http.Header),
+		Body:          io.NopCloser(bytes.NewReader(resp.body)),
+		ContentLength: int64(len(resp.body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/suave/cstore"
+	"github.com/urfave/cli/v2"
+)
+
+var defaultForgeServeAddr = "127.0.0.1:8547"
+
+// forgeServeShutdownTimeout is how long the requests in flight are waited for on shutdown.
+const forgeServeShutdownTimeout = 5 * time.Second
+
+var forgeServeAddrFlag = &cli.StringFlag{
+	Name:  "addr",
+	Usage: `The address to serve the forge JSON-RPC endpoint on`,
+	Value: defaultForgeServeAddr,
+}
+
+var forgeServeCmd = &cli.Command{
+	Name:  "serve",
+	Usage: "Serve a local kettle for Foundry tests",
+	Description: `Runs a local kettle, as geth forge --local does, and serves it over JSON-RPC
+with the forge namespace. Foundry tests run the precompiles on it with geth forge --server
+and control the kettle with the methods:
+
+    forge_run(precompile, input)             runs a precompile by name or address
+    forge_resetConfStore()                   empties the confidential store
+    forge_snapshot()                         snapshots the confidential store
+    forge_restore(id)                        restores a snapshot, and drops the newer ones
+    forge_setHttpResponse(method, url, status, body)
+                                             mocks the response of a whitelisted url
+    forge_clearHttpResponses()               removes the mocked responses
+    forge_setCallerStack(callers)            sets the callers of the precompiles`,
+	Flags: []cli.Flag{
+		forgeServeAddrFlag,
+	},
+	Action: func(ctx *cli.Context) error {
+		cfg, err := readConfig(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to read config: %w", err)
+		}
+		if cfg.ConfStoreDir != "" {
+			return errors.New("the forge server runs with an in-memory confidential store")
+		}
+		local, err := newLocalForge(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to start the local kettle: %w", err)
+		}
+		defer local.Close()
+
+		server := rpc.NewServer()
+		defer server.Stop()
+		if err := server.RegisterName("forge", newForgeAPI(local)); err != nil {
+			return err
+		}
+
+		listener, err := net.Listen("tcp", ctx.String(forgeServeAddrFlag.Name))
+		if err != nil {
+			return err
+		}
+		// serve until interrupted, so that the kettle is closed on the way out
+		interrupt := make(chan os.Signal, 1)
+		signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
+		defer signal.Stop(interrupt)
+
+		httpServer := &http.Server{Handler: server}
+		errc := make(chan error, 1)
+		go func() {
+			errc <- httpServer.Serve(listener)
+		}()
+		log.Info("Forge server started", "url", "http://"+listener.Addr().String())
+
+		select {
+		case err := <-errc:
+			return err
+		case <-interrupt:
+			log.Info("Shutting down the forge server")
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), forgeServeShutdownTimeout)
+			defer cancel()
+			return httpServer.Shutdown(shutdownCtx)
+		}
+	},
+}
+
+// forgeAPI is the JSON-RPC API of the forge server.
+type forgeAPI struct {
+	// lock serializes the runs, so that the tests see the writes of the previous runs
+	lock      sync.Mutex
+	local     *localForge
+	snapshots []*cstore.LocalConfidentialStore
+}
+
+func newForgeAPI(local *localForge) *forgeAPI {
+	return &forgeAPI{local: local}
+}
+
+func (f *forgeAPI) store() *cstore.LocalConfidentialStore {
+	return f.local.engine.Backend().(*cstore.LocalConfidentialStore)
+}
+
+// Run runs a precompile, given by its name or address, and returns its output.
+func (f *forgeAPI) Run(precompile string, input hexutil.Bytes) (hexutil.Bytes, error) {
+	addr, err := resolvePrecompile(precompile)
+	if err != nil {
+		return nil, err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.local.Run(addr, input)
+}
+
+// ResetConfStore empties the confidential store, and drops its snapshots.
+func (f *forgeAPI) ResetConfStore() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.snapshots = nil
+	return f.store().Reset()
+}
+
+// Snapshot snapshots the confidential store and returns the id of the snapshot.
+func (f *forgeAPI) Snapshot() hexutil.Uint64 {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.snapshots = append(f.snapshots, f.store().Snapshot())
+	return hexutil.Uint64(len(f.snapshots) - 1)
+}
+
+// Restore restores a snapshot of the confidential store. The newer snapshots are
+// dropped, the restored one is kept to be restored again.
+func (f *forgeAPI) Restore(id hexutil.Uint64) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if uint64(id) >= uint64(len(f.snapshots)) {
+		return fmt.Errorf("unknown snapshot %d", id)
+	}
+	f.store().Restore(f.snapshots[id])
+	f.snapshots = f.snapshots[:id+1]
+	return nil
+}
+
+// SetHttpResponse mocks the response of the http requests to a url. An empty
+// method mocks the requests of any method. The url must still be whitelisted.
+func (f *forgeAPI) SetHttpResponse(method string, url string, status uint64, body hexutil.Bytes) error {
+	if status < 100 || status > 999 {
+		return fmt.Errorf("invalid status %d", status)
+	}
+	f.local.http.Set(method, url, int(status), body)
+	return nil
+}
+
+// ClearHttpResponses removes the mocked http responses.
+func (f *forgeAPI) ClearHttpResponses() {
+	f.local.http.Clear()
+}
+
+// SetCallerStack sets the callers of the precompiles, the last one being the
+// closest caller.
+func (f *forgeAPI) SetCallerStack(callers []common.Address) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.local.callers = callers
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/suave/artifacts"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForgeServe(t *testing.T) {
+	local, err := newLocalForge(&suaveForgeConfig{
+		Whitelist: []string{"example.com"},
+	})
+	require.NoError(t, err)
+	defer local.Close()
+
+	server := rpc.NewServer()
+	defer server.Stop()
+	require.NoError(t, server.RegisterName("forge", newForgeAPI(local)))
+
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	run := func(name string, args ...interface{}) ([]byte, error) {
+		input, err := artifacts.SuaveAbi.Methods[name].Inputs.Pack(args...)
+		require.NoError(t, err)
+
+		var output hexutil.Bytes
+		err = client.Call(&output, "forge_run", name, hexutil.Bytes(input))
+		return output, err
+	}
+
+	// the records are only readable by the callers of the stack
+	caller := common.Address{0x1}
+	require.NoError(t, client.Call(nil, "forge_setCallerStack", []common.Address{caller}))
+
+	output, err := run("newDataRecord", uint64(0), []common.Address{caller}, []common.Address{}, "a")
+	require.NoError(t, err)
+	res, err := artifacts.SuaveAbi.Methods["newDataRecord"].Outputs.Unpack(output)
+	require.NoError(t, err)
+	record := *abi.ConvertType(res[0], new(types.DataRecord)).(*types.DataRecord)
+
+	_, err = run("confidentialStore", record.Id, "key", []byte{0x1})
+	require.NoError(t, err)
+
+	// the snapshots revert the writes done after them
+	var snapshot hexutil.Uint64
+	require.NoError(t, client.Call(&snapshot, "forge_snapshot"))
+
+	_, err = run("confidentialStore", record.Id, "key", []byte{0x2})
+	require.NoError(t, err)
+	output, err = run("confidentialRetrieve", record.Id, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x2}, output)
+
+	var newer hexutil.Uint64
+	require.NoError(t, client.Call(&newer, "forge_snapshot"))
+
+	require.NoError(t, client.Call(nil, "forge_restore", snapshot))
+	output, err = run("confidentialRetrieve", record.Id, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x1}, output)
+
+	// a restored snapshot can be restored again, the newer ones are dropped
+	require.Error(t, client.Call(nil, "forge_restore", newer))
+
+	_, err = run("confidentialStore", record.Id, "key", []byte{0x3})
+	require.NoError(t, err)
+	require.NoError(t, client.Call(nil, "forge_restore", snapshot))
+	output, err = run("confidentialRetrieve", record.Id, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x1}, output)
+
+	require.NoError(t, client.Call(nil, "forge_setCallerStack", []common.Address{{0x2}}))
+	_, err = run("confidentialRetrieve", record.Id, "key")
+	require.Error(t, err)
+
+	require.NoError(t, client.Call(nil, "forge_resetConfStore"))
+	require.NoError(t, client.Call(nil, "forge_setCallerStack", []common.Address{caller}))
+	_, err = run("confidentialRetrieve", record.Id, "key")
+	require.Error(t, err)
+
+	// the http requests to the whitelisted urls are mocked
+	require.NoError(t, client.Call(nil, "forge_setHttpResponse", "GET", "http://example.com/a", 200, hexutil.Bytes("mocked")))
+
+	output, err = run("doHTTPRequest", types.HttpRequest{Url: "http://example.com/a", Method: "GET"})
+	require.NoError(t, err)
+	res, err = artifacts.SuaveAbi.Methods["doHTTPRequest"].Outputs.Unpack(output)
+	require.NoError(t, err)
+	require.Equal(t, []byte("mocked"), res[0])
+
+	require.NoError(t, client.Call(nil, "forge_setHttpResponse", "", "http://other.com", 200, hexutil.Bytes{}))
+	_, err = run("doHTTPRequest", types.HttpRequest{Url: "http://other.com", Method: "GET"})
+	require.ErrorContains(t, err, "DomainNotAllowed")
+
+	require.NoError(t, client.Call(nil, "forge_setHttpResponse", "", "http://example.com/b", http.StatusNotFound, hexutil.Bytes{}))
+	_, err = run("doHTTPRequest", types.HttpRequest{Url: "http://example.com/b", Method: "POST"})
+	require.ErrorContains(t, err, "HttpRequestFailed(status: 404")
+
+	require.NoError(t, client.Call(nil, "forge_clearHttpResponses"))
+
+	// the precompiles are given by their name or address
+	var out hexutil.Bytes
+	require.Error(t, client.Call(&out, "forge_run", "unknown", hexutil.Bytes{}))
+	require.NoError(t, client.Call(&out, "forge_run", artifacts.SuaveMethods["randomBytes"].Hex(), mustPack(t, "randomBytes", uint8(4))))
+}
+
+func mustPack(t *testing.T, name string, args ...interface{}) hexutil.Bytes {
+	input, err := artifacts.SuaveAbi.Methods[name].Inputs.Pack(args...)
+	require.NoError(t, err)
+	return input
+}
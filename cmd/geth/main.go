@@ -199,6 +199,13 @@ var (
 		utils.SuaveConfidentialStorePebbleDbPathFlag,
 		utils.SuaveEthBundleSigningKeyFlag,
 		utils.SuaveEthBlockSigningKeyFlag,
+		utils.SuaveBeaconSpecFlag,
+		utils.SuaveBeaconEndpointFlag,
+		utils.SuaveBundleRecordFlag,
+		utils.SuavePluginFlag,
+		utils.SuaveBuilderSimulationWorkersFlag,
+		utils.SuaveMevShareRefundPercentFlag,
+		utils.SuaveMevShareInclusionRangeFlag,
 		utils.SuaveExternalWhitelistFlag,
 		utils.SuaveDevModeFlag,
 	}
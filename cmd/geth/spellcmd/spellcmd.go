@@ -1,12 +1,13 @@
 package spellcmd
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
-
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,12 +15,15 @@ import (
 	ethgoabi "github.com/umbracle/ethgo/abi"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/suave/artifacts"
 	"github.com/ethereum/go-ethereum/suave/sdk"
 
 	"github.com/urfave/cli/v2"
@@ -53,6 +57,10 @@ var (
 		Name:  "confidential-input",
 		Usage: "The confidential input to use for the confidential request",
 	}
+	simulateFlag = &cli.BoolFlag{
+		Name:  "simulate",
+		Usage: "Simulate the confidential request before sending it",
+	}
 )
 
 var (
@@ -63,6 +71,7 @@ var (
 		Subcommands: []*cli.Command{
 			deployCmd,
 			confRequestCmd,
+			simulateCmd,
 		},
 	}
 
@@ -129,78 +138,28 @@ var (
 			rpcFlag,
 			artifactsDirFlag,
 			confidentialInput,
+			simulateFlag,
 		},
 		Action: func(ctx *cli.Context) error {
-			args := ctx.Args().Slice()
-			if len(args) < 2 {
-				return fmt.Errorf("expected at least 2 arguments (contract address, method signature), got %d", len(args))
-			}
-
-			clt, err := getClient(ctx)
+			req, err := parseConfRequest(ctx)
 			if err != nil {
 				return err
 			}
 
-			var confInput []byte
-			if input := ctx.String(confidentialInput.Name); input != "" {
-				if strings.HasPrefix(input, "0x") {
-					if confInput, err = hexutil.Decode(input); err != nil {
-						return fmt.Errorf("failed to decode hex confidential input: %w", err)
-					}
-				} else {
-					confInput = []byte(input)
-				}
-				log.Info("Confidential input provided", "input", confInput)
-			} else {
-				log.Info("No confidential input provided, using empty string")
-			}
-
-			var contractAddr common.Address
-			if err := contractAddr.UnmarshalText([]byte(args[0])); err != nil {
-				return err
-			}
-
-			log.Info(fmt.Sprintf("Contract at address %s", contractAddr.String()))
-
-			methodSig := args[1]
-			if !strings.HasPrefix("function ", methodSig) {
-				// ethgo requires the method signature to start with "function "
-				methodSig = "function " + methodSig
-			}
-			method, err := ethgoabi.NewMethod(methodSig)
+			clt, err := getClient(ctx)
 			if err != nil {
-				return fmt.Errorf("failed to parse method signature: %w", err)
+				return err
 			}
 
-			var methodArgs []interface{}
-			if len(args) == 3 {
-				// arguments are passed as an array of items '(0x000,1,2)'
-				methodArgsStr := args[2]
-
-				// verify it has brackets and remove them
-				if !strings.HasPrefix(methodArgsStr, "(") {
-					return fmt.Errorf("expected method arguments to start with '('")
-				}
-				if !strings.HasSuffix(methodArgsStr, ")") {
-					return fmt.Errorf("expected method arguments to end with ')'")
-				}
-
-				methodArgsStr = methodArgsStr[1 : len(methodArgsStr)-1]
-				parts := strings.Split(methodArgsStr, ",")
-
-				for _, part := range parts {
-					methodArgs = append(methodArgs, strings.TrimSpace(part))
+			if ctx.Bool(simulateFlag.Name) {
+				if err := simulateConfRequest(ctx, clt, req); err != nil {
+					return err
 				}
 			}
 
-			calldata, err := method.Encode(methodArgs)
-			if err != nil {
-				return err
-			}
-
 			log.Info("Sending offchain confidential compute request", "kettle", clt.KettleAddress().String())
 
-			hash, err := sendConfRequest(clt, contractAddr, calldata, confInput)
+			hash, err := sendConfRequest(clt, req.addr, req.calldata, req.confInput)
 			if err != nil {
 				return err
 			}
@@ -219,35 +178,117 @@ var (
 			log.Info("Transaction mined", "status", receipt.Status, "blockNum", receipt.BlockNumber)
 
 			if len(receipt.Logs) != 0 {
-				// If possible, try to use the artifact output folder to type decode the logs emitted
-				artifactEvents, err := resolveEventsInArtifactsFolder(ctx)
-				if err != nil {
-					log.Warn("could not decode events from artifacts")
-				}
-
 				log.Info("Logs emitted in the onchain transaction", "numLogs", len(receipt.Logs))
-				for _, rLog := range receipt.Logs {
-					prettyLogEmitted := false
-					if artifactEvents != nil {
-						prettyLogEmitted = artifactEvents.decodeLog(rLog)
-					}
-
-					// fallback to emit the log raw if the event was not found in the artifacts
-					if !prettyLogEmitted {
-						topic1 := "<none>"
-						if len(rLog.Topics) >= 1 {
-							topic1 = rLog.Topics[0].Hex()[:5]
-						}
-						log.Info("Log emitted", "address", rLog.Address.Hex(), "numTopics", len(rLog.Topics), "topic1", topic1)
-					}
-				}
+				decodeLogs(ctx, receipt.Logs)
 			}
 
 			return nil
 		},
 	}
+
+	simulateCmd = &cli.Command{
+		Name:  "simulate",
+		Usage: "Simulate a confidential request to a contract",
+		Description: `Simulate a confidential request to a contract, without sending it, and print
+its return values and the compute result decoded with the ABIs of the artifacts,
+the data records it creates and the calls it makes to the precompiles`,
+		Flags: []cli.Flag{
+			kettleAddressFlag,
+			privateKeyFlag,
+			rpcFlag,
+			artifactsDirFlag,
+			confidentialInput,
+		},
+		Action: func(ctx *cli.Context) error {
+			req, err := parseConfRequest(ctx)
+			if err != nil {
+				return err
+			}
+
+			clt, err := getClient(ctx)
+			if err != nil {
+				return err
+			}
+			return simulateConfRequest(ctx, clt, req)
+		},
+	}
 )
 
+// confRequest is a confidential request given in the arguments of a command.
+type confRequest struct {
+	addr      common.Address
+	calldata  []byte
+	confInput []byte
+}
+
+// parseConfRequest parses the contract address, the method signature and the
+// method arguments '(arg1,arg2)' of the command, and its confidential input.
+func parseConfRequest(ctx *cli.Context) (*confRequest, error) {
+	args := ctx.Args().Slice()
+	if len(args) < 2 {
+		return nil, fmt.Errorf("expected at least 2 arguments (contract address, method signature), got %d", len(args))
+	}
+
+	var (
+		req = &confRequest{}
+		err error
+	)
+	if input := ctx.String(confidentialInput.Name); input != "" {
+		if strings.HasPrefix(input, "0x") {
+			if req.confInput, err = hexutil.Decode(input); err != nil {
+				return nil, fmt.Errorf("failed to decode hex confidential input: %w", err)
+			}
+		} else {
+			req.confInput = []byte(input)
+		}
+		log.Info("Confidential input provided", "input", req.confInput)
+	} else {
+		log.Info("No confidential input provided, using empty string")
+	}
+
+	if err := req.addr.UnmarshalText([]byte(args[0])); err != nil {
+		return nil, err
+	}
+
+	log.Info(fmt.Sprintf("Contract at address %s", req.addr.String()))
+
+	methodSig := args[1]
+	if !strings.HasPrefix("function ", methodSig) {
+		// ethgo requires the method signature to start with "function "
+		methodSig = "function " + methodSig
+	}
+	method, err := ethgoabi.NewMethod(methodSig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse method signature: %w", err)
+	}
+
+	var methodArgs []interface{}
+	if len(args) == 3 {
+		// arguments are passed as an array of items '(0x000,1,2)'
+		methodArgsStr := args[2]
+
+		// verify it has brackets and remove them
+		if !strings.HasPrefix(methodArgsStr, "(") {
+			return nil, fmt.Errorf("expected method arguments to start with '('")
+		}
+		if !strings.HasSuffix(methodArgsStr, ")") {
+			return nil, fmt.Errorf("expected method arguments to end with ')'")
+		}
+
+		methodArgsStr = methodArgsStr[1 : len(methodArgsStr)-1]
+		parts := strings.Split(methodArgsStr, ",")
+
+		for _, part := range parts {
+			methodArgs = append(methodArgs, strings.TrimSpace(part))
+		}
+	}
+
+	if req.calldata, err = method.Encode(methodArgs); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
 func getClient(ctx *cli.Context) (*sdk.Client, error) {
 	rpcClient, err := rpc.Dial(ctx.String(rpcFlag.Name))
 	if err != nil {
@@ -336,6 +377,166 @@ func sendConfRequest(client *sdk.Client, addr common.Address, calldata, confByte
 	return hash, nil
 }
 
+// simulateConfRequest simulates a confidential request on the kettle, and prints
+// its precompile calls, its results decoded with the artifacts and the records
+// it creates. It fails if the simulated request fails.
+func simulateConfRequest(ctx *cli.Context, client *sdk.Client, req *confRequest) error {
+	var (
+		senderAddr = client.SenderAddr()
+		kettleAddr = client.KettleAddress()
+	)
+
+	nonce, err := client.RPC().PendingNonceAt(context.Background(), senderAddr)
+	if err != nil {
+		return err
+	}
+
+	gasPrice, err := client.RPC().SuggestGasPrice(context.Background())
+	if err != nil {
+		return err
+	}
+
+	log.Info("Simulating offchain confidential compute request", "kettle", kettleAddr.String())
+
+	// the request is simulated with the same parameters it is sent with
+	gas := hexutil.Uint64(10000000)
+	var trace ethapi.ConfidentialCallTrace
+	err = client.RPC().Client().Call(&trace, "eth_traceConfidentialCall", ethapi.TransactionArgs{
+		From:               &senderAddr,
+		To:                 &req.addr,
+		Gas:                &gas,
+		GasPrice:           (*hexutil.Big)(gasPrice),
+		Nonce:              (*hexutil.Uint64)(&nonce),
+		KettleAddress:      &kettleAddr,
+		ConfidentialInputs: (*hexutil.Bytes)(&req.confInput),
+		Data:               (*hexutil.Bytes)(&req.calldata),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("Precompile calls in the simulation", "numCalls", len(trace.Precompiles))
+	for _, call := range trace.Precompiles {
+		logPrecompileCall(call)
+	}
+
+	if trace.Error != "" {
+		return fmt.Errorf("the simulated request failed: %s (data %s)", trace.Error, hexutil.Encode(trace.ReturnData))
+	}
+
+	// If possible, try to use the artifact output folder to type decode the results
+	abis, err := resolveAbisInArtifactsFolder(ctx)
+	if err != nil {
+		log.Warn("could not decode results from artifacts")
+	}
+
+	// the return values are decoded with the outputs of the method called, and
+	// the compute result with the inputs of the callback it calls
+	if method := abis.method(req.calldata); method != nil && method.Outputs != nil && len(trace.ReturnData) != 0 {
+		if decoded, err := ethgoabi.Decode(method.Outputs, trace.ReturnData); err == nil {
+			logDecoded("Return values", method, method.Outputs, decoded)
+		}
+	}
+	if method := abis.method(trace.ComputeResult); method != nil {
+		if decoded, err := ethgoabi.Decode(method.Inputs, trace.ComputeResult[4:]); err == nil {
+			logDecoded("Compute result", method, method.Inputs, decoded)
+		}
+	} else {
+		log.Info("Compute result", "data", hexutil.Encode(trace.ComputeResult))
+	}
+
+	log.Info("Records created in the simulation", "numRecords", len(trace.Records))
+	for _, record := range trace.Records {
+		log.Info("Record created", "id", record.Id, "version", record.Version, "decryptionCondition", uint64(record.DecryptionCondition),
+			"allowedPeekers", record.AllowedPeekers, "allowedStores", record.AllowedStores, "keys", record.Keys)
+	}
+
+	if len(trace.Logs) != 0 {
+		log.Info("Logs emitted in the simulation", "numLogs", len(trace.Logs))
+		decodeLogs(ctx, trace.Logs)
+	}
+	return nil
+}
+
+// logDecoded logs the values of a method decoded with one of its argument types.
+func logDecoded(msg string, method *ethgoabi.Method, typ *ethgoabi.Type, decoded interface{}) {
+	values, ok := decoded.(map[string]interface{})
+	if !ok {
+		return
+	}
+	decodedList := []interface{}{
+		"name", method.Sig(),
+	}
+	for indx, elem := range typ.TupleElems() {
+		name := elem.Name
+		if name == "" {
+			name = strconv.Itoa(indx)
+		}
+		value := values[name]
+		if data, ok := value.([]byte); ok {
+			value = hexutil.Bytes(data)
+		}
+		decodedList = append(decodedList, name, value)
+	}
+	log.Info(msg, decodedList...)
+}
+
+// maxTraceValueLen is the length the values in the precompile trace are cut at
+const maxTraceValueLen = 66
+
+// logPrecompileCall logs a call to a precompile, indented with its depth, with
+// its input and output decoded with the ABI of the suave precompiles.
+func logPrecompileCall(call *ethapi.PrecompileCall) {
+	var input, output string
+	if method, ok := artifacts.SuaveAbi.Methods[call.Name]; ok {
+		input = formatPrecompileValues(method.Inputs, call.Input)
+		output = formatPrecompileValues(method.Outputs, call.Output)
+	} else {
+		// the plugins of the kettle
+		input = formatTraceValue(call.Input)
+		output = formatTraceValue(call.Output)
+	}
+
+	msg := fmt.Sprintf("%s%s(%s)", strings.Repeat("  ", call.Depth-1), call.Name, input)
+	if call.Error != "" {
+		log.Info(msg, "caller", call.Caller, "err", call.Error)
+	} else {
+		log.Info(msg, "caller", call.Caller, "output", output)
+	}
+}
+
+// formatPrecompileValues formats the values packed with the arguments, or the
+// raw data if they are not ABI encoded.
+func formatPrecompileValues(args abi.Arguments, data []byte) string {
+	values, err := args.Unpack(data)
+	if err != nil {
+		return formatTraceValue(data)
+	}
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = formatTraceValue(value)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatTraceValue(value interface{}) string {
+	var str string
+	switch value := value.(type) {
+	case []byte:
+		str = hexutil.Encode(value)
+	case [16]byte:
+		str = hexutil.Encode(value[:])
+	case string:
+		str = strconv.Quote(value)
+	default:
+		str = fmt.Sprintf("%+v", value)
+	}
+	if len(str) > maxTraceValueLen {
+		str = str[:maxTraceValueLen] + "..."
+	}
+	return str
+}
+
 var (
 	txnMinedTimeout = 5 * time.Minute
 )
@@ -423,7 +624,66 @@ func (a artifactEvents) decodeLog(rawLog *types.Log) bool {
 	return false
 }
 
+// decodeLogs logs the logs, decoded with the events of the artifacts if possible.
+func decodeLogs(ctx *cli.Context, logs []*types.Log) {
+	// If possible, try to use the artifact output folder to type decode the logs emitted
+	artifactEvents, err := resolveEventsInArtifactsFolder(ctx)
+	if err != nil {
+		log.Warn("could not decode events from artifacts")
+	}
+
+	for _, rLog := range logs {
+		prettyLogEmitted := false
+		if artifactEvents != nil {
+			prettyLogEmitted = artifactEvents.decodeLog(rLog)
+		}
+
+		// fallback to emit the log raw if the event was not found in the artifacts
+		if !prettyLogEmitted {
+			topic1 := "<none>"
+			if len(rLog.Topics) >= 1 {
+				topic1 = rLog.Topics[0].Hex()[:5]
+			}
+			log.Info("Log emitted", "address", rLog.Address.Hex(), "numTopics", len(rLog.Topics), "topic1", topic1)
+		}
+	}
+}
+
 func resolveEventsInArtifactsFolder(ctx *cli.Context) (artifactEvents, error) {
+	abis, err := resolveAbisInArtifactsFolder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*ethgoabi.Event
+	for _, contractAbi := range abis {
+		for _, evnt := range contractAbi.Events {
+			events = append(events, evnt)
+		}
+	}
+	return artifactEvents(events), nil
+}
+
+// artifactAbis contains the ABIs of all the contracts in the artifacts folder
+type artifactAbis []*ethgoabi.ABI
+
+// method returns the method called by the calldata, or nil if none of the ABIs
+// has it.
+func (a artifactAbis) method(calldata []byte) *ethgoabi.Method {
+	if len(calldata) < 4 {
+		return nil
+	}
+	for _, contractAbi := range a {
+		for _, method := range contractAbi.Methods {
+			if bytes.Equal(method.ID(), calldata[:4]) {
+				return method
+			}
+		}
+	}
+	return nil
+}
+
+func resolveAbisInArtifactsFolder(ctx *cli.Context) (artifactAbis, error) {
 	outDir := ctx.String(artifactsDirFlag.Name)
 
 	// check if the directory exists or not
@@ -435,7 +695,7 @@ func resolveEventsInArtifactsFolder(ctx *cli.Context) (artifactEvents, error) {
 		return nil, fmt.Errorf("is not dir")
 	}
 
-	var events []*ethgoabi.Event
+	var abis []*ethgoabi.ABI
 	err = filepath.WalkDir(outDir, func(path string, d os.DirEntry, _ error) error {
 		if d.IsDir() {
 			return nil
@@ -452,9 +712,7 @@ func resolveEventsInArtifactsFolder(ctx *cli.Context) (artifactEvents, error) {
 		}
 
 		if artifact.Abi != nil {
-			for _, evnt := range artifact.Abi.Events {
-				events = append(events, evnt)
-			}
+			abis = append(abis, artifact.Abi)
 		}
 		return nil
 	})
@@ -462,5 +720,5 @@ func resolveEventsInArtifactsFolder(ctx *cli.Context) (artifactEvents, error) {
 		return nil, err
 	}
 
-	return artifactEvents(events), nil
+	return artifactAbis(abis), nil
 }
@@ -586,6 +586,57 @@ var (
 		Category: flags.SuaveCategory,
 	}
 
+	SuaveBeaconSpecFlag = &cli.StringSliceFlag{
+		Name:     "suave.beacon.spec",
+		EnvVars:  []string{"SUAVE_BEACON_SPEC"},
+		Usage:    "Beacon chain spec files (config.yaml) of additional networks to sign relay bids for",
+		Category: flags.SuaveCategory,
+	}
+
+	SuaveBeaconEndpointFlag = &cli.StringFlag{
+		Name:     "suave.beacon.endpoint",
+		EnvVars:  []string{"SUAVE_BEACON_ENDPOINT"},
+		Usage:    "Beacon node API followed for the slot and proposer data, 'mock' for a local mock",
+		Category: flags.SuaveCategory,
+	}
+
+	SuaveBundleRecordFlag = &cli.StringSliceFlag{
+		Name:     "suave.bundle-record",
+		EnvVars:  []string{"SUAVE_BUNDLE_RECORD"},
+		Usage:    "Additional record types to build blocks from, as <version>=<json|abi|mevshare>:<key>[,<ids key>]",
+		Category: flags.SuaveCategory,
+	}
+
+	SuavePluginFlag = &cli.StringSliceFlag{
+		Name:     "suave.plugin",
+		EnvVars:  []string{"SUAVE_PLUGIN"},
+		Usage:    "Spec files (JSON) of the precompiles served out of process by plugins",
+		Category: flags.SuaveCategory,
+	}
+
+	SuaveBuilderSimulationWorkersFlag = &cli.IntFlag{
+		Name:     "suave.builder.simulation-workers",
+		EnvVars:  []string{"SUAVE_BUILDER_SIMULATION_WORKERS"},
+		Usage:    "Number of workers simulating the batches of a builder session (0 = number of CPUs)",
+		Category: flags.SuaveCategory,
+	}
+
+	SuaveMevShareRefundPercentFlag = &cli.IntFlag{
+		Name:     "suave.mevshare.refund-percent",
+		EnvVars:  []string{"SUAVE_MEVSHARE_REFUND_PERCENT"},
+		Usage:    "Percent of the profit refunded to the user by the filled mev-share bundles whose user bundle sets no refund",
+		Value:    suave.DefaultConfig.MevShareRefundPercent,
+		Category: flags.SuaveCategory,
+	}
+
+	SuaveMevShareInclusionRangeFlag = &cli.Uint64Flag{
+		Name:     "suave.mevshare.inclusion-range",
+		EnvVars:  []string{"SUAVE_MEVSHARE_INCLUSION_RANGE"},
+		Usage:    "Number of blocks targeted by the filled mev-share bundles whose user bundle sets no max block",
+		Value:    suave.DefaultConfig.MevShareInclusionRange,
+		Category: flags.SuaveCategory,
+	}
+
 	SuaveDevModeFlag = &cli.BoolFlag{
 		Name:     "suave.dev",
 		Usage:    "Dev mode for suave",
@@ -1780,6 +1831,34 @@ func SetSuaveConfig(ctx *cli.Context, stack *node.Node, cfg *suave.Config) {
 		cfg.EthBlockSigningKeyHex = ctx.String(SuaveEthBlockSigningKeyFlag.Name)
 	}
 
+	if ctx.IsSet(SuaveBeaconSpecFlag.Name) {
+		cfg.BeaconSpecs = ctx.StringSlice(SuaveBeaconSpecFlag.Name)
+	}
+
+	if ctx.IsSet(SuaveBeaconEndpointFlag.Name) {
+		cfg.BeaconEndpoint = ctx.String(SuaveBeaconEndpointFlag.Name)
+	}
+
+	if ctx.IsSet(SuaveBundleRecordFlag.Name) {
+		cfg.BundleRecordTypes = ctx.StringSlice(SuaveBundleRecordFlag.Name)
+	}
+
+	if ctx.IsSet(SuavePluginFlag.Name) {
+		cfg.Plugins = ctx.StringSlice(SuavePluginFlag.Name)
+	}
+
+	if ctx.IsSet(SuaveBuilderSimulationWorkersFlag.Name) {
+		cfg.BuilderSimulationWorkers = ctx.Int(SuaveBuilderSimulationWorkersFlag.Name)
+	}
+
+	if ctx.IsSet(SuaveMevShareRefundPercentFlag.Name) {
+		cfg.MevShareRefundPercent = ctx.Int(SuaveMevShareRefundPercentFlag.Name)
+	}
+
+	if ctx.IsSet(SuaveMevShareInclusionRangeFlag.Name) {
+		cfg.MevShareInclusionRange = ctx.Uint64(SuaveMevShareInclusionRangeFlag.Name)
+	}
+
 	if ctx.IsSet(SuaveExternalWhitelistFlag.Name) {
 		cfg.ExternalWhitelist = ctx.StringSlice(SuaveExternalWhitelistFlag.Name)
 		if len(cfg.ExternalWhitelist) == 0 {
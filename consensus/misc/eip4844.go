@@ -36,6 +36,20 @@ func CalcBlobFee(excessDataGas *big.Int) *big.Int {
 	return fakeExponential(minDataGasPrice, excessDataGas, dataGaspriceUpdateFraction)
 }
 
+// CalcExcessDataGas calculates the excess data gas of a block from the excess
+// data gas of its parent and the data gas used by the blobs of the block.
+func CalcExcessDataGas(parentExcessDataGas *big.Int, blobGasUsed uint64) *big.Int {
+	excessDataGas := new(big.Int).SetUint64(blobGasUsed)
+	if parentExcessDataGas != nil {
+		excessDataGas.Add(excessDataGas, parentExcessDataGas)
+	}
+	target := new(big.Int).SetUint64(params.TargetBlobGasPerBlock)
+	if excessDataGas.Cmp(target) < 0 {
+		return new(big.Int)
+	}
+	return excessDataGas.Sub(excessDataGas, target)
+}
+
 // fakeExponential approximates factor * e ** (numerator / denominator) using
 // Taylor expansion.
 func fakeExponential(factor, numerator, denominator *big.Int) *big.Int {
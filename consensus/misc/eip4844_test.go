@@ -83,3 +83,23 @@ func TestFakeExponential(t *testing.T) {
 		}
 	}
 }
+
+func TestCalcExcessDataGas(t *testing.T) {
+	tests := []struct {
+		parentExcessDataGas *big.Int
+		blobGasUsed         uint64
+		want                int64
+	}{
+		{nil, 0, 0},
+		{nil, params.TargetBlobGasPerBlock, 0},
+		{nil, params.MaxBlobGasPerBlock, params.MaxBlobGasPerBlock - params.TargetBlobGasPerBlock},
+		{big.NewInt(params.BlobTxDataGasPerBlob), params.TargetBlobGasPerBlock, params.BlobTxDataGasPerBlob},
+		{big.NewInt(params.BlobTxDataGasPerBlob), 0, 0},
+	}
+	for i, tt := range tests {
+		have := CalcExcessDataGas(tt.parentExcessDataGas, tt.blobGasUsed)
+		if have.Int64() != tt.want {
+			t.Errorf("test %d: excess data gas mismatch: have %v want %v", i, have, tt.want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	errNoMultiTxSnapshot      = errors.New("no multi-transaction snapshot")
+	errInvalidMultiTxSnapshot = errors.New("multi-transaction snapshot invalidated by an intermediate root")
+)
+
+// multiTxSnapshot records the state as it was when the snapshot was taken. Unlike
+// the journal, it survives Finalise, so that several transactions can be reverted
+// at once. The accounts are copied the first time they are accessed afterwards.
+type multiTxSnapshot struct {
+	objects map[common.Address]*objectSnapshot
+	logs    map[common.Hash]int
+	logSize uint
+
+	// invalid is set once the tries are updated, which cannot be reverted.
+	invalid bool
+}
+
+// objectSnapshot is an account as it was when the snapshot was taken.
+type objectSnapshot struct {
+	object     *stateObject // nil if the account was not loaded
+	pending    bool
+	dirty      bool
+	destructed bool
+}
+
+// NewMultiTxSnapshot takes a snapshot of the state which can be reverted after
+// any number of transactions, as long as the state is only finalised with
+// Finalise in the meantime. It must be taken in between transactions, and be
+// either reverted or committed. The snapshots can be nested.
+func (s *StateDB) NewMultiTxSnapshot() {
+	snap := &multiTxSnapshot{
+		objects: make(map[common.Address]*objectSnapshot),
+		logs:    make(map[common.Hash]int, len(s.logs)),
+		logSize: s.logSize,
+	}
+	for hash, logs := range s.logs {
+		snap.logs[hash] = len(logs)
+	}
+	s.multiTxSnapshots = append(s.multiTxSnapshots, snap)
+}
+
+// MultiTxSnapshotRevert reverts the state to the last multi-transaction
+// snapshot and discards the snapshot. It must be called in between
+// transactions.
+func (s *StateDB) MultiTxSnapshotRevert() error {
+	snap, err := s.popMultiTxSnapshot()
+	if err != nil {
+		return err
+	}
+	for addr, prev := range snap.objects {
+		if prev.object == nil {
+			delete(s.stateObjects, addr)
+		} else {
+			s.stateObjects[addr] = prev.object
+		}
+		setMember(s.stateObjectsPending, addr, prev.pending)
+		setMember(s.stateObjectsDirty, addr, prev.dirty)
+		setMember(s.stateObjectsDestruct, addr, prev.destructed)
+	}
+	for hash, logs := range s.logs {
+		if n, ok := snap.logs[hash]; ok {
+			s.logs[hash] = logs[:n]
+		} else {
+			delete(s.logs, hash)
+		}
+	}
+	s.logSize = snap.logSize
+
+	s.journal = newJournal()
+	s.validRevisions = s.validRevisions[:0]
+	s.refund = 0
+	return nil
+}
+
+// MultiTxSnapshotCommit discards the last multi-transaction snapshot and keeps
+// the changes made since it was taken.
+func (s *StateDB) MultiTxSnapshotCommit() error {
+	snap, err := s.popMultiTxSnapshot()
+	if err != nil {
+		return err
+	}
+	// the accounts of the enclosing snapshot are the ones taken the earliest
+	if n := len(s.multiTxSnapshots); n > 0 {
+		parent := s.multiTxSnapshots[n-1]
+		for addr, prev := range snap.objects {
+			if _, ok := parent.objects[addr]; !ok {
+				parent.objects[addr] = prev
+			}
+		}
+	}
+	return nil
+}
+
+func (s *StateDB) popMultiTxSnapshot() (*multiTxSnapshot, error) {
+	n := len(s.multiTxSnapshots)
+	if n == 0 {
+		return nil, errNoMultiTxSnapshot
+	}
+	snap := s.multiTxSnapshots[n-1]
+	s.multiTxSnapshots = s.multiTxSnapshots[:n-1]
+	if snap.invalid {
+		return nil, errInvalidMultiTxSnapshot
+	}
+	return snap, nil
+}
+
+// recordMultiTxSnapshot copies the account in the last multi-transaction snapshot
+// the first time it is accessed since the snapshot was taken.
+func (s *StateDB) recordMultiTxSnapshot(addr common.Address) {
+	snap := s.multiTxSnapshots[len(s.multiTxSnapshots)-1]
+	if _, ok := snap.objects[addr]; ok {
+		return
+	}
+	prev := &objectSnapshot{}
+	if obj := s.stateObjects[addr]; obj != nil {
+		prev.object = obj.deepCopy(s)
+	}
+	_, prev.pending = s.stateObjectsPending[addr]
+	_, prev.dirty = s.stateObjectsDirty[addr]
+	_, prev.destructed = s.stateObjectsDestruct[addr]
+	snap.objects[addr] = prev
+}
+
+// invalidateMultiTxSnapshots marks the multi-transaction snapshots as not
+// revertible anymore, once the changes are written to the tries.
+func (s *StateDB) invalidateMultiTxSnapshots() {
+	for _, snap := range s.multiTxSnapshots {
+		snap.invalid = true
+	}
+}
+
+func setMember(set map[common.Address]struct{}, addr common.Address, member bool) {
+	if member {
+		set[addr] = struct{}{}
+	} else {
+		delete(set, addr)
+	}
+}
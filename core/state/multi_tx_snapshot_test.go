@@ -0,0 +1,142 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func newMultiTxTestState(t *testing.T) *StateDB {
+	db := NewDatabase(rawdb.NewMemoryDatabase())
+	state, _ := New(types.EmptyRootHash, db, nil)
+	for i := byte(1); i < 4; i++ {
+		addr := common.BytesToAddress([]byte{i})
+		state.AddBalance(addr, big.NewInt(int64(i)))
+		state.SetState(addr, common.Hash{i}, common.Hash{i})
+	}
+	root, err := state.Commit(false)
+	if err != nil {
+		t.Fatalf("could not commit state: %v", err)
+	}
+	state, _ = New(root, db, nil)
+
+	// a first transaction, before the snapshot
+	state.SetTxContext(common.Hash{0x1}, 0)
+	state.AddBalance(common.BytesToAddress([]byte{1}), big.NewInt(10))
+	state.AddLog(&types.Log{})
+	state.Finalise(true)
+	return state
+}
+
+// applyMultiTxTestTxs applies two transactions touching loaded, not loaded and
+// new accounts.
+func applyMultiTxTestTxs(state *StateDB) {
+	state.SetTxContext(common.Hash{0x2}, 1)
+	state.AddBalance(common.BytesToAddress([]byte{1}), big.NewInt(5))
+	state.SetState(common.BytesToAddress([]byte{2}), common.Hash{2}, common.Hash{0x22})
+	state.AddLog(&types.Log{})
+	state.Finalise(true)
+
+	state.SetTxContext(common.Hash{0x3}, 2)
+	state.CreateAccount(common.BytesToAddress([]byte{4}))
+	state.AddBalance(common.BytesToAddress([]byte{4}), big.NewInt(4))
+	state.Suicide(common.BytesToAddress([]byte{3}))
+	state.AddLog(&types.Log{})
+	state.Finalise(true)
+}
+
+func TestMultiTxSnapshotRevert(t *testing.T) {
+	state := newMultiTxTestState(t)
+	want := state.Copy().IntermediateRoot(true)
+
+	state.NewMultiTxSnapshot()
+	applyMultiTxTestTxs(state)
+	if root := state.Copy().IntermediateRoot(true); root == want {
+		t.Fatalf("expected the transactions to change the state")
+	}
+	if err := state.MultiTxSnapshotRevert(); err != nil {
+		t.Fatalf("could not revert: %v", err)
+	}
+
+	if root := state.IntermediateRoot(true); root != want {
+		t.Fatalf("state root mismatch: have %x, want %x", root, want)
+	}
+	if logs := state.Logs(); len(logs) != 1 {
+		t.Fatalf("expected 1 log, got %d", len(logs))
+	}
+	if balance := state.GetBalance(common.BytesToAddress([]byte{1})); balance.Cmp(big.NewInt(11)) != 0 {
+		t.Fatalf("balance mismatch: have %v, want 11", balance)
+	}
+	if state.Exist(common.BytesToAddress([]byte{4})) {
+		t.Fatalf("expected the created account to be reverted")
+	}
+	if err := state.MultiTxSnapshotRevert(); !errors.Is(err, errNoMultiTxSnapshot) {
+		t.Fatalf("expected %v, got %v", errNoMultiTxSnapshot, err)
+	}
+}
+
+func TestMultiTxSnapshotNested(t *testing.T) {
+	state := newMultiTxTestState(t)
+	want := state.Copy().IntermediateRoot(true)
+
+	state.NewMultiTxSnapshot()
+
+	// the changes of a committed nested snapshot are reverted with the enclosing one
+	state.NewMultiTxSnapshot()
+	applyMultiTxTestTxs(state)
+	if err := state.MultiTxSnapshotCommit(); err != nil {
+		t.Fatalf("could not commit: %v", err)
+	}
+	applied := state.Copy().IntermediateRoot(true)
+
+	// a reverted nested snapshot leaves the enclosing one untouched
+	state.NewMultiTxSnapshot()
+	state.SetTxContext(common.Hash{0x4}, 3)
+	state.AddBalance(common.BytesToAddress([]byte{5}), big.NewInt(5))
+	state.SetState(common.BytesToAddress([]byte{1}), common.Hash{1}, common.Hash{0x11})
+	state.Finalise(true)
+	if err := state.MultiTxSnapshotRevert(); err != nil {
+		t.Fatalf("could not revert: %v", err)
+	}
+	if root := state.Copy().IntermediateRoot(true); root != applied {
+		t.Fatalf("state root mismatch: have %x, want %x", root, applied)
+	}
+
+	if err := state.MultiTxSnapshotRevert(); err != nil {
+		t.Fatalf("could not revert: %v", err)
+	}
+	if root := state.IntermediateRoot(true); root != want {
+		t.Fatalf("state root mismatch: have %x, want %x", root, want)
+	}
+}
+
+func TestMultiTxSnapshotIntermediateRoot(t *testing.T) {
+	state := newMultiTxTestState(t)
+
+	state.NewMultiTxSnapshot()
+	applyMultiTxTestTxs(state)
+	state.IntermediateRoot(true)
+	if err := state.MultiTxSnapshotRevert(); !errors.Is(err, errInvalidMultiTxSnapshot) {
+		t.Fatalf("expected %v, got %v", errInvalidMultiTxSnapshot, err)
+	}
+}
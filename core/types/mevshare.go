@@ -0,0 +1,382 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// MevShareBundleVersion is the version of the mev-share bundle format.
+	MevShareBundleVersion = "v0.1"
+
+	// MevShareMaxNesting is the maximum depth of the bundles nested in a bundle.
+	MevShareMaxNesting = 1
+	// MevShareMaxBodySize is the maximum number of body elements of a bundle,
+	// including the elements of its nested bundles.
+	MevShareMaxBodySize = 50
+	// MevShareMaxInclusionRange is the maximum number of blocks a bundle can target.
+	MevShareMaxInclusionRange = 30
+)
+
+// Privacy hints of the mev-share bundles, the parts of the bundle shared with the searchers.
+const (
+	MevShareHintCalldata         = "calldata"
+	MevShareHintContractAddress  = "contract_address"
+	MevShareHintLogs             = "logs"
+	MevShareHintFunctionSelector = "function_selector"
+	MevShareHintHash             = "hash"
+	MevShareHintTxHash           = "tx_hash"
+)
+
+// Hint policy bits, selecting the parts of a bundle shared in an orderflow auction.
+const (
+	HintContractAddress uint64 = 1 << iota
+	HintFunctionSelector
+	HintCalldata
+	HintLogs
+	HintTxHash
+	HintValue
+)
+
+var mevShareHintPolicy = []struct {
+	bit  uint64
+	hint string
+}{
+	{HintContractAddress, MevShareHintContractAddress},
+	{HintFunctionSelector, MevShareHintFunctionSelector},
+	{HintCalldata, MevShareHintCalldata},
+	{HintLogs, MevShareHintLogs},
+	{HintTxHash, MevShareHintTxHash},
+}
+
+// MevShareHints returns the mev-share privacy hints of the hint policy. The
+// value has no mev-share hint.
+func MevShareHints(policy uint64) []string {
+	var hints []string
+	for _, h := range mevShareHintPolicy {
+		if policy&h.bit != 0 {
+			hints = append(hints, h.hint)
+		}
+	}
+	return hints
+}
+
+var (
+	ErrMevShareUnmatchedHash = errors.New("bundle references a transaction by hash")
+	ErrMevShareNestedRefund  = errors.New("nested bundle refunds are not supported")
+	errMevShareEmptyBody     = errors.New("bundle has an empty body")
+)
+
+// MevShareBundle is a bundle in the format of the mev-share API (mev_sendBundle).
+// Its body holds transactions, references to transactions by hash, and nested bundles.
+type MevShareBundle struct {
+	Version   string            `json:"version"`
+	Inclusion MevShareInclusion `json:"inclusion"`
+	Body      []MevShareBody    `json:"body"`
+	Validity  MevShareValidity  `json:"validity"`
+	Privacy   *MevSharePrivacy  `json:"privacy,omitempty"`
+}
+
+// MevShareInclusion is the range of blocks the bundle is valid for.
+type MevShareInclusion struct {
+	Block    hexutil.Uint64 `json:"block"`
+	MaxBlock hexutil.Uint64 `json:"maxBlock,omitempty"`
+}
+
+// MevShareBody is an element of the body of a bundle. Exactly one of Hash, Tx
+// and Bundle is set.
+type MevShareBody struct {
+	Hash      *common.Hash
+	Tx        *Transaction
+	CanRevert bool
+	Bundle    *MevShareBundle
+}
+
+// MevShareValidity are the constraints of the bundle: the share of the profit
+// refunded to the senders of the body elements, and how it is split.
+type MevShareValidity struct {
+	Refund       []MevShareRefund `json:"refund,omitempty"`
+	RefundConfig []RefundConfig   `json:"refundConfig,omitempty"`
+}
+
+// MevShareRefund is the percent of the bundle profit refunded to the sender
+// of the element BodyIdx of the body.
+type MevShareRefund struct {
+	BodyIdx int `json:"bodyIdx"`
+	Percent int `json:"percent"`
+}
+
+// MevSharePrivacy are the hints shared about the bundle and the builders it is sent to.
+type MevSharePrivacy struct {
+	Hints    []string `json:"hints,omitempty"`
+	Builders []string `json:"builders,omitempty"`
+}
+
+type rpcMevShareBody struct {
+	Hash      *common.Hash    `json:"hash,omitempty"`
+	Tx        hexutil.Bytes   `json:"tx,omitempty"`
+	CanRevert bool            `json:"canRevert,omitempty"`
+	Bundle    *MevShareBundle `json:"bundle,omitempty"`
+}
+
+func (b *MevShareBody) MarshalJSON() ([]byte, error) {
+	body := rpcMevShareBody{
+		Hash:      b.Hash,
+		CanRevert: b.CanRevert,
+		Bundle:    b.Bundle,
+	}
+	if b.Tx != nil {
+		txBytes, err := b.Tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		body.Tx = txBytes
+	}
+	return json.Marshal(&body)
+}
+
+func (b *MevShareBody) UnmarshalJSON(data []byte) error {
+	var body rpcMevShareBody
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+
+	b.Hash = body.Hash
+	b.CanRevert = body.CanRevert
+	b.Bundle = body.Bundle
+	b.Tx = nil
+	if len(body.Tx) != 0 {
+		var tx Transaction
+		if err := tx.UnmarshalBinary(body.Tx); err != nil {
+			return err
+		}
+		b.Tx = &tx
+	}
+	return nil
+}
+
+// Validate checks the bundle is well formed: a supported version, a valid
+// inclusion range, a bounded body and refunds within the profit.
+func (b *MevShareBundle) Validate() error {
+	size := 0
+	return b.validate(0, &size)
+}
+
+func (b *MevShareBundle) validate(depth int, size *int) error {
+	if depth > MevShareMaxNesting {
+		return fmt.Errorf("bundle nesting is deeper than %d", MevShareMaxNesting)
+	}
+	if b.Version != MevShareBundleVersion {
+		return fmt.Errorf("unsupported bundle version '%s'", b.Version)
+	}
+	if b.Inclusion.MaxBlock != 0 {
+		if b.Inclusion.MaxBlock < b.Inclusion.Block {
+			return fmt.Errorf("max block %d is before block %d", b.Inclusion.MaxBlock, b.Inclusion.Block)
+		}
+		if b.Inclusion.MaxBlock-b.Inclusion.Block > MevShareMaxInclusionRange {
+			return fmt.Errorf("inclusion range is longer than %d blocks", MevShareMaxInclusionRange)
+		}
+	}
+	if len(b.Body) == 0 {
+		return errMevShareEmptyBody
+	}
+
+	for i, body := range b.Body {
+		set := 0
+		for _, ok := range []bool{body.Hash != nil, body.Tx != nil, body.Bundle != nil} {
+			if ok {
+				set++
+			}
+		}
+		if set != 1 {
+			return fmt.Errorf("body %d must have exactly one of hash, tx or bundle", i)
+		}
+
+		if body.Bundle == nil {
+			*size++
+		} else {
+			inner := body.Bundle.Inclusion
+			if inner.Block < b.Inclusion.Block || (b.Inclusion.MaxBlock != 0 && inner.Block > b.Inclusion.MaxBlock) {
+				return fmt.Errorf("body %d bundle inclusion is outside of the bundle inclusion", i)
+			}
+			if err := body.Bundle.validate(depth+1, size); err != nil {
+				return fmt.Errorf("body %d: %w", i, err)
+			}
+		}
+		if *size > MevShareMaxBodySize {
+			return fmt.Errorf("bundle body is larger than %d", MevShareMaxBodySize)
+		}
+	}
+
+	total := 0
+	for _, refund := range b.Validity.Refund {
+		if refund.BodyIdx < 0 || refund.BodyIdx >= len(b.Body) {
+			return fmt.Errorf("refund body index %d out of range", refund.BodyIdx)
+		}
+		if refund.Percent < 0 || refund.Percent > 100 {
+			return fmt.Errorf("invalid refund percent %d", refund.Percent)
+		}
+		total += refund.Percent
+	}
+	if total > 100 {
+		return fmt.Errorf("refunds add up to %d percent", total)
+	}
+
+	if len(b.Validity.RefundConfig) != 0 {
+		total = 0
+		for _, config := range b.Validity.RefundConfig {
+			if config.Percent <= 0 {
+				return fmt.Errorf("invalid refund config percent %d", config.Percent)
+			}
+			total += config.Percent
+		}
+		if total != 100 {
+			return fmt.Errorf("refund config adds up to %d percent instead of 100", total)
+		}
+	}
+	return nil
+}
+
+// ToSBundle flattens the bundle and its nested bundles into a bundle executed
+// atomically by the builder, in the order of the bodies. Only the reverting
+// transactions marked with canRevert can fail. The refunds are paid to the
+// senders of the refunded bodies, or split as in the refund config. Bodies
+// referencing a transaction by hash must be resolved beforehand, and the nested
+// bundles cannot have refunds of their own.
+func (b *MevShareBundle) ToSBundle() (*SBundle, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	bundle := &SBundle{}
+	if b.Inclusion.Block != 0 {
+		bundle.BlockNumber = new(big.Int).SetUint64(uint64(b.Inclusion.Block))
+	}
+	if b.Inclusion.MaxBlock != 0 {
+		bundle.MaxBlock = new(big.Int).SetUint64(uint64(b.Inclusion.MaxBlock))
+	}
+	if err := b.flatten(bundle); err != nil {
+		return nil, err
+	}
+
+	// the refunds are always explicit recipients, so that the builder pays them
+	// whatever the size of the bundle
+	for _, refund := range b.Validity.Refund {
+		if len(b.Validity.RefundConfig) != 0 {
+			for _, config := range b.Validity.RefundConfig {
+				bundle.RefundConfig = append(bundle.RefundConfig, RefundConfig{
+					Address: config.Address,
+					Percent: refund.Percent * config.Percent / 100,
+				})
+			}
+			continue
+		}
+
+		sender, err := b.Body[refund.BodyIdx].sender()
+		if err != nil {
+			return nil, fmt.Errorf("could not get the refund recipient of body %d: %w", refund.BodyIdx, err)
+		}
+		bundle.RefundConfig = append(bundle.RefundConfig, RefundConfig{Address: sender, Percent: refund.Percent})
+	}
+	return bundle, nil
+}
+
+func (b *MevShareBundle) flatten(bundle *SBundle) error {
+	for _, body := range b.Body {
+		switch {
+		case body.Hash != nil:
+			return fmt.Errorf("%w: %s", ErrMevShareUnmatchedHash, body.Hash)
+		case body.Tx != nil:
+			bundle.Txs = append(bundle.Txs, body.Tx)
+			if body.CanRevert {
+				bundle.RevertingHashes = append(bundle.RevertingHashes, body.Tx.Hash())
+			}
+		case body.Bundle != nil:
+			if len(body.Bundle.Validity.Refund) != 0 || len(body.Bundle.Validity.RefundConfig) != 0 {
+				return ErrMevShareNestedRefund
+			}
+			if err := body.Bundle.flatten(bundle); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sender returns the sender of the first transaction of the body.
+func (b *MevShareBody) sender() (common.Address, error) {
+	switch {
+	case b.Tx != nil:
+		return Sender(LatestSignerForChainID(b.Tx.ChainId()), b.Tx)
+	case b.Bundle != nil:
+		return b.Bundle.Body[0].sender()
+	default:
+		return common.Address{}, ErrMevShareUnmatchedHash
+	}
+}
+
+// NewMevShareBundle returns the mev-share bundle of the transactions of the
+// bundle. The refund percent of the bundle refunds the first body, and its
+// refund config is kept as the split of the refund. The refund percents must
+// not be negative nor add up to more than 100.
+func NewMevShareBundle(bundle *SBundle) (*MevShareBundle, error) {
+	share := &MevShareBundle{
+		Version: MevShareBundleVersion,
+	}
+	if bundle.BlockNumber != nil {
+		share.Inclusion.Block = hexutil.Uint64(bundle.BlockNumber.Uint64())
+	}
+	if bundle.MaxBlock != nil {
+		share.Inclusion.MaxBlock = hexutil.Uint64(bundle.MaxBlock.Uint64())
+	}
+
+	reverting := make(map[common.Hash]bool, len(bundle.RevertingHashes))
+	for _, hash := range bundle.RevertingHashes {
+		reverting[hash] = true
+	}
+	for _, tx := range bundle.Txs {
+		share.Body = append(share.Body, MevShareBody{Tx: tx, CanRevert: reverting[tx.Hash()]})
+	}
+
+	switch {
+	case len(bundle.RefundConfig) != 0:
+		total := 0
+		for _, config := range bundle.RefundConfig {
+			if config.Percent < 0 {
+				return nil, fmt.Errorf("negative refund percent for %s", config.Address)
+			}
+			total += config.Percent
+		}
+		if total > 100 {
+			return nil, fmt.Errorf("refund percents add up to %d", total)
+		}
+		if total == 0 {
+			break
+		}
+		share.Validity.Refund = []MevShareRefund{{BodyIdx: 0, Percent: total}}
+		// the first recipient gets the rounding remainder
+		remainder := 100
+		for _, config := range bundle.RefundConfig {
+			percent := config.Percent * 100 / total
+			if percent == 0 {
+				continue
+			}
+			remainder -= percent
+			share.Validity.RefundConfig = append(share.Validity.RefundConfig, RefundConfig{Address: config.Address, Percent: percent})
+		}
+		if len(share.Validity.RefundConfig) > 0 {
+			share.Validity.RefundConfig[0].Percent += remainder
+		}
+	case bundle.RefundPercent != nil:
+		if percent := *bundle.RefundPercent; percent < 0 || percent > 100 {
+			return nil, fmt.Errorf("invalid refund percent %d", percent)
+		}
+		share.Validity.Refund = []MevShareRefund{{BodyIdx: 0, Percent: *bundle.RefundPercent}}
+	}
+	return share, nil
+}
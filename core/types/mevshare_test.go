@@ -0,0 +1,243 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newMevShareTestTx(t *testing.T, nonce uint64) (*Transaction, common.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	tx, err := SignTx(NewTransaction(nonce, common.Address{0x1}, big.NewInt(1), 21000, big.NewInt(1), nil), LatestSignerForChainID(big.NewInt(1)), key)
+	require.NoError(t, err)
+	return tx, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func TestMevShareBundleJSON(t *testing.T) {
+	tx, _ := newMevShareTestTx(t, 0)
+	hash := common.Hash{0x1}
+
+	bundle := &MevShareBundle{
+		Version:   MevShareBundleVersion,
+		Inclusion: MevShareInclusion{Block: 1, MaxBlock: 2},
+		Body: []MevShareBody{
+			{Hash: &hash},
+			{Bundle: &MevShareBundle{
+				Version:   MevShareBundleVersion,
+				Inclusion: MevShareInclusion{Block: 1},
+				Body:      []MevShareBody{{Tx: tx, CanRevert: true}},
+			}},
+		},
+		Validity: MevShareValidity{Refund: []MevShareRefund{{BodyIdx: 0, Percent: 90}}},
+		Privacy:  &MevSharePrivacy{Hints: []string{MevShareHintCalldata, MevShareHintLogs}},
+	}
+
+	data, err := json.Marshal(bundle)
+	require.NoError(t, err)
+
+	var decoded MevShareBundle
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, hash, *decoded.Body[0].Hash)
+	require.Equal(t, tx.Hash(), decoded.Body[1].Bundle.Body[0].Tx.Hash())
+	require.True(t, decoded.Body[1].Bundle.Body[0].CanRevert)
+	require.Equal(t, bundle.Validity, decoded.Validity)
+	require.Equal(t, bundle.Privacy, decoded.Privacy)
+
+	// the spec encoding of the bodies
+	var raw map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &raw))
+	require.Equal(t, "0x1", raw["inclusion"].(map[string]interface{})["block"])
+	require.Equal(t, hash.Hex(), raw["body"].([]interface{})[0].(map[string]interface{})["hash"])
+}
+
+func TestMevShareBundleValidate(t *testing.T) {
+	tx, _ := newMevShareTestTx(t, 0)
+
+	valid := func() *MevShareBundle {
+		return &MevShareBundle{
+			Version:   MevShareBundleVersion,
+			Inclusion: MevShareInclusion{Block: 10, MaxBlock: 20},
+			Body:      []MevShareBody{{Tx: tx}},
+		}
+	}
+	require.NoError(t, valid().Validate())
+
+	cases := map[string]func(b *MevShareBundle){
+		"version":       func(b *MevShareBundle) { b.Version = "v0.2" },
+		"max block":     func(b *MevShareBundle) { b.Inclusion.MaxBlock = 9 },
+		"range":         func(b *MevShareBundle) { b.Inclusion.MaxBlock = 100 },
+		"empty body":    func(b *MevShareBundle) { b.Body = nil },
+		"empty element": func(b *MevShareBundle) { b.Body = []MevShareBody{{}} },
+		"two elements":  func(b *MevShareBundle) { b.Body[0].Hash = &common.Hash{} },
+		"refund index":  func(b *MevShareBundle) { b.Validity.Refund = []MevShareRefund{{BodyIdx: 1, Percent: 10}} },
+		"refund total": func(b *MevShareBundle) {
+			b.Body = append(b.Body, MevShareBody{Tx: tx})
+			b.Validity.Refund = []MevShareRefund{{BodyIdx: 0, Percent: 60}, {BodyIdx: 1, Percent: 60}}
+		},
+		"refund config": func(b *MevShareBundle) {
+			b.Validity.RefundConfig = []RefundConfig{{Address: common.Address{0x1}, Percent: 50}}
+		},
+		"nested inclusion": func(b *MevShareBundle) {
+			inner := valid()
+			inner.Inclusion.Block = 21
+			b.Body = append(b.Body, MevShareBody{Bundle: inner})
+		},
+		"nesting": func(b *MevShareBundle) {
+			inner, innermost := valid(), valid()
+			inner.Body = append(inner.Body, MevShareBody{Bundle: innermost})
+			b.Body = append(b.Body, MevShareBody{Bundle: inner})
+		},
+		"body size": func(b *MevShareBundle) {
+			for i := 0; i < MevShareMaxBodySize; i++ {
+				b.Body = append(b.Body, MevShareBody{Tx: tx})
+			}
+		},
+	}
+	for name, modify := range cases {
+		bundle := valid()
+		modify(bundle)
+		require.Error(t, bundle.Validate(), name)
+	}
+}
+
+func TestMevShareBundleToSBundle(t *testing.T) {
+	userTx, user := newMevShareTestTx(t, 0)
+	backrunTx, _ := newMevShareTestTx(t, 0)
+	otherTx, other := newMevShareTestTx(t, 0)
+
+	bundle := &MevShareBundle{
+		Version:   MevShareBundleVersion,
+		Inclusion: MevShareInclusion{Block: 10, MaxBlock: 12},
+		Body: []MevShareBody{
+			{Bundle: &MevShareBundle{
+				Version:   MevShareBundleVersion,
+				Inclusion: MevShareInclusion{Block: 10},
+				Body:      []MevShareBody{{Tx: userTx, CanRevert: true}},
+			}},
+			{Tx: otherTx},
+			{Tx: backrunTx},
+		},
+		Validity: MevShareValidity{Refund: []MevShareRefund{{BodyIdx: 0, Percent: 50}, {BodyIdx: 1, Percent: 20}}},
+	}
+
+	sbundle, err := bundle.ToSBundle()
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), sbundle.BlockNumber)
+	require.Equal(t, big.NewInt(12), sbundle.MaxBlock)
+	require.Equal(t, []common.Hash{userTx.Hash(), otherTx.Hash(), backrunTx.Hash()}, []common.Hash{sbundle.Txs[0].Hash(), sbundle.Txs[1].Hash(), sbundle.Txs[2].Hash()})
+	require.Equal(t, []common.Hash{userTx.Hash()}, sbundle.RevertingHashes)
+	require.Nil(t, sbundle.RefundPercent)
+	require.Equal(t, []RefundConfig{{Address: user, Percent: 50}, {Address: other, Percent: 20}}, sbundle.RefundConfig)
+
+	// the refund is split as in the refund config
+	bundle.Validity.RefundConfig = []RefundConfig{{Address: common.Address{0x1}, Percent: 80}, {Address: common.Address{0x2}, Percent: 20}}
+	bundle.Validity.Refund = []MevShareRefund{{BodyIdx: 0, Percent: 50}}
+	sbundle, err = bundle.ToSBundle()
+	require.NoError(t, err)
+	require.Equal(t, []RefundConfig{{Address: common.Address{0x1}, Percent: 40}, {Address: common.Address{0x2}, Percent: 10}}, sbundle.RefundConfig)
+
+	// the refunds of the nested bundles cannot be built
+	bundle.Body[0].Bundle.Validity.Refund = []MevShareRefund{{BodyIdx: 0, Percent: 10}}
+	_, err = bundle.ToSBundle()
+	require.ErrorIs(t, err, ErrMevShareNestedRefund)
+
+	// transactions referenced by hash cannot be built
+	hash := userTx.Hash()
+	bundle.Body[0] = MevShareBody{Hash: &hash}
+	_, err = bundle.ToSBundle()
+	require.ErrorIs(t, err, ErrMevShareUnmatchedHash)
+}
+
+func TestNewMevShareBundle(t *testing.T) {
+	tx, sender := newMevShareTestTx(t, 0)
+	revertingTx, _ := newMevShareTestTx(t, 1)
+	refundPercent := 30
+
+	bundle, err := NewMevShareBundle(&SBundle{
+		BlockNumber:     big.NewInt(5),
+		Txs:             Transactions{tx, revertingTx},
+		RevertingHashes: []common.Hash{revertingTx.Hash()},
+		RefundPercent:   &refundPercent,
+	})
+	require.NoError(t, err)
+	require.NoError(t, bundle.Validate())
+	require.Equal(t, MevShareInclusion{Block: 5}, bundle.Inclusion)
+	require.False(t, bundle.Body[0].CanRevert)
+	require.True(t, bundle.Body[1].CanRevert)
+	require.Equal(t, []MevShareRefund{{BodyIdx: 0, Percent: 30}}, bundle.Validity.Refund)
+
+	// back to the same bundle, the refund of the first body is an explicit recipient
+	sbundle, err := bundle.ToSBundle()
+	require.NoError(t, err)
+	require.Nil(t, sbundle.RefundPercent)
+	require.Equal(t, []RefundConfig{{Address: sender, Percent: refundPercent}}, sbundle.RefundConfig)
+	require.Equal(t, []common.Hash{revertingTx.Hash()}, sbundle.RevertingHashes)
+
+	// an explicit refund of 0 is kept as is
+	zero := 0
+	bundle, err = NewMevShareBundle(&SBundle{Txs: Transactions{tx}, RefundPercent: &zero})
+	require.NoError(t, err)
+	sbundle, err = bundle.ToSBundle()
+	require.NoError(t, err)
+	require.Equal(t, []RefundConfig{{Address: sender, Percent: 0}}, sbundle.RefundConfig)
+
+	// refund configs become the split of the refund
+	bundle, err = NewMevShareBundle(&SBundle{
+		Txs:          Transactions{tx},
+		RefundConfig: []RefundConfig{{Address: common.Address{0x1}, Percent: 10}, {Address: common.Address{0x2}, Percent: 20}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, bundle.Validate())
+	require.Equal(t, []MevShareRefund{{BodyIdx: 0, Percent: 30}}, bundle.Validity.Refund)
+	require.Equal(t, []RefundConfig{{Address: common.Address{0x1}, Percent: 34}, {Address: common.Address{0x2}, Percent: 66}}, bundle.Validity.RefundConfig)
+}
+
+func TestNewMevShareBundleInvalidRefunds(t *testing.T) {
+	tx, _ := newMevShareTestTx(t, 0)
+
+	// negative percents
+	_, err := NewMevShareBundle(&SBundle{
+		Txs:          Transactions{tx},
+		RefundConfig: []RefundConfig{{Address: common.Address{0x1}, Percent: 50}, {Address: common.Address{0x2}, Percent: -10}},
+	})
+	require.ErrorContains(t, err, "negative refund percent")
+
+	negative := -1
+	_, err = NewMevShareBundle(&SBundle{Txs: Transactions{tx}, RefundPercent: &negative})
+	require.Error(t, err)
+
+	// percents above 100
+	_, err = NewMevShareBundle(&SBundle{
+		Txs:          Transactions{tx},
+		RefundConfig: []RefundConfig{{Address: common.Address{0x1}, Percent: 60}, {Address: common.Address{0x2}, Percent: 50}},
+	})
+	require.ErrorContains(t, err, "add up to 110")
+
+	// many small recipients adding up to more than 100
+	var configs []RefundConfig
+	for i := 0; i < 101; i++ {
+		configs = append(configs, RefundConfig{Address: common.Address{byte(i)}, Percent: 1})
+	}
+	_, err = NewMevShareBundle(&SBundle{Txs: Transactions{tx}, RefundConfig: configs})
+	require.ErrorContains(t, err, "add up to 101")
+
+	// all the recipients at 0 refund nothing
+	bundle, err := NewMevShareBundle(&SBundle{
+		Txs:          Transactions{tx},
+		RefundConfig: []RefundConfig{{Address: common.Address{0x1}, Percent: 0}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, bundle.Validity.Refund)
+	require.Empty(t, bundle.Validity.RefundConfig)
+}
+
+func TestMevShareHints(t *testing.T) {
+	require.Nil(t, MevShareHints(0))
+	require.Equal(t, []string{MevShareHintContractAddress, MevShareHintLogs}, MevShareHints(HintContractAddress|HintLogs|HintValue))
+}
@@ -194,7 +194,7 @@ func (r *Receipt) decodeTyped(b []byte) error {
 		return errShortTypedReceipt
 	}
 	switch b[0] {
-	case DynamicFeeTxType, AccessListTxType, ConfidentialComputeRequestTxType, SuaveTxType:
+	case DynamicFeeTxType, AccessListTxType, BlobTxType, ConfidentialComputeRequestTxType, SuaveTxType:
 		var data receiptRLP
 		err := rlp.DecodeBytes(b[1:], &data)
 		if err != nil {
@@ -305,6 +305,9 @@ func (rs Receipts) EncodeIndex(i int, w *bytes.Buffer) {
 	case DynamicFeeTxType:
 		w.WriteByte(DynamicFeeTxType)
 		rlp.Encode(w, data)
+	case BlobTxType:
+		w.WriteByte(BlobTxType)
+		rlp.Encode(w, data)
 	case ConfidentialComputeRequestTxType:
 		w.WriteByte(ConfidentialComputeRequestTxType)
 		rlp.Encode(w, data)
@@ -11,11 +11,18 @@ import (
 // Simplified Share Bundle Type for PoC
 
 type SBundle struct {
-	BlockNumber     *big.Int      `json:"blockNumber,omitempty"` // if BlockNumber is set it must match DecryptionCondition!
-	MaxBlock        *big.Int      `json:"maxBlock,omitempty"`
-	Txs             Transactions  `json:"txs"`
-	RevertingHashes []common.Hash `json:"revertingHashes,omitempty"`
-	RefundPercent   *int          `json:"percent,omitempty"`
+	BlockNumber     *big.Int       `json:"blockNumber,omitempty"` // if BlockNumber is set it must match DecryptionCondition!
+	MaxBlock        *big.Int       `json:"maxBlock,omitempty"`
+	Txs             Transactions   `json:"txs"`
+	RevertingHashes []common.Hash  `json:"revertingHashes,omitempty"`
+	RefundPercent   *int           `json:"percent,omitempty"`
+	RefundConfig    []RefundConfig `json:"refundConfig,omitempty"`
+}
+
+// RefundConfig is a recipient of a share of the bundle profit.
+type RefundConfig struct {
+	Address common.Address `json:"address"`
+	Percent int            `json:"percent"`
 }
 
 type RpcSBundle struct {
@@ -24,6 +31,7 @@ type RpcSBundle struct {
 	Txs             []hexutil.Bytes `json:"txs"`
 	RevertingHashes []common.Hash   `json:"revertingHashes,omitempty"`
 	RefundPercent   *int            `json:"percent,omitempty"`
+	RefundConfig    []RefundConfig  `json:"refundConfig,omitempty"`
 }
 
 func (s *SBundle) MarshalJSON() ([]byte, error) {
@@ -42,11 +50,19 @@ func (s *SBundle) MarshalJSON() ([]byte, error) {
 		*blockNumber = hexutil.Big(*s.BlockNumber)
 	}
 
+	var maxBlock *hexutil.Big
+	if s.MaxBlock != nil {
+		maxBlock = new(hexutil.Big)
+		*maxBlock = hexutil.Big(*s.MaxBlock)
+	}
+
 	return json.Marshal(&RpcSBundle{
 		BlockNumber:     blockNumber,
+		MaxBlock:        maxBlock,
 		Txs:             txs,
 		RevertingHashes: s.RevertingHashes,
 		RefundPercent:   s.RefundPercent,
+		RefundConfig:    s.RefundConfig,
 	})
 }
 
@@ -72,24 +88,7 @@ func (s *SBundle) UnmarshalJSON(data []byte) error {
 	s.Txs = txs
 	s.RevertingHashes = rpcSBundle.RevertingHashes
 	s.RefundPercent = rpcSBundle.RefundPercent
+	s.RefundConfig = rpcSBundle.RefundConfig
 
 	return nil
 }
-
-type RPCMevShareBundle struct {
-	Version   string `json:"version"`
-	Inclusion struct {
-		Block    string `json:"block"`
-		MaxBlock string `json:"maxBlock"`
-	} `json:"inclusion"`
-	Body []struct {
-		Tx        string `json:"tx"`
-		CanRevert bool   `json:"canRevert"`
-	} `json:"body"`
-	Validity struct {
-		Refund []struct {
-			BodyIdx int `json:"bodyIdx"`
-			Percent int `json:"percent"`
-		} `json:"refund"`
-	} `json:"validity"`
-}
@@ -1,25 +1,53 @@
 // Code generated by suave/gen. DO NOT EDIT.
-// Hash: 93d0334f831efa37bf623305bc1fadbb86200c71f9e3c4a97337f7879f4216c1
+// Hash: 5104f60b291f4b2d27870e420886b6c1f908af10b5d69aecafeceffcf050ee07
 package types
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 type DataId [16]byte
 
 // Structs
 
-type BuildBlockArgs struct {
+type AccessListEntry struct {
+	Addr        common.Address
+	StorageKeys []common.Hash
+}
+
+type BeaconContext struct {
 	Slot           uint64
-	ProposerPubkey []byte
-	Parent         common.Hash
 	Timestamp      uint64
-	FeeRecipient   common.Address
-	GasLimit       uint64
+	ProposerIndex  uint64
+	ProposerPubkey []byte
 	Random         common.Hash
 	Withdrawals    []*Withdrawal
-	Extra          []byte
 	BeaconRoot     common.Hash
-	FillPending    bool
+	ParentHash     common.Hash
+}
+
+type BuildBlockArgs struct {
+	Slot               uint64
+	ProposerPubkey     []byte
+	Parent             common.Hash
+	Timestamp          uint64
+	FeeRecipient       common.Address
+	GasLimit           uint64
+	Random             common.Hash
+	Withdrawals        []*Withdrawal
+	Extra              []byte
+	BeaconRoot         common.Hash
+	FillPending        bool
+	Algorithm          string
+	FillPendingTimeout uint64
+}
+
+type BundleHint struct {
+	Txs  []*TxHint
+	Logs []*SimulatedLog
 }
 
 type DataRecord struct {
@@ -31,6 +59,50 @@ type DataRecord struct {
 	Version             string
 }
 
+type EthBlockHeader struct {
+	Hash         common.Hash
+	ParentHash   common.Hash
+	Number       uint64
+	Timestamp    uint64
+	Coinbase     common.Address
+	StateRoot    common.Hash
+	ReceiptsRoot common.Hash
+	GasLimit     uint64
+	GasUsed      uint64
+	BaseFee      *big.Int
+	PrevRandao   common.Hash
+	ExtraData    []byte
+}
+
+type EthReceipt struct {
+	TxHash            common.Hash
+	BlockHash         common.Hash
+	BlockNumber       uint64
+	TransactionIndex  uint64
+	Status            uint64
+	GasUsed           uint64
+	CumulativeGasUsed uint64
+	EffectiveGasPrice *big.Int
+	ContractAddress   common.Address
+	Logs              []*SimulatedLog
+}
+
+type EthTransaction struct {
+	TxType     uint8
+	ChainId    *big.Int
+	Nonce      uint64
+	GasPrice   *big.Int
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList []*AccessListEntry
+	BlobFeeCap *big.Int
+	BlobHashes []common.Hash
+}
+
 type HttpRequest struct {
 	Url                    string
 	Method                 string
@@ -46,11 +118,54 @@ type HttpResponse struct {
 	Error  []byte
 }
 
+type RelayBidTrace struct {
+	Slot                 uint64
+	ParentHash           common.Hash
+	BlockHash            common.Hash
+	BuilderPubkey        []byte
+	ProposerPubkey       []byte
+	ProposerFeeRecipient common.Address
+	GasLimit             uint64
+	GasUsed              uint64
+	Value                *big.Int
+	BlockNumber          uint64
+	NumTx                uint64
+	TimestampMs          uint64
+}
+
+type RelaySubmissionOptions struct {
+	Ssz        bool
+	Gzip       bool
+	MaxRetries uint64
+	Deadline   uint64
+}
+
+type RelaySubmissionResult struct {
+	RelayUrl   string
+	Success    bool
+	StatusCode uint64
+	Attempts   uint64
+	Error      string
+}
+
+type SimulateBundleResult struct {
+	Success           bool
+	Error             string
+	GasUsed           uint64
+	CoinbaseProfit    *big.Int
+	EffectiveGasPrice uint64
+	Logs              []*SimulatedLog
+}
+
 type SimulateTransactionResult struct {
-	Egp     uint64
-	Logs    []*SimulatedLog
-	Success bool
-	Error   string
+	Egp             uint64
+	Logs            []*SimulatedLog
+	Success         bool
+	Error           string
+	GasUsed         uint64
+	CoinbasePayment *big.Int
+	RevertReason    string
+	TouchedSlots    []*SimulatedStorageAccess
 }
 
 type SimulatedLog struct {
@@ -59,6 +174,28 @@ type SimulatedLog struct {
 	Topics []common.Hash
 }
 
+type SimulatedStorageAccess struct {
+	Addr  common.Address
+	Slots []common.Hash
+}
+
+type TxHint struct {
+	Hash             common.Hash
+	To               common.Address
+	Value            *big.Int
+	FunctionSelector [4]byte
+	CallData         []byte
+}
+
+type ValidatorDuty struct {
+	Slot           uint64
+	ValidatorIndex uint64
+	Pubkey         []byte
+	FeeRecipient   common.Address
+	GasLimit       uint64
+	Timestamp      uint64
+}
+
 type Withdrawal struct {
 	Index     uint64
 	Validator uint64
@@ -66,6 +203,36 @@ type Withdrawal struct {
 	Amount    uint64
 }
 
+// Errors
+
+// ContextValueNotFoundError is the typed error ContextValueNotFound of the precompiles.
+type ContextValueNotFoundError struct {
+	Key string
+}
+
+func (e ContextValueNotFoundError) Error() string {
+	return fmt.Sprintf("ContextValueNotFound(key: %q)", e.Key)
+}
+
+// DomainNotAllowedError is the typed error DomainNotAllowed of the precompiles.
+type DomainNotAllowedError struct {
+	Domain string
+}
+
+func (e DomainNotAllowedError) Error() string {
+	return fmt.Sprintf("DomainNotAllowed(domain: %q)", e.Domain)
+}
+
+// HttpRequestFailedError is the typed error HttpRequestFailed of the precompiles.
+type HttpRequestFailedError struct {
+	Status uint64
+	Body   []byte
+}
+
+func (e HttpRequestFailedError) Error() string {
+	return fmt.Sprintf("HttpRequestFailed(status: %v, body: 0x%x)", e.Status, e.Body)
+}
+
 type CryptoSignature uint8
 
 const (
@@ -118,8 +118,18 @@ func (tx *Transaction) EncodeRLP(w io.Writer) error {
 }
 
 // encodeTyped writes the canonical encoding of a typed transaction to w.
+// Blob transactions with a sidecar are written in the network encoding,
+// including the blobs.
 func (tx *Transaction) encodeTyped(w *bytes.Buffer) error {
 	w.WriteByte(tx.Type())
+	if blobTx, ok := tx.inner.(*BlobTx); ok && blobTx.Sidecar != nil {
+		return rlp.Encode(w, &blobTxWithBlobs{
+			BlobTx:      blobTx,
+			Blobs:       blobTx.Sidecar.Blobs,
+			Commitments: blobTx.Sidecar.Commitments,
+			Proofs:      blobTx.Sidecar.Proofs,
+		})
+	}
 	return rlp.Encode(w, tx.inner)
 }
 
@@ -200,9 +210,7 @@ func (tx *Transaction) decodeTyped(b []byte) (TxData, error) {
 		err := rlp.DecodeBytes(b[1:], &inner)
 		return &inner, err
 	case BlobTxType:
-		var inner BlobTx
-		err := rlp.DecodeBytes(b[1:], &inner)
-		return &inner, err
+		return decodeBlobTx(b[1:])
 	case ConfidentialComputeRequestTxType:
 		var inner ConfidentialComputeRequest
 		err := rlp.DecodeBytes(b[1:], &inner)
@@ -314,6 +322,54 @@ func (tx *Transaction) BlobGasFeeCap() *big.Int { return tx.inner.blobGasFeeCap(
 // BlobHashes returns the hases of the blob commitments for blob transactions, nil otherwise.
 func (tx *Transaction) BlobHashes() []common.Hash { return tx.inner.blobHashes() }
 
+// BlobTxSidecar returns the sidecar of a blob transaction, nil otherwise.
+func (tx *Transaction) BlobTxSidecar() *BlobTxSidecar {
+	if blobTx, ok := tx.inner.(*BlobTx); ok {
+		return blobTx.Sidecar
+	}
+	return nil
+}
+
+// WithoutBlobTxSidecar returns a copy of tx with the blob sidecar removed,
+// as included in the blocks.
+func (tx *Transaction) WithoutBlobTxSidecar() *Transaction {
+	blobTx, ok := tx.inner.(*BlobTx)
+	if !ok || blobTx.Sidecar == nil {
+		return tx
+	}
+	inner := blobTx.copy().(*BlobTx)
+	inner.Sidecar = nil
+
+	cpy := &Transaction{inner: inner, time: tx.time}
+	// the hash and the sender do not depend on the sidecar
+	if hash := tx.hash.Load(); hash != nil {
+		cpy.hash.Store(hash)
+	}
+	if from := tx.from.Load(); from != nil {
+		cpy.from.Store(from)
+	}
+	return cpy
+}
+
+// WithBlobTxSidecar returns a copy of the blob transaction tx with the sidecar attached.
+func (tx *Transaction) WithBlobTxSidecar(sidecar *BlobTxSidecar) (*Transaction, error) {
+	blobTx, ok := tx.inner.(*BlobTx)
+	if !ok {
+		return nil, ErrTxTypeNotSupported
+	}
+	inner := blobTx.copy().(*BlobTx)
+	inner.Sidecar = sidecar
+
+	cpy := &Transaction{inner: inner, time: tx.time}
+	if hash := tx.hash.Load(); hash != nil {
+		cpy.hash.Store(hash)
+	}
+	if from := tx.from.Load(); from != nil {
+		cpy.from.Store(from)
+	}
+	return cpy, nil
+}
+
 // Value returns the ether amount of the transaction.
 func (tx *Transaction) Value() *big.Int { return new(big.Int).Set(tx.inner.value()) }
 
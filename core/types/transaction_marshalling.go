@@ -23,6 +23,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/holiman/uint256"
 )
 
@@ -30,27 +31,30 @@ import (
 type txJSON struct {
 	Type hexutil.Uint64 `json:"type"`
 
-	ChainID                   *hexutil.Big     `json:"chainId,omitempty"`
-	Nonce                     *hexutil.Uint64  `json:"nonce"`
-	To                        *common.Address  `json:"to"`
-	Gas                       *hexutil.Uint64  `json:"gas"`
-	GasPrice                  *hexutil.Big     `json:"gasPrice"`
-	MaxPriorityFeePerGas      *hexutil.Big     `json:"maxPriorityFeePerGas"`
-	MaxFeePerGas              *hexutil.Big     `json:"maxFeePerGas"`
-	MaxFeePerDataGas          *hexutil.Big     `json:"maxFeePerDataGas,omitempty"`
-	Value                     *hexutil.Big     `json:"value"`
-	Input                     *hexutil.Bytes   `json:"input"`
-	AccessList                *AccessList      `json:"accessList,omitempty"`
-	BlobVersionedHashes       []common.Hash    `json:"blobVersionedHashes,omitempty"`
-	KettleAddress             *common.Address  `json:"kettleAddress,omitempty"`
-	ConfidentialInputsHash    *common.Hash     `json:"confidentialInputsHash,omitempty"`
-	IsEIP712                  *bool            `json:"iseip712,omitempty"`
-	ConfidentialInputs        *hexutil.Bytes   `json:"confidentialInputs,omitempty"`
-	RequestRecord             *json.RawMessage `json:"requestRecord,omitempty"`
-	ConfidentialComputeResult *hexutil.Bytes   `json:"confidentialComputeResult,omitempty"`
-	V                         *hexutil.Big     `json:"v"`
-	R                         *hexutil.Big     `json:"r"`
-	S                         *hexutil.Big     `json:"s"`
+	ChainID                   *hexutil.Big         `json:"chainId,omitempty"`
+	Nonce                     *hexutil.Uint64      `json:"nonce"`
+	To                        *common.Address      `json:"to"`
+	Gas                       *hexutil.Uint64      `json:"gas"`
+	GasPrice                  *hexutil.Big         `json:"gasPrice"`
+	MaxPriorityFeePerGas      *hexutil.Big         `json:"maxPriorityFeePerGas"`
+	MaxFeePerGas              *hexutil.Big         `json:"maxFeePerGas"`
+	MaxFeePerDataGas          *hexutil.Big         `json:"maxFeePerDataGas,omitempty"`
+	Value                     *hexutil.Big         `json:"value"`
+	Input                     *hexutil.Bytes       `json:"input"`
+	AccessList                *AccessList          `json:"accessList,omitempty"`
+	BlobVersionedHashes       []common.Hash        `json:"blobVersionedHashes,omitempty"`
+	Blobs                     []kzg4844.Blob       `json:"blobs,omitempty"`
+	Commitments               []kzg4844.Commitment `json:"commitments,omitempty"`
+	Proofs                    []kzg4844.Proof      `json:"proofs,omitempty"`
+	KettleAddress             *common.Address      `json:"kettleAddress,omitempty"`
+	ConfidentialInputsHash    *common.Hash         `json:"confidentialInputsHash,omitempty"`
+	IsEIP712                  *bool                `json:"iseip712,omitempty"`
+	ConfidentialInputs        *hexutil.Bytes       `json:"confidentialInputs,omitempty"`
+	RequestRecord             *json.RawMessage     `json:"requestRecord,omitempty"`
+	ConfidentialComputeResult *hexutil.Bytes       `json:"confidentialComputeResult,omitempty"`
+	V                         *hexutil.Big         `json:"v"`
+	R                         *hexutil.Big         `json:"r"`
+	S                         *hexutil.Big         `json:"s"`
 
 	// Only used for encoding:
 	Hash common.Hash `json:"hash"`
@@ -114,6 +118,11 @@ func (tx *Transaction) MarshalJSON() ([]byte, error) {
 		enc.Input = (*hexutil.Bytes)(&itx.Data)
 		enc.AccessList = &itx.AccessList
 		enc.BlobVersionedHashes = itx.BlobHashes
+		if itx.Sidecar != nil {
+			enc.Blobs = itx.Sidecar.Blobs
+			enc.Commitments = itx.Sidecar.Commitments
+			enc.Proofs = itx.Sidecar.Proofs
+		}
 		enc.To = tx.To()
 		enc.V = (*hexutil.Big)(itx.V.ToBig())
 		enc.R = (*hexutil.Big)(itx.R.ToBig())
@@ -381,6 +390,13 @@ func (tx *Transaction) UnmarshalJSON(input []byte) error {
 			return errors.New("missing required field 'blobVersionedHashes' in transaction")
 		}
 		itx.BlobHashes = dec.BlobVersionedHashes
+		if dec.Blobs != nil {
+			itx.Sidecar = &BlobTxSidecar{
+				Blobs:       dec.Blobs,
+				Commitments: dec.Commitments,
+				Proofs:      dec.Proofs,
+			}
+		}
 		itx.V = uint256.MustFromBig((*big.Int)(dec.V))
 		if dec.R == nil {
 			return errors.New("missing required field 'r' in transaction")
@@ -17,13 +17,24 @@
 package types
 
 import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/holiman/uint256"
 )
 
+// blobCommitmentVersionKZG is the version byte of the blob hashes of KZG commitments.
+const blobCommitmentVersionKZG uint8 = 0x01
+
+// ErrMissingBlobTxSidecar is returned when the blobs of a blob transaction are required.
+var ErrMissingBlobTxSidecar = errors.New("blob transaction without sidecar")
+
 // BlobTx represents an EIP-4844 transaction.
 type BlobTx struct {
 	ChainID    *uint256.Int
@@ -38,12 +49,95 @@ type BlobTx struct {
 	BlobFeeCap *uint256.Int // a.k.a. maxFeePerDataGas
 	BlobHashes []common.Hash
 
+	// A blob transaction can optionally contain blobs. This field must be set
+	// when the transaction is sent to a builder, and is left out of the blocks.
+	Sidecar *BlobTxSidecar `rlp:"-"`
+
 	// Signature values
 	V *uint256.Int `json:"v" gencodec:"required"`
 	R *uint256.Int `json:"r" gencodec:"required"`
 	S *uint256.Int `json:"s" gencodec:"required"`
 }
 
+// BlobTxSidecar contains the blobs of a blob transaction.
+type BlobTxSidecar struct {
+	Blobs       []kzg4844.Blob       // Blobs needed by the blob pool
+	Commitments []kzg4844.Commitment // Commitments needed by the blob pool
+	Proofs      []kzg4844.Proof      // Proofs needed by the blob pool
+}
+
+// BlobHashes computes the blob hashes of the commitments of the sidecar.
+func (sc *BlobTxSidecar) BlobHashes() []common.Hash {
+	hashes := make([]common.Hash, len(sc.Commitments))
+	for i, commitment := range sc.Commitments {
+		hashes[i] = kzgToVersionedHash(commitment)
+	}
+	return hashes
+}
+
+// Verify checks that the sidecar holds the blobs of the given blob hashes and
+// that the proofs of the blobs are valid.
+func (sc *BlobTxSidecar) Verify(hashes []common.Hash) error {
+	if len(sc.Blobs) != len(hashes) || len(sc.Commitments) != len(hashes) || len(sc.Proofs) != len(hashes) {
+		return fmt.Errorf("invalid number of %d blobs, %d commitments and %d proofs for %d blob hashes", len(sc.Blobs), len(sc.Commitments), len(sc.Proofs), len(hashes))
+	}
+	for i, hash := range sc.BlobHashes() {
+		if hash != hashes[i] {
+			return fmt.Errorf("blob %d: commitment hash %x does not match blob hash %x", i, hash, hashes[i])
+		}
+	}
+	for i := range sc.Blobs {
+		if err := kzg4844.VerifyBlobProof(sc.Blobs[i], sc.Commitments[i], sc.Proofs[i]); err != nil {
+			return fmt.Errorf("blob %d: invalid proof: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// kzgToVersionedHash computes the blob hash of a KZG commitment, as per EIP-4844.
+func kzgToVersionedHash(commitment kzg4844.Commitment) common.Hash {
+	hash := common.Hash(sha256.Sum256(commitment[:]))
+	hash[0] = blobCommitmentVersionKZG
+	return hash
+}
+
+// blobTxWithBlobs is the network encoding of a blob transaction with its sidecar.
+type blobTxWithBlobs struct {
+	BlobTx      *BlobTx
+	Blobs       []kzg4844.Blob
+	Commitments []kzg4844.Commitment
+	Proofs      []kzg4844.Proof
+}
+
+// decodeBlobTx decodes a blob transaction payload in either the canonical
+// encoding or the network encoding carrying the sidecar.
+func decodeBlobTx(b []byte) (TxData, error) {
+	elems, _, err := rlp.SplitList(b)
+	if err != nil {
+		return nil, err
+	}
+	kind, _, _, err := rlp.Split(elems)
+	if err != nil {
+		return nil, err
+	}
+	if kind != rlp.List {
+		var inner BlobTx
+		err := rlp.DecodeBytes(b, &inner)
+		return &inner, err
+	}
+
+	var inner blobTxWithBlobs
+	if err := rlp.DecodeBytes(b, &inner); err != nil {
+		return nil, err
+	}
+	inner.BlobTx.Sidecar = &BlobTxSidecar{
+		Blobs:       inner.Blobs,
+		Commitments: inner.Commitments,
+		Proofs:      inner.Proofs,
+	}
+	return inner.BlobTx, nil
+}
+
 // copy creates a deep copy of the transaction data and initializes all fields.
 func (tx *BlobTx) copy() TxData {
 	cpy := &BlobTx{
@@ -65,6 +159,8 @@ func (tx *BlobTx) copy() TxData {
 	}
 	copy(cpy.AccessList, tx.AccessList)
 	copy(cpy.BlobHashes, tx.BlobHashes)
+	// the sidecar is immutable
+	cpy.Sidecar = tx.Sidecar
 
 	if tx.Value != nil {
 		cpy.Value.Set(tx.Value)
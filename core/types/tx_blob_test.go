@@ -0,0 +1,108 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBlobTxSidecar(t *testing.T) *BlobTxSidecar {
+	var blob kzg4844.Blob
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	require.NoError(t, err)
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	require.NoError(t, err)
+
+	return &BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{blob},
+		Commitments: []kzg4844.Commitment{commitment},
+		Proofs:      []kzg4844.Proof{proof},
+	}
+}
+
+func newTestBlobTx(t *testing.T, sidecar *BlobTxSidecar) *Transaction {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	tx, err := SignNewTx(key, NewCancunSigner(big.NewInt(1)), &BlobTx{
+		ChainID:    uint256.NewInt(1),
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(1),
+		Gas:        21000,
+		To:         &common.Address{0x1},
+		Value:      uint256.NewInt(0),
+		BlobFeeCap: uint256.NewInt(1),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func TestBlobTxSidecarVerify(t *testing.T) {
+	sidecar := newTestBlobTxSidecar(t)
+	hashes := sidecar.BlobHashes()
+	require.Equal(t, blobCommitmentVersionKZG, hashes[0][0])
+	require.NoError(t, sidecar.Verify(hashes))
+
+	require.Error(t, sidecar.Verify(nil))
+	require.Error(t, sidecar.Verify([]common.Hash{{0x1}}))
+
+	invalid := &BlobTxSidecar{
+		Blobs:       sidecar.Blobs,
+		Commitments: sidecar.Commitments,
+		Proofs:      []kzg4844.Proof{{0x1}},
+	}
+	require.Error(t, invalid.Verify(hashes))
+}
+
+func TestBlobTxSidecarEncoding(t *testing.T) {
+	sidecar := newTestBlobTxSidecar(t)
+	tx := newTestBlobTx(t, sidecar)
+
+	// the binary encoding carries the sidecar
+	txBytes, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded Transaction
+	require.NoError(t, decoded.UnmarshalBinary(txBytes))
+	require.Equal(t, tx.Hash(), decoded.Hash())
+	require.Equal(t, sidecar, decoded.BlobTxSidecar())
+
+	// as does the json encoding
+	txJSON, err := json.Marshal(tx)
+	require.NoError(t, err)
+
+	var decodedJSON Transaction
+	require.NoError(t, json.Unmarshal(txJSON, &decodedJSON))
+	require.Equal(t, tx.Hash(), decodedJSON.Hash())
+	require.Equal(t, sidecar, decodedJSON.BlobTxSidecar())
+
+	// the transaction without the sidecar keeps its hash
+	stripped := tx.WithoutBlobTxSidecar()
+	require.Nil(t, stripped.BlobTxSidecar())
+	require.NotNil(t, tx.BlobTxSidecar())
+	require.Equal(t, tx.Hash(), stripped.Hash())
+
+	strippedBytes, err := stripped.MarshalBinary()
+	require.NoError(t, err)
+	require.Less(t, len(strippedBytes), len(txBytes))
+
+	var decodedStripped Transaction
+	require.NoError(t, decodedStripped.UnmarshalBinary(strippedBytes))
+	require.Equal(t, tx.Hash(), decodedStripped.Hash())
+	require.Nil(t, decodedStripped.BlobTxSidecar())
+
+	withSidecar, err := stripped.WithBlobTxSidecar(sidecar)
+	require.NoError(t, err)
+	require.Equal(t, sidecar, withSidecar.BlobTxSidecar())
+
+	_, err = NewTransaction(0, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), nil).WithBlobTxSidecar(sidecar)
+	require.ErrorIs(t, err, ErrTxTypeNotSupported)
+}
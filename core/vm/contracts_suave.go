@@ -276,7 +276,8 @@ func (s *suaveRuntime) doHTTPRequest2(request types.HttpRequest) (types.HttpResp
 	}
 
 	client := &http.Client{
-		Timeout: timeout,
+		Timeout:   timeout,
+		Transport: s.suaveContext.Backend.HttpTransport,
 	}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -310,7 +311,7 @@ func (m *suaveRuntime) doHTTPRequest(request types.HttpRequest) ([]byte, error)
 		return nil, err
 	}
 	if resp.Status > 299 {
-		return nil, fmt.Errorf("http error: %d: %v", resp.Status, resp.Body)
+		return nil, types.HttpRequestFailedError{Status: resp.Status, Body: resp.Body}
 	}
 	return resp.Body, err
 }
@@ -336,7 +337,7 @@ func (s *suaveRuntime) resolveURL(urlOrServiceName string) (string, error) {
 		}
 	}
 	if !allowed {
-		return "", fmt.Errorf("domain %s is not allowed", parsedURL.Hostname())
+		return "", types.DomainNotAllowedError{Domain: parsedURL.Hostname()}
 	}
 
 	return urlOrServiceName, nil
@@ -356,13 +357,17 @@ func (s *suaveRuntime) simulateTransaction(session string, txnBytes []byte) (typ
 	if err != nil {
 		return types.SimulateTransactionResult{}, err
 	}
+	if result.CoinbasePayment == nil {
+		// failed simulations do not report a payment and uint256 cannot be abi encoded from nil
+		result.CoinbasePayment = big.NewInt(0)
+	}
 	return *result, nil
 }
 
 func (s *suaveRuntime) contextGet(key string) ([]byte, error) {
 	val, ok := s.suaveContext.Context[key]
 	if !ok {
-		return nil, fmt.Errorf("value not found")
+		return nil, types.ContextValueNotFoundError{Key: key}
 	}
 	return val, nil
 }
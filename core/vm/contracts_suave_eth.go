@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"net/http"
 	"reflect"
@@ -15,11 +16,13 @@ import (
 	"github.com/ethereum/go-ethereum/beacon/dencun"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	suave_backends "github.com/ethereum/go-ethereum/suave/backends"
 	suave "github.com/ethereum/go-ethereum/suave/core"
+	"github.com/ethereum/go-ethereum/suave/relay"
 	"github.com/flashbots/go-boost-utils/bls"
 	"github.com/flashbots/go-boost-utils/ssz"
 	"github.com/holiman/uint256"
@@ -65,27 +68,182 @@ func (s *suaveRuntime) signEthTransaction(txn []byte, chainId string, signingKey
 	return signedBytes, nil
 }
 
+func (s *suaveRuntime) signEthTypedTransaction(txn types.EthTransaction, signingKey string) ([]byte, common.Hash, error) {
+	key, err := crypto.HexToECDSA(signingKey)
+	if err != nil {
+		return nil, common.Hash{}, fmt.Errorf("key not formatted properly: %w", err)
+	}
+
+	txData, err := newTxData(&txn)
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	// the transaction is signed for an Ethereum chain, which is not
+	// the chain of the kettle, so that the blob transactions are supported
+	var signer types.Signer = types.HomesteadSigner{}
+	if txn.ChainId.Sign() != 0 {
+		signer = types.NewCancunSigner(txn.ChainId)
+	}
+	signedTx, err := types.SignNewTx(key, signer, txData)
+	if err != nil {
+		return nil, common.Hash{}, fmt.Errorf("could not sign: %w", err)
+	}
+
+	signedBytes, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, common.Hash{}, fmt.Errorf("could not encode signed transaction: %w", err)
+	}
+
+	return signedBytes, signedTx.Hash(), nil
+}
+
+// newTxData builds the unsigned transaction of the given type.
+func newTxData(txn *types.EthTransaction) (types.TxData, error) {
+	var to *common.Address
+	if txn.To != (common.Address{}) {
+		to = &txn.To
+	}
+
+	var accessList types.AccessList
+	for _, entry := range txn.AccessList {
+		accessList = append(accessList, types.AccessTuple{
+			Address:     entry.Addr,
+			StorageKeys: entry.StorageKeys,
+		})
+	}
+
+	switch txn.TxType {
+	case types.LegacyTxType:
+		if len(txn.AccessList) != 0 || len(txn.BlobHashes) != 0 {
+			return nil, fmt.Errorf("legacy transactions have no access list nor blobs")
+		}
+		return &types.LegacyTx{
+			Nonce:    txn.Nonce,
+			GasPrice: txn.GasPrice,
+			Gas:      txn.Gas,
+			To:       to,
+			Value:    txn.Value,
+			Data:     txn.Data,
+		}, nil
+	case types.AccessListTxType:
+		if len(txn.BlobHashes) != 0 {
+			return nil, fmt.Errorf("access list transactions have no blobs")
+		}
+		return &types.AccessListTx{
+			ChainID:    txn.ChainId,
+			Nonce:      txn.Nonce,
+			GasPrice:   txn.GasPrice,
+			Gas:        txn.Gas,
+			To:         to,
+			Value:      txn.Value,
+			Data:       txn.Data,
+			AccessList: accessList,
+		}, nil
+	case types.DynamicFeeTxType:
+		if len(txn.BlobHashes) != 0 {
+			return nil, fmt.Errorf("dynamic fee transactions have no blobs")
+		}
+		return &types.DynamicFeeTx{
+			ChainID:    txn.ChainId,
+			Nonce:      txn.Nonce,
+			GasTipCap:  txn.GasTipCap,
+			GasFeeCap:  txn.GasFeeCap,
+			Gas:        txn.Gas,
+			To:         to,
+			Value:      txn.Value,
+			Data:       txn.Data,
+			AccessList: accessList,
+		}, nil
+	case types.BlobTxType:
+		if to == nil {
+			return nil, fmt.Errorf("blob transactions cannot create contracts")
+		}
+		if len(txn.BlobHashes) == 0 {
+			return nil, fmt.Errorf("blob transactions must have at least one blob")
+		}
+		if txn.ChainId.Sign() == 0 {
+			return nil, fmt.Errorf("blob transactions require a chain id")
+		}
+		return &types.BlobTx{
+			ChainID:    uint256.MustFromBig(txn.ChainId),
+			Nonce:      txn.Nonce,
+			GasTipCap:  uint256.MustFromBig(txn.GasTipCap),
+			GasFeeCap:  uint256.MustFromBig(txn.GasFeeCap),
+			Gas:        txn.Gas,
+			To:         to,
+			Value:      uint256.MustFromBig(txn.Value),
+			Data:       txn.Data,
+			AccessList: accessList,
+			BlobFeeCap: uint256.MustFromBig(txn.BlobFeeCap),
+			BlobHashes: txn.BlobHashes,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", txn.TxType)
+	}
+}
+
+var bundleSimulationTimeout = 5 * time.Second
+
+var relaySubmitter = relay.NewSubmitter(nil)
+
+// BuiltBlobs keeps the blobs of the recently built blocks by block hash. The
+// bids returned to the EVM do not carry the blobs, they are attached again
+// when the bids are submitted to the relays.
+type BuiltBlobs = lru.Cache[phase0.Hash32, *builderDeneb.BlobsBundle]
+
+// NewBuiltBlobs returns a cache for the blobs of the recently built blocks.
+func NewBuiltBlobs() *BuiltBlobs {
+	return lru.NewCache[phase0.Hash32, *builderDeneb.BlobsBundle](16)
+}
+
+var beaconContextTimeout = 5 * time.Second
+
+var ethStateTimeout = 5 * time.Second
+
 func (b *suaveRuntime) simulateBundle(input []byte) (uint64, error) {
-	var bundle types.SBundle
-	err := json.Unmarshal(input, &bundle)
+	result, err := b.doSimulateBundle(nil, input)
 	if err != nil {
 		return 0, err
 	}
+	if !result.Success {
+		return 0, fmt.Errorf("bundle simulation failed: %s", result.Error)
+	}
+	return result.EffectiveGasPrice, nil
+}
 
-	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Second))
+func (b *suaveRuntime) simulateBundleWithArgs(blockArgs types.BuildBlockArgs, bundleData []byte) (types.SimulateBundleResult, error) {
+	result, err := b.doSimulateBundle(&blockArgs, bundleData)
+	if err != nil {
+		return types.SimulateBundleResult{}, err
+	}
+	return *result, nil
+}
+
+// doSimulateBundle simulates the bundle on the configured execution node. If no
+// block arguments are given, the bundle is simulated on top of the current head.
+func (b *suaveRuntime) doSimulateBundle(blockArgs *types.BuildBlockArgs, bundleData []byte) (*types.SimulateBundleResult, error) {
+	var bundle types.SBundle
+	if err := json.Unmarshal(bundleData, &bundle); err != nil {
+		return nil, fmt.Errorf("could not unmarshal bundle: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), bundleSimulationTimeout)
 	defer cancel()
 
-	envelope, err := b.suaveContext.Backend.ConfidentialEthBackend.BuildEthBlock(ctx, nil, bundle.Txs)
+	result, err := b.suaveContext.Backend.ConfidentialEthBackend.SimulateBundle(ctx, blockArgs, &bundle)
 	if err != nil {
-		return 0, err
+		return nil, fmt.Errorf("could not simulate bundle: %w", err)
 	}
 
-	if envelope.ExecutionPayload.GasUsed == 0 {
-		return 0, err
+	// uint256 and dynamic arrays cannot be abi encoded from nil
+	if result.CoinbaseProfit == nil {
+		result.CoinbaseProfit = big.NewInt(0)
 	}
-
-	egp := new(big.Int).Div(envelope.BlockValue, big.NewInt(int64(envelope.ExecutionPayload.GasUsed)))
-	return egp.Uint64(), nil
+	if result.Logs == nil {
+		result.Logs = []*types.SimulatedLog{}
+	}
+	return result, nil
 }
 
 func (b *suaveRuntime) extractHint(bundleBytes []byte) ([]byte, error) {
@@ -94,15 +252,20 @@ func (b *suaveRuntime) extractHint(bundleBytes []byte) ([]byte, error) {
 	if err != nil {
 		return []byte(err.Error()), err
 	}
+	if len(bundle.Txs) == 0 {
+		return nil, fmt.Errorf("bundle has no transactions")
+	}
 
 	tx := bundle.Txs[0]
 	hint := struct {
 		To   common.Address
 		Data []byte
 	}{
-		To:   *tx.To(),
 		Data: tx.Data(),
 	}
+	if tx.To() != nil {
+		hint.To = *tx.To()
+	}
 
 	hintBytes, err := json.Marshal(hint)
 	if err != nil {
@@ -111,14 +274,185 @@ func (b *suaveRuntime) extractHint(bundleBytes []byte) ([]byte, error) {
 	return hintBytes, nil
 }
 
+func (b *suaveRuntime) extractHints(bundleData []byte, policy uint64) (types.BundleHint, error) {
+	var bundle types.SBundle
+	if err := json.Unmarshal(bundleData, &bundle); err != nil {
+		return types.BundleHint{}, fmt.Errorf("could not unmarshal bundle: %w", err)
+	}
+
+	hint := types.BundleHint{
+		Txs:  make([]*types.TxHint, len(bundle.Txs)),
+		Logs: []*types.SimulatedLog{},
+	}
+	for i, tx := range bundle.Txs {
+		txHint := &types.TxHint{Value: new(big.Int)}
+		if policy&types.HintTxHash != 0 {
+			txHint.Hash = tx.Hash()
+		}
+		if policy&types.HintContractAddress != 0 && tx.To() != nil {
+			txHint.To = *tx.To()
+		}
+		if policy&types.HintValue != 0 {
+			txHint.Value = tx.Value()
+		}
+		if policy&types.HintFunctionSelector != 0 && len(tx.Data()) >= 4 {
+			copy(txHint.FunctionSelector[:], tx.Data())
+		}
+		if policy&types.HintCalldata != 0 {
+			txHint.CallData = tx.Data()
+		}
+		hint.Txs[i] = txHint
+	}
+
+	if policy&types.HintLogs != 0 {
+		result, err := b.doSimulateBundle(nil, bundleData)
+		if err != nil {
+			return types.BundleHint{}, err
+		}
+		if !result.Success {
+			return types.BundleHint{}, fmt.Errorf("bundle simulation failed: %s", result.Error)
+		}
+		hint.Logs = append(hint.Logs, result.Logs...)
+	}
+	return hint, nil
+}
+
 func (b *suaveRuntime) ethcall(contractAddr common.Address, input []byte) ([]byte, error) {
 	return b.suaveContext.Backend.ConfidentialEthBackend.Call(context.Background(), contractAddr, input)
 }
 
+func (b *suaveRuntime) getNonce(account common.Address) (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ethStateTimeout)
+	defer cancel()
+
+	return b.suaveContext.Backend.ConfidentialEthBackend.PendingNonceAt(ctx, account)
+}
+
+func (b *suaveRuntime) getBalance(account common.Address) (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ethStateTimeout)
+	defer cancel()
+
+	return b.suaveContext.Backend.ConfidentialEthBackend.BalanceAt(ctx, account)
+}
+
+func (b *suaveRuntime) getCode(account common.Address) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ethStateTimeout)
+	defer cancel()
+
+	code, err := b.suaveContext.Backend.ConfidentialEthBackend.CodeAt(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if code == nil {
+		code = []byte{}
+	}
+	return code, nil
+}
+
+func (b *suaveRuntime) getStorageAt(account common.Address, slot common.Hash) (common.Hash, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ethStateTimeout)
+	defer cancel()
+
+	return b.suaveContext.Backend.ConfidentialEthBackend.StorageAt(ctx, account, slot)
+}
+
+// latestBlockHeader is the block number requesting the latest header from getBlockHeader.
+const latestBlockHeader = math.MaxUint64
+
+func (b *suaveRuntime) getBlockHeader(number uint64) (types.EthBlockHeader, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ethStateTimeout)
+	defer cancel()
+
+	var blockNumber *big.Int
+	if number != latestBlockHeader {
+		blockNumber = new(big.Int).SetUint64(number)
+	}
+	header, err := b.suaveContext.Backend.ConfidentialEthBackend.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return types.EthBlockHeader{}, fmt.Errorf("could not get block header: %w", err)
+	}
+
+	baseFee := header.BaseFee
+	if baseFee == nil {
+		baseFee = big.NewInt(0)
+	}
+	extra := header.Extra
+	if extra == nil {
+		extra = []byte{}
+	}
+	return types.EthBlockHeader{
+		Hash:         header.Hash(),
+		ParentHash:   header.ParentHash,
+		Number:       header.Number.Uint64(),
+		Timestamp:    header.Time,
+		Coinbase:     header.Coinbase,
+		StateRoot:    header.Root,
+		ReceiptsRoot: header.ReceiptHash,
+		GasLimit:     header.GasLimit,
+		GasUsed:      header.GasUsed,
+		BaseFee:      baseFee,
+		PrevRandao:   header.MixDigest,
+		ExtraData:    extra,
+	}, nil
+}
+
+func (b *suaveRuntime) getTransactionReceipt(txHash common.Hash) (types.EthReceipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ethStateTimeout)
+	defer cancel()
+
+	receipt, err := b.suaveContext.Backend.ConfidentialEthBackend.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return types.EthReceipt{}, fmt.Errorf("could not get transaction receipt: %w", err)
+	}
+
+	var blockNumber uint64
+	if receipt.BlockNumber != nil {
+		blockNumber = receipt.BlockNumber.Uint64()
+	}
+	effectiveGasPrice := receipt.EffectiveGasPrice
+	if effectiveGasPrice == nil {
+		effectiveGasPrice = big.NewInt(0)
+	}
+	logs := []*types.SimulatedLog{}
+	for _, log := range receipt.Logs {
+		topics := log.Topics
+		if topics == nil {
+			topics = []common.Hash{}
+		}
+		logs = append(logs, &types.SimulatedLog{
+			Addr:   log.Address,
+			Topics: topics,
+			Data:   log.Data,
+		})
+	}
+	return types.EthReceipt{
+		TxHash:            receipt.TxHash,
+		BlockHash:         receipt.BlockHash,
+		BlockNumber:       blockNumber,
+		TransactionIndex:  uint64(receipt.TransactionIndex),
+		Status:            receipt.Status,
+		GasUsed:           receipt.GasUsed,
+		CumulativeGasUsed: receipt.CumulativeGasUsed,
+		EffectiveGasPrice: effectiveGasPrice,
+		ContractAddress:   receipt.ContractAddress,
+		Logs:              logs,
+	}, nil
+}
+
 func (b *suaveRuntime) buildEthBlock(blockArgs types.BuildBlockArgs, dataID types.DataId, relayUrl string) ([]byte, []byte, error) {
 	return b.buildEthBlockTo("", blockArgs, dataID, relayUrl)
 }
 
+// recordRetriever reads the confidential store on behalf of a precompile.
+type recordRetriever struct {
+	store  ConfidentialStore
+	caller common.Address
+}
+
+func (r *recordRetriever) Retrieve(id types.DataId, key string) ([]byte, error) {
+	return r.store.Retrieve(id, r.caller, key)
+}
+
 // This is a temp solution and will be replaced with block building session
 func (b *suaveRuntime) buildEthBlockTo(execNode string, blockArgs types.BuildBlockArgs, dataID types.DataId, relayUrl string) ([]byte, []byte, error) {
 	dataIDs := [][16]byte{}
@@ -150,72 +484,16 @@ func (b *suaveRuntime) buildEthBlockTo(execNode string, blockArgs types.BuildBlo
 		recordsToMerge[i] = record.ToInnerRecord()
 	}
 
-	var mergedBundles []types.SBundle
+	var (
+		mergedBundles []types.SBundle
+		store         = &recordRetriever{store: b.suaveContext.Backend.ConfidentialStore, caller: buildEthBlockAddr}
+	)
 	for _, record := range recordsToMerge {
-		switch record.Version {
-		case "mevshare:v0:matchDataRecords":
-			// fetch the matched ids and merge the bundle
-			matchedBundleIdsBytes, err := b.suaveContext.Backend.ConfidentialStore.Retrieve(record.Id, buildEthBlockAddr, "mevshare:v0:mergedDataRecords")
-			if err != nil {
-				return nil, nil, fmt.Errorf("could not retrieve record ids data for record %v, from cdas: %w", record, err)
-			}
-
-			unpackeddataIDs, err := dataIDsAbi.Inputs.Unpack(matchedBundleIdsBytes)
-			if err != nil {
-				return nil, nil, fmt.Errorf("could not unpack record ids data for record %v, from cdas: %w", record, err)
-			}
-
-			matchdataIDs := unpackeddataIDs[0].([][16]byte)
-
-			userBundleBytes, err := b.suaveContext.Backend.ConfidentialStore.Retrieve(matchdataIDs[0], buildEthBlockAddr, "mevshare:v0:ethBundles")
-			if err != nil {
-				return nil, nil, fmt.Errorf("could not retrieve bundle data for dataID %v: %w", matchdataIDs[0], err)
-			}
-
-			var userBundle types.SBundle
-			if err := json.Unmarshal(userBundleBytes, &userBundle); err != nil {
-				return nil, nil, fmt.Errorf("could not unmarshal user bundle data for dataID %v: %w", matchdataIDs[0], err)
-			}
-
-			matchBundleBytes, err := b.suaveContext.Backend.ConfidentialStore.Retrieve(matchdataIDs[1], buildEthBlockAddr, "mevshare:v0:ethBundles")
-			if err != nil {
-				return nil, nil, fmt.Errorf("could not retrieve match bundle data for dataID %v: %w", matchdataIDs[1], err)
-			}
-
-			var matchBundle types.SBundle
-			if err := json.Unmarshal(matchBundleBytes, &matchBundle); err != nil {
-				return nil, nil, fmt.Errorf("could not unmarshal match bundle data for dataID %v: %w", matchdataIDs[1], err)
-			}
-
-			userBundle.Txs = append(userBundle.Txs, matchBundle.Txs...)
-
-			mergedBundles = append(mergedBundles, userBundle)
-
-		case "mevshare:v0:unmatchedBundles":
-			bundleBytes, err := b.suaveContext.Backend.ConfidentialStore.Retrieve(record.Id, buildEthBlockAddr, "mevshare:v0:ethBundles")
-			if err != nil {
-				return nil, nil, fmt.Errorf("could not retrieve bundle data for dataID %v, from cdas: %w", record.Id, err)
-			}
-
-			var bundle types.SBundle
-			if err := json.Unmarshal(bundleBytes, &bundle); err != nil {
-				return nil, nil, fmt.Errorf("could not unmarshal bundle data for dataID %v, from cdas: %w", record.Id, err)
-			}
-			mergedBundles = append(mergedBundles, bundle)
-		case "default:v0:ethBundles":
-			bundleBytes, err := b.suaveContext.Backend.ConfidentialStore.Retrieve(record.Id, buildEthBlockAddr, "default:v0:ethBundles")
-			if err != nil {
-				return nil, nil, fmt.Errorf("could not retrieve bundle data for dataID %v, from cdas: %w", record.Id, err)
-			}
-
-			var bundle types.SBundle
-			if err := json.Unmarshal(bundleBytes, &bundle); err != nil {
-				return nil, nil, fmt.Errorf("could not unmarshal bundle data for dataID %v, from cdas: %w", record.Id, err)
-			}
-			mergedBundles = append(mergedBundles, bundle)
-		default:
-			return nil, nil, fmt.Errorf("unknown record version %s", record.Version)
+		bundle, err := b.suaveContext.Backend.bundleRecords().Bundle(store, &record)
+		if err != nil {
+			return nil, nil, err
 		}
+		mergedBundles = append(mergedBundles, *bundle)
 	}
 
 	log.Info("requesting a block be built", "mergedBundles", mergedBundles)
@@ -276,27 +554,17 @@ func (b *suaveRuntime) buildEthBlockTo(execNode string, blockArgs types.BuildBlo
 		Value:                value,
 	}
 
-	var genesisForkVersion phase0.Version
-
-	// use the chain id of the execution node to figure out the fork version
+	// use the chain id of the execution node to figure out the beacon network
 	chainID, err := confBackend.ChainID(context.Background())
 	if err != nil {
-		return nil, nil, fmt.Errorf("could not get chain id to resolve fork version: %w", err)
+		return nil, nil, fmt.Errorf("could not get chain id to resolve beacon network: %w", err)
 	}
-
-	switch chainID.Uint64() {
-	case 1:
-		// mainnet
-		genesisForkVersion = phase0.Version{0x00, 0x00, 0x00, 0x00}
-	case 17000, 1337:
-		// holesky and test chains
-		genesisForkVersion = phase0.Version{0x01, 0x01, 0x70, 0x00}
-	default:
-		return nil, nil, fmt.Errorf("unsupported chain id %d", chainID.Uint64())
+	network, err := b.suaveContext.Backend.beaconNetworks().Network(chainID.Uint64())
+	if err != nil {
+		return nil, nil, err
 	}
 
-	builderSigningDomain := ssz.ComputeDomain(ssz.DomainTypeAppBuilder, genesisForkVersion, phase0.Root{})
-	signature, err := ssz.SignMessage(&blockBidMsg, builderSigningDomain, b.suaveContext.Backend.EthBlockSigningKey)
+	signature, err := ssz.SignMessage(&blockBidMsg, network.BuilderDomain(), b.suaveContext.Backend.EthBlockSigningKey)
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not sign builder record: %w", err)
 	}
@@ -308,12 +576,16 @@ func (b *suaveRuntime) buildEthBlockTo(execNode string, blockArgs types.BuildBlo
 		BlobsBundle:      &builderDeneb.BlobsBundle{},
 	}
 
+	blobsBundle, err := parseBlobs(envelope.BlobsBundle)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not parse blobs: %w", err)
+	}
+	if len(blobsBundle.Blobs) != 0 && b.suaveContext.Backend.BuiltBlobs != nil {
+		b.suaveContext.Backend.BuiltBlobs.Add(payload.BlockHash, blobsBundle)
+	}
+
 	if len(relayUrl) != 0 {
 		// Only attach blobs if bid is submitted outside EVM
-		blobsBundle, err := parseBlobs(envelope.BlobsBundle)
-		if err != nil {
-			return nil, nil, fmt.Errorf("could not parse blobs: %w", err)
-		}
 		bidRequest.BlobsBundle = blobsBundle
 		bidBytes, err := bidRequest.MarshalJSON()
 		if err != nil {
@@ -363,6 +635,15 @@ func (b *suaveRuntime) privateKeyGen(cryptoType types.CryptoSignature) (string,
 func (b *suaveRuntime) submitEthBlockToRelay(relayUrl string, builderDataRecordJson []byte) ([]byte, error) {
 	endpoint := relayUrl + "/relay/v1/builder/blocks"
 
+	var bid builderDeneb.SubmitBlockRequest
+	if err := bid.UnmarshalJSON(builderDataRecordJson); err == nil && b.attachBuiltBlobs(&bid) {
+		bidBytes, err := bid.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal builder bid: %w", err)
+		}
+		builderDataRecordJson = bidBytes
+	}
+
 	httpReq := types.HttpRequest{
 		Method: http.MethodPost,
 		Url:    endpoint,
@@ -380,6 +661,181 @@ func (b *suaveRuntime) submitEthBlockToRelay(relayUrl string, builderDataRecordJ
 	return resp, nil
 }
 
+// attachBuiltBlobs attaches to a bid without blobs the blobs of its block, if
+// the block was built by the kettle. It reports whether blobs were attached.
+func (b *suaveRuntime) attachBuiltBlobs(bid *builderDeneb.SubmitBlockRequest) bool {
+	builtBlobs := b.suaveContext.Backend.BuiltBlobs
+	if builtBlobs == nil || bid.ExecutionPayload == nil {
+		return false
+	}
+	if bid.BlobsBundle != nil && len(bid.BlobsBundle.Blobs) != 0 {
+		return false
+	}
+	blobsBundle, ok := builtBlobs.Get(bid.ExecutionPayload.BlockHash)
+	if ok {
+		bid.BlobsBundle = blobsBundle
+	}
+	return ok
+}
+
+func (b *suaveRuntime) submitEthBlockToRelays(relayUrls []string, builderBid []byte, options types.RelaySubmissionOptions) ([]types.RelaySubmissionResult, error) {
+	var bid builderDeneb.SubmitBlockRequest
+	if err := bid.UnmarshalJSON(builderBid); err != nil {
+		return nil, fmt.Errorf("could not unmarshal builder bid: %w", err)
+	}
+	b.attachBuiltBlobs(&bid)
+
+	results := make([]types.RelaySubmissionResult, len(relayUrls))
+
+	// relays which are not allowed are reported without being contacted
+	var relays []string
+	var indexes []int
+	for i, relayUrl := range relayUrls {
+		results[i].RelayUrl = relayUrl
+		url, err := b.resolveURL(relayUrl)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		relays = append(relays, url)
+		indexes = append(indexes, i)
+	}
+
+	// the submission cannot last more than a slot, whatever the options
+	opts := relay.Options{
+		SSZ:        options.Ssz,
+		Gzip:       options.Gzip,
+		MaxRetries: relay.MaxRetries,
+	}
+	if options.MaxRetries < relay.MaxRetries {
+		opts.MaxRetries = int(options.MaxRetries)
+	}
+	if options.Deadline != 0 && options.Deadline <= math.MaxInt64 {
+		opts.Deadline = time.UnixMilli(int64(options.Deadline))
+	}
+	submitted, err := relaySubmitter.Submit(context.Background(), relays, &bid, opts)
+	if err != nil {
+		return nil, err
+	}
+	for i, res := range submitted {
+		result := &results[indexes[i]]
+		result.Success = res.Success
+		result.StatusCode = uint64(res.StatusCode)
+		result.Attempts = uint64(res.Attempts)
+		result.Error = res.Error
+	}
+	return results, nil
+}
+
+func (b *suaveRuntime) getBeaconContext(slot uint64) (types.BeaconContext, error) {
+	provider := b.suaveContext.Backend.BeaconContext
+	if provider == nil {
+		return types.BeaconContext{}, fmt.Errorf("kettle does not follow a beacon node")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), beaconContextTimeout)
+	defer cancel()
+
+	beaconCtx, err := provider.Context(ctx, slot)
+	if err != nil {
+		return types.BeaconContext{}, fmt.Errorf("could not get beacon context: %w", err)
+	}
+
+	withdrawals := beaconCtx.Withdrawals
+	if withdrawals == nil {
+		withdrawals = []*types.Withdrawal{}
+	}
+	return types.BeaconContext{
+		Slot:           beaconCtx.Slot,
+		Timestamp:      beaconCtx.Timestamp,
+		ProposerIndex:  beaconCtx.ProposerIndex,
+		ProposerPubkey: beaconCtx.ProposerPubkey,
+		Random:         beaconCtx.Random,
+		Withdrawals:    withdrawals,
+		BeaconRoot:     beaconCtx.BeaconRoot,
+		ParentHash:     beaconCtx.ParentHash,
+	}, nil
+}
+
+var relayDataTimeout = 5 * time.Second
+
+func (b *suaveRuntime) relayClient(relayUrl string) (*relay.Client, error) {
+	url, err := b.resolveURL(relayUrl)
+	if err != nil {
+		return nil, err
+	}
+	return relay.NewClient(&http.Client{Timeout: relayDataTimeout}, url), nil
+}
+
+func (b *suaveRuntime) getRelayValidators(relayUrl string) ([]types.ValidatorDuty, error) {
+	client, err := b.relayClient(relayUrl)
+	if err != nil {
+		return nil, err
+	}
+	duties, err := client.Validators(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("could not get validators from relay: %w", err)
+	}
+
+	result := make([]types.ValidatorDuty, len(duties))
+	for i, duty := range duties {
+		result[i] = types.ValidatorDuty{
+			Slot:           duty.Slot,
+			ValidatorIndex: duty.ValidatorIndex,
+			Pubkey:         duty.Pubkey,
+			FeeRecipient:   duty.FeeRecipient,
+			GasLimit:       duty.GasLimit,
+			Timestamp:      duty.Timestamp,
+		}
+	}
+	return result, nil
+}
+
+func (b *suaveRuntime) getRelayBidTraces(relayUrl string, slot uint64) ([]types.RelayBidTrace, error) {
+	client, err := b.relayClient(relayUrl)
+	if err != nil {
+		return nil, err
+	}
+	traces, err := client.BuilderBlocksReceived(context.Background(), slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get bids from relay: %w", err)
+	}
+	return toRelayBidTraces(traces), nil
+}
+
+func (b *suaveRuntime) getRelayDeliveredPayloads(relayUrl string, slot uint64) ([]types.RelayBidTrace, error) {
+	client, err := b.relayClient(relayUrl)
+	if err != nil {
+		return nil, err
+	}
+	traces, err := client.ProposerPayloadsDelivered(context.Background(), slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get delivered payloads from relay: %w", err)
+	}
+	return toRelayBidTraces(traces), nil
+}
+
+func toRelayBidTraces(traces []*relay.BidTrace) []types.RelayBidTrace {
+	result := make([]types.RelayBidTrace, len(traces))
+	for i, trace := range traces {
+		result[i] = types.RelayBidTrace{
+			Slot:                 trace.Slot,
+			ParentHash:           trace.ParentHash,
+			BlockHash:            trace.BlockHash,
+			BuilderPubkey:        trace.BuilderPubkey,
+			ProposerPubkey:       trace.ProposerPubkey,
+			ProposerFeeRecipient: trace.ProposerFeeRecipient,
+			GasLimit:             trace.GasLimit,
+			GasUsed:              trace.GasUsed,
+			Value:                trace.Value,
+			BlockNumber:          trace.BlockNumber,
+			NumTx:                trace.NumTx,
+			TimestampMs:          trace.TimestampMs,
+		}
+	}
+	return result
+}
+
 func executableDataToDenebExecutionPayload(data *dencun.ExecutableData) (*specDeneb.ExecutionPayload, error) {
 	transactionData := make([]bellatrix.Transaction, len(data.Transactions))
 	for i, tx := range data.Transactions {
@@ -472,92 +928,79 @@ func (c *suaveRuntime) submitBundleJsonRPC(url string, method string, params []b
 func (c *suaveRuntime) fillMevShareBundle(dataID types.DataId) ([]byte, error) {
 	record, err := c.suaveContext.Backend.ConfidentialStore.FetchRecordByID(dataID)
 	if err != nil {
-		fmt.Println(err.Error())
 		return nil, err
 	}
 
 	if _, err := checkIsPrecompileCallAllowed(c.suaveContext, fillMevShareBundleAddr, record); err != nil {
-		fmt.Println(err.Error())
 		return nil, err
 	}
 
 	matchedBundleIdsBytes, err := c.confidentialRetrieve(dataID, "mevshare:v0:mergedDataRecords")
 	if err != nil {
-		fmt.Println(err.Error())
 		return nil, err
 	}
 
 	unpackedDataIDs, err := dataIDsAbi.Inputs.Unpack(matchedBundleIdsBytes)
 	if err != nil {
-		fmt.Println(err.Error())
 		return nil, fmt.Errorf("could not unpack record ids data for record %v, from cdas: %w", record, err)
 	}
 
 	matchDataIDs := unpackedDataIDs[0].([][16]byte)
+	if len(matchDataIDs) < 2 {
+		return nil, fmt.Errorf("record %x does not match a user and a match bundle", dataID)
+	}
 
 	userBundleBytes, err := c.confidentialRetrieve(matchDataIDs[0], "mevshare:v0:ethBundles")
 	if err != nil {
-		fmt.Println(err.Error())
 		return nil, fmt.Errorf("could not retrieve bundle data for dataID %v: %w", matchDataIDs[0], err)
 	}
 
 	var userBundle types.SBundle
 	if err := json.Unmarshal(userBundleBytes, &userBundle); err != nil {
-		fmt.Println(err.Error())
 		return nil, fmt.Errorf("could not unmarshal user bundle data for dataID %v: %w", matchDataIDs[0], err)
 	}
 
 	matchBundleBytes, err := c.confidentialRetrieve(matchDataIDs[1], "mevshare:v0:ethBundles")
 	if err != nil {
-		fmt.Println(err.Error())
 		return nil, fmt.Errorf("could not retrieve match bundle data for dataID %v: %w", matchDataIDs[1], err)
 	}
 
 	var matchBundle types.SBundle
 	if err := json.Unmarshal(matchBundleBytes, &matchBundle); err != nil {
-		fmt.Println(err.Error())
 		return nil, fmt.Errorf("could not unmarshal match bundle data for dataID %v: %w", matchDataIDs[1], err)
 	}
 
-	shareBundle := &types.RPCMevShareBundle{
-		Version: "v0.1",
+	// the user transactions come first and get the refund, followed by the match.
+	// The refund and the inclusion range not set by the user are the kettle defaults
+	config := c.suaveContext.Backend.mevShare()
+	if userBundle.RefundPercent == nil && len(userBundle.RefundConfig) == 0 {
+		refundPercent := config.RefundPercent
+		userBundle.RefundPercent = &refundPercent
 	}
-
-	shareBundle.Inclusion.Block = hexutil.EncodeUint64(record.DecryptionCondition)
-	shareBundle.Inclusion.MaxBlock = hexutil.EncodeUint64(record.DecryptionCondition + 25) // Assumes 25 block inclusion range
-
-	for _, tx := range append(userBundle.Txs, matchBundle.Txs...) {
-		txBytes, err := tx.MarshalBinary()
-		if err != nil {
-			fmt.Println(err.Error())
-			return nil, fmt.Errorf("could not marshal transaction: %w", err)
-		}
-
-		shareBundle.Body = append(shareBundle.Body, struct {
-			Tx        string `json:"tx"`
-			CanRevert bool   `json:"canRevert"`
-		}{Tx: hexutil.Encode(txBytes)})
+	shareBundle, err := types.NewMevShareBundle(&userBundle)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user bundle for dataID %v: %w", matchDataIDs[0], err)
+	}
+	for _, tx := range matchBundle.Txs {
+		shareBundle.Body = append(shareBundle.Body, types.MevShareBody{Tx: tx})
 	}
 
-	for i := range userBundle.Txs {
-		refundPercent := 10
-		if userBundle.RefundPercent != nil {
-			refundPercent = *userBundle.RefundPercent
-		}
-		shareBundle.Validity.Refund = append(shareBundle.Validity.Refund, struct {
-			BodyIdx int `json:"bodyIdx"`
-			Percent int `json:"percent"`
-		}{
-			BodyIdx: i,
-			Percent: refundPercent,
-		})
+	shareBundle.Inclusion.Block = hexutil.Uint64(record.DecryptionCondition)
+	if userBundle.MaxBlock == nil {
+		shareBundle.Inclusion.MaxBlock = hexutil.Uint64(record.DecryptionCondition + config.InclusionRange)
 	}
 
+	if err := shareBundle.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid mev-share bundle: %w", err)
+	}
 	return json.Marshal(shareBundle)
 }
 
 func parseBlobs(bundle *dencun.BlobsBundleV1) (*builderDeneb.BlobsBundle, error) {
 	blobsBundle := &builderDeneb.BlobsBundle{}
+	if bundle == nil {
+		return blobsBundle, nil
+	}
 	for i, blob := range bundle.Blobs {
 		blobFixed, err := convertToFixedArray(blob, [131072]byte{})
 		if err != nil {
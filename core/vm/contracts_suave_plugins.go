@@ -0,0 +1,134 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/metrics"
+	suave "github.com/ethereum/go-ethereum/suave/core"
+	"github.com/ethereum/go-ethereum/suave/plugins"
+)
+
+// plugin returns the plugin registered at an address, or nil if there is none.
+func (b *SuaveExecutionBackend) plugin(addr common.Address) *plugins.Plugin {
+	if b == nil || b.Plugins == nil {
+		return nil
+	}
+	return b.Plugins.Get(addr)
+}
+
+// pluginPrecompile implements PrecompiledContract for the precompiles served
+// out of process by the plugins of the kettle.
+type pluginPrecompile struct {
+	plugin       *plugins.Plugin
+	suaveContext *SuaveContext
+}
+
+func newPluginPrecompile(plugin *plugins.Plugin, suaveContext *SuaveContext) *pluginPrecompile {
+	return &pluginPrecompile{plugin: plugin, suaveContext: suaveContext}
+}
+
+func (p *pluginPrecompile) RequiredGas(input []byte) uint64 {
+	return p.plugin.Gas
+}
+
+func (p *pluginPrecompile) Run(input []byte) ([]byte, error) {
+	if metrics.EnabledExpensive {
+		metrics.GetOrRegisterMeter("suave/plugins/"+p.plugin.Name, nil).Mark(1)
+
+		now := time.Now()
+		defer func() {
+			metrics.GetOrRegisterTimer("suave/plugins/"+p.plugin.Name+"/duration", nil).Update(time.Since(now))
+		}()
+	}
+
+	ret, err := p.run(input)
+	if err != nil {
+		// plugins revert with their data, any other error with its message
+		var revertErr *plugins.RevertError
+		if errors.As(err, &revertErr) && len(revertErr.Data) != 0 {
+			ret = revertErr.Data
+		} else {
+			ret = []byte(err.Error())
+		}
+		return ret, ErrExecutionReverted
+	}
+	return ret, nil
+}
+
+func (p *pluginPrecompile) run(input []byte) ([]byte, error) {
+	if len(input) < 4 || string(input[:4]) != string(p.plugin.Method.ID) {
+		return nil, fmt.Errorf("plugin %s: unknown function selector", p.plugin.Name)
+	}
+	args, err := p.plugin.Method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, errFailedToUnpackInput
+	}
+
+	req := &plugins.Request{
+		Address: p.plugin.Address,
+		Input:   input,
+		Caller:  p.caller(),
+		Kettle:  common.BytesToAddress(p.suaveContext.Context["kettleAddress"]),
+	}
+	for _, index := range p.plugin.Records {
+		record, err := p.record(args[index].([16]byte))
+		if err != nil {
+			return nil, err
+		}
+		req.Records = append(req.Records, record)
+	}
+
+	return p.plugin.Call(context.Background(), req)
+}
+
+// record fetches a record of the input, which the plugin and its caller must
+// be allowed to peek into, with the values of the keys of the plugin.
+func (p *pluginPrecompile) record(id suave.DataId) (*plugins.Record, error) {
+	store := p.suaveContext.Backend.ConfidentialStore
+	if store == nil {
+		return nil, fmt.Errorf("confidential store is not enabled")
+	}
+
+	record, err := store.FetchRecordByID(id)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := checkIsPrecompileCallAllowed(p.suaveContext, p.plugin.Address, record)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &plugins.Record{
+		ID:                  record.Id[:],
+		DecryptionCondition: hexutil.Uint64(record.DecryptionCondition),
+		AllowedPeekers:      record.AllowedPeekers,
+		AllowedStores:       record.AllowedStores,
+		Version:             record.Version,
+		Values:              make(map[string]hexutil.Bytes),
+	}
+	for _, key := range p.plugin.Keys {
+		value, err := store.Retrieve(id, caller, key)
+		if err != nil {
+			// the keys are optional, the record may not store all of them
+			continue
+		}
+		res.Values[key] = value
+	}
+	return res, nil
+}
+
+// caller returns the closest caller of the precompile.
+func (p *pluginPrecompile) caller() common.Address {
+	for i := len(p.suaveContext.CallerStack) - 1; i >= 0; i-- {
+		caller := p.suaveContext.CallerStack[i]
+		if caller != nil && *caller != p.plugin.Address {
+			return *caller
+		}
+	}
+	return common.Address{}
+}
@@ -0,0 +1,86 @@
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/suave/plugins"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuave_PluginPrecompile(t *testing.T) {
+	b := newTestBackend(t)
+
+	endpoint := filepath.Join(t.TempDir(), "plugin.ipc")
+	server, err := plugins.Serve(endpoint, plugins.HandlerFunc(func(ctx context.Context, req *plugins.Request) ([]byte, error) {
+		// echo the value of the record
+		value := req.Records[0].Values["key"]
+		if len(value) == 0 {
+			return nil, &plugins.RevertError{Data: []byte{0xde, 0xad}}
+		}
+		return value, nil
+	}))
+	require.NoError(t, err)
+	defer server.Close()
+
+	plugin, err := plugins.New(&plugins.Spec{
+		Name:     "echo",
+		Address:  common.HexToAddress("0x90000001"),
+		Endpoint: endpoint,
+		ABI:      json.RawMessage(`[{"type":"function","name":"echo","inputs":[{"name":"id","type":"bytes16"}],"outputs":[{"name":"","type":"bytes"}],"stateMutability":"view"}]`),
+		Records:  []string{"id"},
+		Keys:     []string{"key"},
+	})
+	require.NoError(t, err)
+	defer plugin.Close()
+
+	b.suaveContext.Backend.Plugins = plugins.NewRegistry()
+	require.NoError(t, b.suaveContext.Backend.Plugins.Register(plugin))
+	require.Equal(t, plugin, b.suaveContext.Backend.plugin(plugin.Address))
+
+	callerAddr := common.Address{0x1}
+	allowed, err := b.newDataRecord(0, []common.Address{callerAddr, plugin.Address}, nil, "a")
+	require.NoError(t, err)
+	notAllowed, err := b.newDataRecord(0, []common.Address{callerAddr}, nil, "a")
+	require.NoError(t, err)
+
+	b.suaveContext.CallerStack = []*common.Address{&callerAddr}
+
+	output, err := plugin.Method.Outputs.Pack([]byte{0x1, 0x2})
+	require.NoError(t, err)
+	require.NoError(t, b.confidentialStore(allowed.Id, "key", output))
+
+	pluginContext := &SuaveContext{
+		Backend:     b.suaveContext.Backend,
+		CallerStack: []*common.Address{&callerAddr, &plugin.Address},
+	}
+	run := func(id [16]byte) ([]byte, error) {
+		input, err := plugin.Method.Inputs.Pack(id)
+		require.NoError(t, err)
+		return newPluginPrecompile(plugin, pluginContext).Run(append(plugin.Method.ID, input...))
+	}
+
+	// the record and its value are forwarded to the plugin
+	ret, err := run(allowed.Id)
+	require.NoError(t, err)
+	require.Equal(t, output, ret)
+
+	// the plugin must be allowed on the record
+	ret, err = run(notAllowed.Id)
+	require.ErrorIs(t, err, ErrExecutionReverted)
+	require.Contains(t, string(ret), "not allowed")
+
+	// the plugin reverts with its data
+	require.NoError(t, b.confidentialStore(allowed.Id, "key", nil))
+	ret, err = run(allowed.Id)
+	require.ErrorIs(t, err, ErrExecutionReverted)
+	require.Equal(t, []byte{0xde, 0xad}, ret)
+
+	// the input must match the abi of the plugin
+	ret, err = newPluginPrecompile(plugin, pluginContext).Run([]byte{0x1})
+	require.ErrorIs(t, err, ErrExecutionReverted)
+	require.Contains(t, string(ret), "unknown function selector")
+}
@@ -1,18 +1,19 @@
 // Code generated by suave/gen. DO NOT EDIT.
-// Hash: 93d0334f831efa37bf623305bc1fadbb86200c71f9e3c4a97337f7879f4216c1
+// Hash: 5104f60b291f4b2d27870e420886b6c1f908af10b5d69aecafeceffcf050ee07
 package vm
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/suave/artifacts"
 	"github.com/mitchellh/mapstructure"
-	"math/big"
 )
 
-var _ = new(big.Int)
-
 var (
 	errFailedToUnpackInput = fmt.Errorf("failed to decode input")
 	errFailedToDecodeField = fmt.Errorf("failed to decode field")
@@ -31,52 +32,81 @@ type SuaveRuntime interface {
 	doHTTPRequest(request types.HttpRequest) ([]byte, error)
 	doHTTPRequest2(request types.HttpRequest) (types.HttpResponse, error)
 	ethcall(contractAddr common.Address, input1 []byte) ([]byte, error)
+	// Deprecated: Use extractHints, which selects the hints with a policy.
 	extractHint(bundleData []byte) ([]byte, error)
+	extractHints(bundleData []byte, policy uint64) (types.BundleHint, error)
 	fetchDataRecords(cond uint64, namespace string) ([]types.DataRecord, error)
 	fillMevShareBundle(dataId types.DataId) ([]byte, error)
+	getBalance(account common.Address) (*big.Int, error)
+	getBeaconContext(slot uint64) (types.BeaconContext, error)
+	getBlockHeader(number uint64) (types.EthBlockHeader, error)
+	getCode(account common.Address) ([]byte, error)
 	getInsecureTime() (*big.Int, error)
+	getNonce(account common.Address) (uint64, error)
+	getRelayBidTraces(relayUrl string, slot uint64) ([]types.RelayBidTrace, error)
+	getRelayDeliveredPayloads(relayUrl string, slot uint64) ([]types.RelayBidTrace, error)
+	getRelayValidators(relayUrl string) ([]types.ValidatorDuty, error)
+	getStorageAt(account common.Address, slot common.Hash) (common.Hash, error)
+	getTransactionReceipt(txHash common.Hash) (types.EthReceipt, error)
 	newBuilder() (string, error)
 	newDataRecord(decryptionCondition uint64, allowedPeekers []common.Address, allowedStores []common.Address, dataType string) (types.DataRecord, error)
 	privateKeyGen(crypto types.CryptoSignature) (string, error)
 	randomBytes(numBytes uint8) ([]byte, error)
 	signEthTransaction(txn []byte, chainId string, signingKey string) ([]byte, error)
+	signEthTypedTransaction(txn types.EthTransaction, signingKey string) ([]byte, common.Hash, error)
 	signMessage(digest []byte, crypto types.CryptoSignature, signingKey string) ([]byte, error)
 	simulateBundle(bundleData []byte) (uint64, error)
+	simulateBundleWithArgs(blockArgs types.BuildBlockArgs, bundleData []byte) (types.SimulateBundleResult, error)
 	simulateTransaction(sessionid string, txn []byte) (types.SimulateTransactionResult, error)
 	submitBundleJsonRPC(url string, method string, params []byte) ([]byte, error)
 	submitEthBlockToRelay(relayUrl string, builderBid []byte) ([]byte, error)
+	submitEthBlockToRelays(relayUrls []string, builderBid []byte, options types.RelaySubmissionOptions) ([]types.RelaySubmissionResult, error)
 }
 
 var (
-	aesDecryptAddr            = common.HexToAddress("0x000000000000000000000000000000005670000d")
-	aesEncryptAddr            = common.HexToAddress("0x000000000000000000000000000000005670000e")
-	buildEthBlockAddr         = common.HexToAddress("0x0000000000000000000000000000000042100001")
-	buildEthBlockToAddr       = common.HexToAddress("0x0000000000000000000000000000000042100006")
-	confidentialInputsAddr    = common.HexToAddress("0x0000000000000000000000000000000042010001")
-	confidentialRetrieveAddr  = common.HexToAddress("0x0000000000000000000000000000000042020001")
-	confidentialStoreAddr     = common.HexToAddress("0x0000000000000000000000000000000042020000")
-	contextGetAddr            = common.HexToAddress("0x0000000000000000000000000000000053300003")
-	doHTTPRequestAddr         = common.HexToAddress("0x0000000000000000000000000000000043200002")
-	doHTTPRequest2Addr        = common.HexToAddress("0x0000000000000000000000000000000043200003")
-	ethcallAddr               = common.HexToAddress("0x0000000000000000000000000000000042100003")
-	extractHintAddr           = common.HexToAddress("0x0000000000000000000000000000000042100037")
-	fetchDataRecordsAddr      = common.HexToAddress("0x0000000000000000000000000000000042030001")
-	fillMevShareBundleAddr    = common.HexToAddress("0x0000000000000000000000000000000043200001")
-	getInsecureTimeAddr       = common.HexToAddress("0x000000000000000000000000000000007770000c")
-	newBuilderAddr            = common.HexToAddress("0x0000000000000000000000000000000053200001")
-	newDataRecordAddr         = common.HexToAddress("0x0000000000000000000000000000000042030000")
-	privateKeyGenAddr         = common.HexToAddress("0x0000000000000000000000000000000053200003")
-	randomBytesAddr           = common.HexToAddress("0x000000000000000000000000000000007770000b")
-	signEthTransactionAddr    = common.HexToAddress("0x0000000000000000000000000000000040100001")
-	signMessageAddr           = common.HexToAddress("0x0000000000000000000000000000000040100003")
-	simulateBundleAddr        = common.HexToAddress("0x0000000000000000000000000000000042100000")
-	simulateTransactionAddr   = common.HexToAddress("0x0000000000000000000000000000000053200002")
-	submitBundleJsonRPCAddr   = common.HexToAddress("0x0000000000000000000000000000000043000001")
-	submitEthBlockToRelayAddr = common.HexToAddress("0x0000000000000000000000000000000042100002")
+	aesDecryptAddr                = common.HexToAddress("0x000000000000000000000000000000005670000d")
+	aesEncryptAddr                = common.HexToAddress("0x000000000000000000000000000000005670000e")
+	buildEthBlockAddr             = common.HexToAddress("0x0000000000000000000000000000000042100001")
+	buildEthBlockToAddr           = common.HexToAddress("0x0000000000000000000000000000000042100006")
+	confidentialInputsAddr        = common.HexToAddress("0x0000000000000000000000000000000042010001")
+	confidentialRetrieveAddr      = common.HexToAddress("0x0000000000000000000000000000000042020001")
+	confidentialStoreAddr         = common.HexToAddress("0x0000000000000000000000000000000042020000")
+	contextGetAddr                = common.HexToAddress("0x0000000000000000000000000000000053300003")
+	doHTTPRequestAddr             = common.HexToAddress("0x0000000000000000000000000000000043200002")
+	doHTTPRequest2Addr            = common.HexToAddress("0x0000000000000000000000000000000043200003")
+	ethcallAddr                   = common.HexToAddress("0x0000000000000000000000000000000042100003")
+	extractHintAddr               = common.HexToAddress("0x0000000000000000000000000000000042100037")
+	extractHintsAddr              = common.HexToAddress("0x000000000000000000000000000000004210000b")
+	fetchDataRecordsAddr          = common.HexToAddress("0x0000000000000000000000000000000042030001")
+	fillMevShareBundleAddr        = common.HexToAddress("0x0000000000000000000000000000000043200001")
+	getBalanceAddr                = common.HexToAddress("0x000000000000000000000000000000004210000d")
+	getBeaconContextAddr          = common.HexToAddress("0x000000000000000000000000000000004210000a")
+	getBlockHeaderAddr            = common.HexToAddress("0x0000000000000000000000000000000042100010")
+	getCodeAddr                   = common.HexToAddress("0x000000000000000000000000000000004210000e")
+	getInsecureTimeAddr           = common.HexToAddress("0x000000000000000000000000000000007770000c")
+	getNonceAddr                  = common.HexToAddress("0x000000000000000000000000000000004210000c")
+	getRelayBidTracesAddr         = common.HexToAddress("0x0000000000000000000000000000000042100008")
+	getRelayDeliveredPayloadsAddr = common.HexToAddress("0x0000000000000000000000000000000042100009")
+	getRelayValidatorsAddr        = common.HexToAddress("0x0000000000000000000000000000000042100007")
+	getStorageAtAddr              = common.HexToAddress("0x000000000000000000000000000000004210000f")
+	getTransactionReceiptAddr     = common.HexToAddress("0x0000000000000000000000000000000042100011")
+	newBuilderAddr                = common.HexToAddress("0x0000000000000000000000000000000053200001")
+	newDataRecordAddr             = common.HexToAddress("0x0000000000000000000000000000000042030000")
+	privateKeyGenAddr             = common.HexToAddress("0x0000000000000000000000000000000053200003")
+	randomBytesAddr               = common.HexToAddress("0x000000000000000000000000000000007770000b")
+	signEthTransactionAddr        = common.HexToAddress("0x0000000000000000000000000000000040100001")
+	signEthTypedTransactionAddr   = common.HexToAddress("0x0000000000000000000000000000000040100002")
+	signMessageAddr               = common.HexToAddress("0x0000000000000000000000000000000040100003")
+	simulateBundleAddr            = common.HexToAddress("0x0000000000000000000000000000000042100000")
+	simulateBundleWithArgsAddr    = common.HexToAddress("0x0000000000000000000000000000000042100004")
+	simulateTransactionAddr       = common.HexToAddress("0x0000000000000000000000000000000053200002")
+	submitBundleJsonRPCAddr       = common.HexToAddress("0x0000000000000000000000000000000043000001")
+	submitEthBlockToRelayAddr     = common.HexToAddress("0x0000000000000000000000000000000042100002")
+	submitEthBlockToRelaysAddr    = common.HexToAddress("0x0000000000000000000000000000000042100005")
 )
 
 var addrList = []common.Address{
-	aesDecryptAddr, aesEncryptAddr, buildEthBlockAddr, buildEthBlockToAddr, confidentialInputsAddr, confidentialRetrieveAddr, confidentialStoreAddr, contextGetAddr, doHTTPRequestAddr, doHTTPRequest2Addr, ethcallAddr, extractHintAddr, fetchDataRecordsAddr, fillMevShareBundleAddr, getInsecureTimeAddr, newBuilderAddr, newDataRecordAddr, privateKeyGenAddr, randomBytesAddr, signEthTransactionAddr, signMessageAddr, simulateBundleAddr, simulateTransactionAddr, submitBundleJsonRPCAddr, submitEthBlockToRelayAddr,
+	aesDecryptAddr, aesEncryptAddr, buildEthBlockAddr, buildEthBlockToAddr, confidentialInputsAddr, confidentialRetrieveAddr, confidentialStoreAddr, contextGetAddr, doHTTPRequestAddr, doHTTPRequest2Addr, ethcallAddr, extractHintAddr, extractHintsAddr, fetchDataRecordsAddr, fillMevShareBundleAddr, getBalanceAddr, getBeaconContextAddr, getBlockHeaderAddr, getCodeAddr, getInsecureTimeAddr, getNonceAddr, getRelayBidTracesAddr, getRelayDeliveredPayloadsAddr, getRelayValidatorsAddr, getStorageAtAddr, getTransactionReceiptAddr, newBuilderAddr, newDataRecordAddr, privateKeyGenAddr, randomBytesAddr, signEthTransactionAddr, signEthTypedTransactionAddr, signMessageAddr, simulateBundleAddr, simulateBundleWithArgsAddr, simulateTransactionAddr, submitBundleJsonRPCAddr, submitEthBlockToRelayAddr, submitEthBlockToRelaysAddr,
 }
 
 type SuaveRuntimeAdapter struct {
@@ -121,15 +151,48 @@ func (b *SuaveRuntimeAdapter) run(addr common.Address, input []byte) ([]byte, er
 	case extractHintAddr:
 		return b.extractHint(input)
 
+	case extractHintsAddr:
+		return b.extractHints(input)
+
 	case fetchDataRecordsAddr:
 		return b.fetchDataRecords(input)
 
 	case fillMevShareBundleAddr:
 		return b.fillMevShareBundle(input)
 
+	case getBalanceAddr:
+		return b.getBalance(input)
+
+	case getBeaconContextAddr:
+		return b.getBeaconContext(input)
+
+	case getBlockHeaderAddr:
+		return b.getBlockHeader(input)
+
+	case getCodeAddr:
+		return b.getCode(input)
+
 	case getInsecureTimeAddr:
 		return b.getInsecureTime(input)
 
+	case getNonceAddr:
+		return b.getNonce(input)
+
+	case getRelayBidTracesAddr:
+		return b.getRelayBidTraces(input)
+
+	case getRelayDeliveredPayloadsAddr:
+		return b.getRelayDeliveredPayloads(input)
+
+	case getRelayValidatorsAddr:
+		return b.getRelayValidators(input)
+
+	case getStorageAtAddr:
+		return b.getStorageAt(input)
+
+	case getTransactionReceiptAddr:
+		return b.getTransactionReceipt(input)
+
 	case newBuilderAddr:
 		return b.newBuilder(input)
 
@@ -145,12 +208,18 @@ func (b *SuaveRuntimeAdapter) run(addr common.Address, input []byte) ([]byte, er
 	case signEthTransactionAddr:
 		return b.signEthTransaction(input)
 
+	case signEthTypedTransactionAddr:
+		return b.signEthTypedTransaction(input)
+
 	case signMessageAddr:
 		return b.signMessage(input)
 
 	case simulateBundleAddr:
 		return b.simulateBundle(input)
 
+	case simulateBundleWithArgsAddr:
+		return b.simulateBundleWithArgs(input)
+
 	case simulateTransactionAddr:
 		return b.simulateTransaction(input)
 
@@ -160,11 +229,74 @@ func (b *SuaveRuntimeAdapter) run(addr common.Address, input []byte) ([]byte, er
 	case submitEthBlockToRelayAddr:
 		return b.submitEthBlockToRelay(input)
 
+	case submitEthBlockToRelaysAddr:
+		return b.submitEthBlockToRelays(input)
+
 	default:
 		return nil, fmt.Errorf("suave precompile not found for " + addr.String())
 	}
 }
 
+// packSuaveError encodes a typed error of the precompiles as its ABI revert data.
+func packSuaveError(err error) ([]byte, bool) {
+	if e := (types.ContextValueNotFoundError{}); errors.As(err, &e) {
+		return packSuaveErrorArgs("ContextValueNotFound", e.Key)
+	}
+	if e := (types.DomainNotAllowedError{}); errors.As(err, &e) {
+		return packSuaveErrorArgs("DomainNotAllowed", e.Domain)
+	}
+	if e := (types.HttpRequestFailedError{}); errors.As(err, &e) {
+		return packSuaveErrorArgs("HttpRequestFailed", e.Status, e.Body)
+	}
+	return nil, false
+}
+
+func packSuaveErrorArgs(name string, args ...interface{}) ([]byte, bool) {
+	abiErr := artifacts.SuaveAbi.Errors[name]
+	data, err := abiErr.Inputs.Pack(args...)
+	if err != nil {
+		return nil, false
+	}
+	return append(abiErr.ID[:4:4], data...), true
+}
+
+// UnpackSuaveError decodes the revert data of a precompile into its typed error,
+// or returns nil if it does not carry one.
+func UnpackSuaveError(data []byte) error {
+	if len(data) < 4 {
+		return nil
+	}
+	if abiErr := artifacts.SuaveAbi.Errors["ContextValueNotFound"]; bytes.Equal(data[:4], abiErr.ID[:4]) {
+		unpacked, err := abiErr.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil
+		}
+		var e types.ContextValueNotFoundError
+		e.Key = unpacked[0].(string)
+		return e
+	}
+	if abiErr := artifacts.SuaveAbi.Errors["DomainNotAllowed"]; bytes.Equal(data[:4], abiErr.ID[:4]) {
+		unpacked, err := abiErr.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil
+		}
+		var e types.DomainNotAllowedError
+		e.Domain = unpacked[0].(string)
+		return e
+	}
+	if abiErr := artifacts.SuaveAbi.Errors["HttpRequestFailed"]; bytes.Equal(data[:4], abiErr.ID[:4]) {
+		unpacked, err := abiErr.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil
+		}
+		var e types.HttpRequestFailedError
+		e.Status = unpacked[0].(uint64)
+		e.Body = unpacked[1].([]byte)
+		return e
+	}
+	return nil
+}
+
 func (b *SuaveRuntimeAdapter) aesDecrypt(input []byte) (res []byte, err error) {
 	var (
 		unpacked []interface{}
@@ -653,6 +785,46 @@ func (b *SuaveRuntimeAdapter) extractHint(input []byte) (res []byte, err error)
 
 }
 
+func (b *SuaveRuntimeAdapter) extractHints(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["extractHints"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		bundleData []byte
+		policy     uint64
+	)
+
+	bundleData = unpacked[0].([]byte)
+	policy = unpacked[1].(uint64)
+
+	var (
+		hint types.BundleHint
+	)
+
+	if hint, err = b.impl.extractHints(bundleData, policy); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["extractHints"].Outputs.Pack(hint)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
 func (b *SuaveRuntimeAdapter) fetchDataRecords(input []byte) (res []byte, err error) {
 	var (
 		unpacked []interface{}
@@ -730,6 +902,158 @@ func (b *SuaveRuntimeAdapter) fillMevShareBundle(input []byte) (res []byte, err
 
 }
 
+func (b *SuaveRuntimeAdapter) getBalance(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["getBalance"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		account common.Address
+	)
+
+	account = unpacked[0].(common.Address)
+
+	var (
+		balance *big.Int
+	)
+
+	if balance, err = b.impl.getBalance(account); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["getBalance"].Outputs.Pack(balance)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
+func (b *SuaveRuntimeAdapter) getBeaconContext(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["getBeaconContext"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		slot uint64
+	)
+
+	slot = unpacked[0].(uint64)
+
+	var (
+		beaconContext types.BeaconContext
+	)
+
+	if beaconContext, err = b.impl.getBeaconContext(slot); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["getBeaconContext"].Outputs.Pack(beaconContext)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
+func (b *SuaveRuntimeAdapter) getBlockHeader(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["getBlockHeader"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		number uint64
+	)
+
+	number = unpacked[0].(uint64)
+
+	var (
+		header types.EthBlockHeader
+	)
+
+	if header, err = b.impl.getBlockHeader(number); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["getBlockHeader"].Outputs.Pack(header)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
+func (b *SuaveRuntimeAdapter) getCode(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["getCode"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		account common.Address
+	)
+
+	account = unpacked[0].(common.Address)
+
+	var (
+		code []byte
+	)
+
+	if code, err = b.impl.getCode(account); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["getCode"].Outputs.Pack(code)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
 func (b *SuaveRuntimeAdapter) getInsecureTime(input []byte) (res []byte, err error) {
 	var (
 		unpacked []interface{}
@@ -764,6 +1088,240 @@ func (b *SuaveRuntimeAdapter) getInsecureTime(input []byte) (res []byte, err err
 
 }
 
+func (b *SuaveRuntimeAdapter) getNonce(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["getNonce"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		account common.Address
+	)
+
+	account = unpacked[0].(common.Address)
+
+	var (
+		nonce uint64
+	)
+
+	if nonce, err = b.impl.getNonce(account); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["getNonce"].Outputs.Pack(nonce)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
+func (b *SuaveRuntimeAdapter) getRelayBidTraces(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["getRelayBidTraces"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		relayUrl string
+		slot     uint64
+	)
+
+	relayUrl = unpacked[0].(string)
+	slot = unpacked[1].(uint64)
+
+	var (
+		bids []types.RelayBidTrace
+	)
+
+	if bids, err = b.impl.getRelayBidTraces(relayUrl, slot); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["getRelayBidTraces"].Outputs.Pack(bids)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
+func (b *SuaveRuntimeAdapter) getRelayDeliveredPayloads(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["getRelayDeliveredPayloads"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		relayUrl string
+		slot     uint64
+	)
+
+	relayUrl = unpacked[0].(string)
+	slot = unpacked[1].(uint64)
+
+	var (
+		payloads []types.RelayBidTrace
+	)
+
+	if payloads, err = b.impl.getRelayDeliveredPayloads(relayUrl, slot); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["getRelayDeliveredPayloads"].Outputs.Pack(payloads)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
+func (b *SuaveRuntimeAdapter) getRelayValidators(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["getRelayValidators"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		relayUrl string
+	)
+
+	relayUrl = unpacked[0].(string)
+
+	var (
+		duties []types.ValidatorDuty
+	)
+
+	if duties, err = b.impl.getRelayValidators(relayUrl); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["getRelayValidators"].Outputs.Pack(duties)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
+func (b *SuaveRuntimeAdapter) getStorageAt(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["getStorageAt"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		account common.Address
+		slot    common.Hash
+	)
+
+	account = unpacked[0].(common.Address)
+	slot = common.Hash(unpacked[1].([32]byte))
+
+	var (
+		value common.Hash
+	)
+
+	if value, err = b.impl.getStorageAt(account, slot); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["getStorageAt"].Outputs.Pack(value)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
+func (b *SuaveRuntimeAdapter) getTransactionReceipt(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["getTransactionReceipt"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		txHash common.Hash
+	)
+
+	txHash = common.Hash(unpacked[0].([32]byte))
+
+	var (
+		receipt types.EthReceipt
+	)
+
+	if receipt, err = b.impl.getTransactionReceipt(txHash); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["getTransactionReceipt"].Outputs.Pack(receipt)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
 func (b *SuaveRuntimeAdapter) newBuilder(input []byte) (res []byte, err error) {
 	var (
 		unpacked []interface{}
@@ -963,6 +1521,51 @@ func (b *SuaveRuntimeAdapter) signEthTransaction(input []byte) (res []byte, err
 
 }
 
+func (b *SuaveRuntimeAdapter) signEthTypedTransaction(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["signEthTypedTransaction"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		txn        types.EthTransaction
+		signingKey string
+	)
+
+	if err = mapstructure.Decode(unpacked[0], &txn); err != nil {
+		err = errFailedToDecodeField
+		return
+	}
+
+	signingKey = unpacked[1].(string)
+
+	var (
+		signedTxn []byte
+		txHash    common.Hash
+	)
+
+	if signedTxn, txHash, err = b.impl.signEthTypedTransaction(txn, signingKey); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["signEthTypedTransaction"].Outputs.Pack(signedTxn, txHash)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
 func (b *SuaveRuntimeAdapter) signMessage(input []byte) (res []byte, err error) {
 	var (
 		unpacked []interface{}
@@ -1048,6 +1651,50 @@ func (b *SuaveRuntimeAdapter) simulateBundle(input []byte) (res []byte, err erro
 
 }
 
+func (b *SuaveRuntimeAdapter) simulateBundleWithArgs(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["simulateBundleWithArgs"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		blockArgs  types.BuildBlockArgs
+		bundleData []byte
+	)
+
+	if err = mapstructure.Decode(unpacked[0], &blockArgs); err != nil {
+		err = errFailedToDecodeField
+		return
+	}
+
+	bundleData = unpacked[1].([]byte)
+
+	var (
+		simulationResult types.SimulateBundleResult
+	)
+
+	if simulationResult, err = b.impl.simulateBundleWithArgs(blockArgs, bundleData); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["simulateBundleWithArgs"].Outputs.Pack(simulationResult)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
+
 func (b *SuaveRuntimeAdapter) simulateTransaction(input []byte) (res []byte, err error) {
 	var (
 		unpacked []interface{}
@@ -1161,3 +1808,49 @@ func (b *SuaveRuntimeAdapter) submitEthBlockToRelay(input []byte) (res []byte, e
 	return result, nil
 
 }
+
+func (b *SuaveRuntimeAdapter) submitEthBlockToRelays(input []byte) (res []byte, err error) {
+	var (
+		unpacked []interface{}
+		result   []byte
+	)
+
+	_ = unpacked
+	_ = result
+
+	unpacked, err = artifacts.SuaveAbi.Methods["submitEthBlockToRelays"].Inputs.Unpack(input)
+	if err != nil {
+		err = errFailedToUnpackInput
+		return
+	}
+
+	var (
+		relayUrls  []string
+		builderBid []byte
+		options    types.RelaySubmissionOptions
+	)
+
+	relayUrls = unpacked[0].([]string)
+	builderBid = unpacked[1].([]byte)
+
+	if err = mapstructure.Decode(unpacked[2], &options); err != nil {
+		err = errFailedToDecodeField
+		return
+	}
+
+	var (
+		results []types.RelaySubmissionResult
+	)
+
+	if results, err = b.impl.submitEthBlockToRelays(relayUrls, builderBid, options); err != nil {
+		return
+	}
+
+	result, err = artifacts.SuaveAbi.Methods["submitEthBlockToRelays"].Outputs.Pack(results)
+	if err != nil {
+		err = errFailedToPackOutput
+		return
+	}
+	return result, nil
+
+}
@@ -40,10 +40,38 @@ func (m *mockRuntime) ethcall(contractAddr common.Address, input1 []byte) ([]byt
 	return []byte{0x1}, nil
 }
 
+func (m *mockRuntime) getNonce(account common.Address) (uint64, error) {
+	return 1, nil
+}
+
+func (m *mockRuntime) getBalance(account common.Address) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (m *mockRuntime) getCode(account common.Address) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (m *mockRuntime) getStorageAt(account common.Address, slot common.Hash) (common.Hash, error) {
+	return common.Hash{0x1}, nil
+}
+
+func (m *mockRuntime) getBlockHeader(number uint64) (types.EthBlockHeader, error) {
+	return types.EthBlockHeader{BaseFee: big.NewInt(1), ExtraData: []byte{}}, nil
+}
+
+func (m *mockRuntime) getTransactionReceipt(txHash common.Hash) (types.EthReceipt, error) {
+	return types.EthReceipt{EffectiveGasPrice: big.NewInt(1), Logs: []*types.SimulatedLog{}}, nil
+}
+
 func (m *mockRuntime) extractHint(bundleData []byte) ([]byte, error) {
 	return []byte{0x1}, nil
 }
 
+func (m *mockRuntime) extractHints(bundleData []byte, policy uint64) (types.BundleHint, error) {
+	return types.BundleHint{Txs: []*types.TxHint{}, Logs: []*types.SimulatedLog{}}, nil
+}
+
 func (m *mockRuntime) fetchDataRecords(cond uint64, namespace string) ([]types.DataRecord, error) {
 	return []types.DataRecord{{}}, nil
 }
@@ -60,6 +88,10 @@ func (m *mockRuntime) signEthTransaction(txn []byte, chainId string, signingKey
 	return []byte{0x1}, nil
 }
 
+func (m *mockRuntime) signEthTypedTransaction(txn types.EthTransaction, signingKey string) ([]byte, common.Hash, error) {
+	return []byte{0x1}, common.Hash{}, nil
+}
+
 func (m *mockRuntime) signMessage(digest []byte, crypto types.CryptoSignature, signingKey string) ([]byte, error) {
 	return []byte{0x1}, nil
 }
@@ -68,6 +100,10 @@ func (m *mockRuntime) simulateBundle(bundleData []byte) (uint64, error) {
 	return 1, nil
 }
 
+func (m *mockRuntime) simulateBundleWithArgs(blockArgs types.BuildBlockArgs, bundleData []byte) (types.SimulateBundleResult, error) {
+	return types.SimulateBundleResult{CoinbaseProfit: big.NewInt(0)}, nil
+}
+
 func (m *mockRuntime) submitBundleJsonRPC(url string, method string, params []byte) ([]byte, error) {
 	return []byte{0x1}, nil
 }
@@ -76,6 +112,26 @@ func (m *mockRuntime) submitEthBlockToRelay(relayUrl string, builderDataRecord [
 	return []byte{0x1}, nil
 }
 
+func (m *mockRuntime) submitEthBlockToRelays(relayUrls []string, builderBid []byte, options types.RelaySubmissionOptions) ([]types.RelaySubmissionResult, error) {
+	return []types.RelaySubmissionResult{{}}, nil
+}
+
+func (m *mockRuntime) getRelayValidators(relayUrl string) ([]types.ValidatorDuty, error) {
+	return []types.ValidatorDuty{{}}, nil
+}
+
+func (m *mockRuntime) getRelayBidTraces(relayUrl string, slot uint64) ([]types.RelayBidTrace, error) {
+	return []types.RelayBidTrace{{Value: big.NewInt(1)}}, nil
+}
+
+func (m *mockRuntime) getRelayDeliveredPayloads(relayUrl string, slot uint64) ([]types.RelayBidTrace, error) {
+	return []types.RelayBidTrace{{Value: big.NewInt(1)}}, nil
+}
+
+func (m *mockRuntime) getBeaconContext(slot uint64) (types.BeaconContext, error) {
+	return types.BeaconContext{}, nil
+}
+
 func (m *mockRuntime) doHTTPRequest(request types.HttpRequest) ([]byte, error) {
 	return []byte{0x1}, nil
 }
@@ -89,7 +145,7 @@ func (m *mockRuntime) newBuilder() (string, error) {
 }
 
 func (m *mockRuntime) simulateTransaction(session string, txn []byte) (types.SimulateTransactionResult, error) {
-	return types.SimulateTransactionResult{}, nil
+	return types.SimulateTransactionResult{CoinbasePayment: big.NewInt(0)}, nil
 }
 
 func (m *mockRuntime) privateKeyGen(crypto types.CryptoSignature) (string, error) {
@@ -3,6 +3,11 @@ package vm
 import (
 	"context"
 	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
@@ -10,12 +15,21 @@ import (
 	"testing"
 	"time"
 
+	builderDeneb "github.com/attestantio/go-builder-client/api/deneb"
+	builderV1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethereum/go-ethereum/beacon/dencun"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/suave/artifacts"
+	builder "github.com/ethereum/go-ethereum/suave/builder/api"
 	suave "github.com/ethereum/go-ethereum/suave/core"
 	"github.com/ethereum/go-ethereum/suave/cstore"
+	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/scrypt"
 )
@@ -34,6 +48,10 @@ func (m *mockSuaveBackend) AddTransaction(ctx context.Context, sessionId string,
 	return &types.SimulateTransactionResult{}, nil
 }
 
+func (m *mockSuaveBackend) SimulateBatch(ctx context.Context, sessionId string, candidates []types.Transactions) ([]*builder.SimulateBatchResult, error) {
+	return nil, nil
+}
+
 func (m *mockSuaveBackend) InitializeBid(record suave.DataRecord) error {
 	return nil
 }
@@ -70,6 +88,13 @@ func (m *mockSuaveBackend) BuildEthBlockFromBundles(ctx context.Context, args *s
 	return nil, nil
 }
 
+func (m *mockSuaveBackend) SimulateBundle(ctx context.Context, args *suave.BuildBlockArgs, bundle *types.SBundle) (*types.SimulateBundleResult, error) {
+	return &types.SimulateBundleResult{
+		Success: true,
+		Logs:    []*types.SimulatedLog{{Addr: common.Address{0x1}, Topics: []common.Hash{{0x2}}}},
+	}, nil
+}
+
 func (m *mockSuaveBackend) Call(ctx context.Context, contractAddr common.Address, input []byte) ([]byte, error) {
 	return nil, nil
 }
@@ -78,6 +103,39 @@ func (m *mockSuaveBackend) ChainID(ctx context.Context) (*big.Int, error) {
 	return nil, nil
 }
 
+func (m *mockSuaveBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return 1, nil
+}
+
+func (m *mockSuaveBackend) BalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
+	return big.NewInt(100), nil
+}
+
+func (m *mockSuaveBackend) CodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return nil, nil
+}
+
+func (m *mockSuaveBackend) StorageAt(ctx context.Context, account common.Address, key common.Hash) (common.Hash, error) {
+	return key, nil
+}
+
+func (m *mockSuaveBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if number == nil {
+		number = big.NewInt(10)
+	}
+	return &types.Header{Number: number, Time: 1000, GasLimit: 30000000}, nil
+}
+
+func (m *mockSuaveBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return &types.Receipt{
+		TxHash:      txHash,
+		BlockNumber: big.NewInt(10),
+		Status:      types.ReceiptStatusSuccessful,
+		GasUsed:     21000,
+		Logs:        []*types.Log{{Address: common.Address{0x1}, Data: []byte{0x2}}},
+	}, nil
+}
+
 func (m *mockSuaveBackend) Subscribe() (<-chan cstore.DAMessage, context.CancelFunc) {
 	return nil, func() {}
 }
@@ -193,6 +251,47 @@ func TestSuave_ConfStoreWorkflow(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSuave_FillMevShareBundle(t *testing.T) {
+	b := newTestBackend(t)
+
+	newBundleRecord := func(bundle *types.SBundle) types.DataId {
+		record, err := b.newDataRecord(10, []common.Address{suave.AllowedPeekerAny}, nil, "mevshare:v0")
+		require.NoError(t, err)
+		data, err := json.Marshal(bundle)
+		require.NoError(t, err)
+		require.NoError(t, b.confidentialStore(record.Id, "mevshare:v0:ethBundles", data))
+		return record.Id
+	}
+	fill := func(user *types.SBundle) *types.MevShareBundle {
+		match := &types.SBundle{Txs: types.Transactions{types.NewTransaction(0, common.Address{0x2}, big.NewInt(1), 21000, big.NewInt(1), nil)}}
+		ids, err := dataIDsAbi.Inputs.Pack([]types.DataId{newBundleRecord(user), newBundleRecord(match)})
+		require.NoError(t, err)
+
+		record, err := b.newDataRecord(10, []common.Address{suave.AllowedPeekerAny}, nil, "mevshare:v0")
+		require.NoError(t, err)
+		require.NoError(t, b.confidentialStore(record.Id, "mevshare:v0:mergedDataRecords", ids))
+
+		data, err := b.fillMevShareBundle(record.Id)
+		require.NoError(t, err)
+		var bundle types.MevShareBundle
+		require.NoError(t, json.Unmarshal(data, &bundle))
+		return &bundle
+	}
+	userTx := types.NewTransaction(0, common.Address{0x1}, big.NewInt(1), 21000, big.NewInt(1), nil)
+
+	// the refund and the inclusion range not set by the user are the kettle defaults
+	b.suaveContext.Backend.MevShare = &MevShareConfig{RefundPercent: 30, InclusionRange: 5}
+	bundle := fill(&types.SBundle{Txs: types.Transactions{userTx}})
+	require.Equal(t, []types.MevShareRefund{{BodyIdx: 0, Percent: 30}}, bundle.Validity.Refund)
+	require.Equal(t, types.MevShareInclusion{Block: 10, MaxBlock: 15}, bundle.Inclusion)
+
+	// the ones set by the user are kept
+	refundPercent := 50
+	bundle = fill(&types.SBundle{Txs: types.Transactions{userTx}, RefundPercent: &refundPercent, MaxBlock: big.NewInt(12)})
+	require.Equal(t, []types.MevShareRefund{{BodyIdx: 0, Percent: 50}}, bundle.Validity.Refund)
+	require.Equal(t, types.MevShareInclusion{Block: 10, MaxBlock: 12}, bundle.Inclusion)
+}
+
 type httpTestHandler struct {
 	fn func(w http.ResponseWriter, r *http.Request)
 }
@@ -221,6 +320,144 @@ func basicHandler(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte("ok1"))
 }
 
+func TestSuave_ExtractHints(t *testing.T) {
+	b := newTestBackend(t)
+
+	tx := types.NewTransaction(0, common.Address{0x3}, big.NewInt(5), 100000, big.NewInt(1), []byte{0x1, 0x2, 0x3, 0x4, 0x5})
+	bundleData, err := json.Marshal(&types.SBundle{Txs: types.Transactions{tx}})
+	require.NoError(t, err)
+
+	hint, err := b.extractHints(bundleData, types.HintContractAddress|types.HintFunctionSelector)
+	require.NoError(t, err)
+	require.Equal(t, []*types.TxHint{{To: common.Address{0x3}, Value: big.NewInt(0), FunctionSelector: [4]byte{0x1, 0x2, 0x3, 0x4}}}, hint.Txs)
+	require.Empty(t, hint.Logs)
+
+	hint, err = b.extractHints(bundleData, types.HintCalldata|types.HintTxHash|types.HintValue|types.HintLogs)
+	require.NoError(t, err)
+	require.Equal(t, []*types.TxHint{{Hash: tx.Hash(), Value: big.NewInt(5), CallData: tx.Data()}}, hint.Txs)
+	require.Equal(t, []*types.SimulatedLog{{Addr: common.Address{0x1}, Topics: []common.Hash{{0x2}}}}, hint.Logs)
+
+	// the hint is abi encoded
+	_, err = artifacts.SuaveAbi.Methods["extractHints"].Outputs.Pack(hint)
+	require.NoError(t, err)
+}
+
+func TestSuave_SignEthTypedTransaction(t *testing.T) {
+	b := newTestBackend(t)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signingKey := hex.EncodeToString(crypto.FromECDSA(key))
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	newTxn := func(txType uint8) types.EthTransaction {
+		return types.EthTransaction{
+			TxType:     txType,
+			ChainId:    big.NewInt(5),
+			Nonce:      1,
+			GasPrice:   big.NewInt(10),
+			GasTipCap:  big.NewInt(1),
+			GasFeeCap:  big.NewInt(10),
+			Gas:        21000,
+			To:         common.Address{0x1},
+			Value:      big.NewInt(100),
+			Data:       []byte{0x1},
+			BlobFeeCap: big.NewInt(0),
+		}
+	}
+
+	for _, txType := range []uint8{types.LegacyTxType, types.AccessListTxType, types.DynamicFeeTxType, types.BlobTxType} {
+		txn := newTxn(txType)
+		if txType != types.LegacyTxType {
+			txn.AccessList = []*types.AccessListEntry{{Addr: common.Address{0x2}, StorageKeys: []common.Hash{{0x3}}}}
+		}
+		if txType == types.BlobTxType {
+			txn.BlobHashes = []common.Hash{{0x1}}
+		}
+
+		signedTxn, txHash, err := b.signEthTypedTransaction(txn, signingKey)
+		require.NoError(t, err)
+
+		var tx types.Transaction
+		require.NoError(t, tx.UnmarshalBinary(signedTxn))
+		require.Equal(t, txType, tx.Type())
+		require.Equal(t, txHash, tx.Hash())
+		require.Equal(t, big.NewInt(5), tx.ChainId())
+		require.Equal(t, txn.To, *tx.To())
+
+		from, err := types.Sender(types.NewCancunSigner(big.NewInt(5)), &tx)
+		require.NoError(t, err)
+		require.Equal(t, sender, from)
+	}
+
+	// contract creation
+	creation := newTxn(types.DynamicFeeTxType)
+	creation.To = common.Address{}
+	signedTxn, _, err := b.signEthTypedTransaction(creation, signingKey)
+	require.NoError(t, err)
+
+	var tx types.Transaction
+	require.NoError(t, tx.UnmarshalBinary(signedTxn))
+	require.Nil(t, tx.To())
+
+	// invalid transactions
+	invalid := []types.EthTransaction{newTxn(types.BlobTxType), creation, newTxn(0x10)}
+	invalid[1].TxType = types.BlobTxType
+	invalid[1].BlobHashes = []common.Hash{{0x1}}
+	for _, txn := range invalid {
+		_, _, err := b.signEthTypedTransaction(txn, signingKey)
+		require.Error(t, err)
+	}
+}
+
+func TestSuave_EthState(t *testing.T) {
+	b := newTestBackend(t)
+
+	nonce, err := b.getNonce(common.Address{0x1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), nonce)
+
+	balance, err := b.getBalance(common.Address{0x1})
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), balance)
+
+	// the code is never nil to be abi encoded
+	code, err := b.getCode(common.Address{0x1})
+	require.NoError(t, err)
+	require.Equal(t, []byte{}, code)
+
+	value, err := b.getStorageAt(common.Address{0x1}, common.Hash{0x2})
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{0x2}, value)
+
+	// the maximum number is the latest block, 0 is the genesis block
+	header, err := b.getBlockHeader(math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), header.Number)
+	require.Equal(t, big.NewInt(0), header.BaseFee)
+
+	header, err = b.getBlockHeader(0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), header.Number)
+
+	header, err = b.getBlockHeader(5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), header.Number)
+
+	receipt, err := b.getTransactionReceipt(common.Hash{0x3})
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{0x3}, receipt.TxHash)
+	require.Equal(t, uint64(10), receipt.BlockNumber)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	require.Equal(t, []*types.SimulatedLog{{Addr: common.Address{0x1}, Data: []byte{0x2}, Topics: []common.Hash{}}}, receipt.Logs)
+
+	// the results are abi encoded
+	_, err = artifacts.SuaveAbi.Methods["getBlockHeader"].Outputs.Pack(header)
+	require.NoError(t, err)
+	_, err = artifacts.SuaveAbi.Methods["getTransactionReceipt"].Outputs.Pack(receipt)
+	require.NoError(t, err)
+}
+
 func TestSuave_HttpRequest_Basic(t *testing.T) {
 	srv := httptest.NewServer(&httpTestHandler{
 		fn: basicHandler,
@@ -317,6 +554,93 @@ func TestSuave_HttpRequest_Basic(t *testing.T) {
 	}
 }
 
+func TestSuave_SubmitEthBlockToRelay_Blobs(t *testing.T) {
+	var received builderDeneb.SubmitBlockRequest
+	srv := httptest.NewServer(&httpTestHandler{
+		fn: func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.NoError(t, received.UnmarshalJSON(body))
+		},
+	})
+	defer srv.Close()
+
+	builtBlobs := NewBuiltBlobs()
+	s := &suaveRuntime{
+		suaveContext: &SuaveContext{
+			Backend: &SuaveExecutionBackend{
+				ExternalWhitelist: []string{"127.0.0.1"},
+				BuiltBlobs:        builtBlobs,
+			},
+		},
+	}
+
+	bid := &builderDeneb.SubmitBlockRequest{
+		Message: &builderV1.BidTrace{Slot: 1, Value: uint256.NewInt(1)},
+		ExecutionPayload: &deneb.ExecutionPayload{
+			BlockHash:     phase0.Hash32{0x1},
+			BaseFeePerGas: uint256.NewInt(1),
+			ExtraData:     []byte{},
+			Transactions:  []bellatrix.Transaction{},
+			Withdrawals:   []*capella.Withdrawal{},
+		},
+		BlobsBundle: &builderDeneb.BlobsBundle{},
+	}
+	bidBytes, err := bid.MarshalJSON()
+	require.NoError(t, err)
+
+	// the bids of the blocks built by the kettle are submitted with their blobs
+	blobsBundle := &builderDeneb.BlobsBundle{
+		Commitments: []deneb.KZGCommitment{{0x2}},
+		Proofs:      []deneb.KZGProof{{0x3}},
+		Blobs:       []deneb.Blob{{0x4}},
+	}
+	builtBlobs.Add(bid.ExecutionPayload.BlockHash, blobsBundle)
+
+	_, err = s.submitEthBlockToRelay(srv.URL, bidBytes)
+	require.NoError(t, err)
+	require.Equal(t, blobsBundle, received.BlobsBundle)
+
+	// the other bids are submitted as they are
+	bid.ExecutionPayload.BlockHash = phase0.Hash32{0x5}
+	bidBytes, err = bid.MarshalJSON()
+	require.NoError(t, err)
+
+	_, err = s.submitEthBlockToRelay(srv.URL, bidBytes)
+	require.NoError(t, err)
+	require.Empty(t, received.BlobsBundle.Blobs)
+}
+
+func TestSuave_TypedErrors(t *testing.T) {
+	suaveContext := &SuaveContext{
+		Backend: &SuaveExecutionBackend{},
+		Context: map[string][]byte{},
+	}
+
+	// the typed errors of the precompiles revert with their ABI encoding
+	input, err := artifacts.SuaveAbi.Methods["contextGet"].Inputs.Pack("a")
+	require.NoError(t, err)
+
+	ret, err := NewSuavePrecompiledContractWrapper(contextGetAddr, suaveContext).Run(input)
+	require.ErrorIs(t, err, ErrExecutionReverted)
+	require.Equal(t, artifacts.SuaveAbi.Errors["ContextValueNotFound"].ID.Bytes()[:4], ret[:4])
+	require.Equal(t, types.ContextValueNotFoundError{Key: "a"}, UnpackSuaveError(ret))
+
+	// any other error reverts with its message
+	input, err = artifacts.SuaveAbi.Methods["doHTTPRequest"].Inputs.Pack(types.HttpRequest{})
+	require.NoError(t, err)
+
+	ret, err = NewSuavePrecompiledContractWrapper(doHTTPRequestAddr, suaveContext).Run(input)
+	require.ErrorIs(t, err, ErrExecutionReverted)
+	require.Equal(t, "only GET and POST methods are supported", string(ret))
+	require.Nil(t, UnpackSuaveError(ret))
+
+	// typed errors are matched even if wrapped
+	data, ok := packSuaveError(fmt.Errorf("failed: %w", types.HttpRequestFailedError{Status: 404, Body: []byte("b")}))
+	require.True(t, ok)
+	require.Equal(t, types.HttpRequestFailedError{Status: 404, Body: []byte("b")}, UnpackSuaveError(data))
+}
+
 func TestSuave_HttpRequest_FlashbotsSignatue(t *testing.T) {
 	signingKey, _ := crypto.GenerateKey()
 	signingKeyAddr := crypto.PubkeyToAddress(signingKey.PublicKey).Hex()
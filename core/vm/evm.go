@@ -53,6 +53,13 @@ func (evm *EVM) precompile(addr common.Address) (PrecompiledContract, bool) {
 			suaveContext := NewRuntimeSuaveContext(evm, addr)
 			return NewSuavePrecompiledContractWrapper(addr, suaveContext), true
 		}
+
+		// precompiles served by the plugins of the kettle
+		if evm.Config.IsConfidential && evm.SuaveContext != nil {
+			if plugin := evm.SuaveContext.Backend.plugin(addr); plugin != nil {
+				return newPluginPrecompile(plugin, NewRuntimeSuaveContext(evm, addr)), true
+			}
+		}
 	}
 	var precompiles map[common.Address]PrecompiledContract
 	switch {
@@ -3,13 +3,17 @@ package vm
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/suave/artifacts"
+	"github.com/ethereum/go-ethereum/suave/beacon"
 	suave "github.com/ethereum/go-ethereum/suave/core"
+	"github.com/ethereum/go-ethereum/suave/plugins"
+	"github.com/ethereum/go-ethereum/suave/records"
 	"github.com/flashbots/go-boost-utils/bls"
 	"golang.org/x/exp/slices"
 )
@@ -39,6 +43,61 @@ type SuaveExecutionBackend struct {
 	ServiceAliasRegistry   map[string]string
 	ConfidentialStore      ConfidentialStore
 	ConfidentialEthBackend suave.ConfidentialEthBackend
+	BeaconNetworks         *beacon.Registry
+	BeaconContext          beacon.ContextProvider
+	BundleRecords          *records.Registry
+	Plugins                *plugins.Registry
+	HttpTransport          http.RoundTripper // sends the requests of the http precompiles if set
+	BuiltBlobs             *BuiltBlobs       // keeps the blobs of the built blocks for their submission if set
+	MevShare               *MevShareConfig   // defaults of the filled mev-share bundles, DefaultMevShareConfig if not set
+}
+
+// MevShareConfig are the defaults of the mev-share bundles filled by fillMevShareBundle,
+// used when the user bundle does not set them.
+type MevShareConfig struct {
+	RefundPercent  int    // percent of the profit refunded to the user
+	InclusionRange uint64 // number of blocks targeted after the decryption condition
+}
+
+// DefaultMevShareConfig are the default mev-share settings of a kettle.
+var DefaultMevShareConfig = MevShareConfig{
+	RefundPercent:  suave.DefaultConfig.MevShareRefundPercent,
+	InclusionRange: suave.DefaultConfig.MevShareInclusionRange,
+}
+
+// Validate checks the refund percent and the inclusion range can be used in a bundle.
+func (c *MevShareConfig) Validate() error {
+	if c.RefundPercent < 0 || c.RefundPercent > 100 {
+		return fmt.Errorf("invalid mev-share refund percent %d", c.RefundPercent)
+	}
+	if c.InclusionRange > types.MevShareMaxInclusionRange {
+		return fmt.Errorf("mev-share inclusion range is longer than %d blocks", types.MevShareMaxInclusionRange)
+	}
+	return nil
+}
+
+// beaconNetworks returns the configured beacon networks, or the default ones if none is set.
+func (b *SuaveExecutionBackend) beaconNetworks() *beacon.Registry {
+	if b.BeaconNetworks == nil {
+		return beacon.DefaultRegistry()
+	}
+	return b.BeaconNetworks
+}
+
+// mevShare returns the configured mev-share settings, or the default ones if none is set.
+func (b *SuaveExecutionBackend) mevShare() *MevShareConfig {
+	if b.MevShare == nil {
+		return &DefaultMevShareConfig
+	}
+	return b.MevShare
+}
+
+// bundleRecords returns the configured bundle record types, or the default ones if none is set.
+func (b *SuaveExecutionBackend) bundleRecords() *records.Registry {
+	if b.BundleRecords == nil {
+		return records.DefaultRegistry()
+	}
+	return b.BundleRecords
 }
 
 func NewRuntimeSuaveContext(evm *EVM, caller common.Address) *SuaveContext {
@@ -92,7 +151,12 @@ func (p *SuavePrecompiledContractWrapper) Run(input []byte) ([]byte, error) {
 
 	ret, err := stub.run(p.addr, input)
 	if err != nil && ret == nil {
-		ret = []byte(err.Error())
+		// typed errors revert with their ABI encoding, any other one with its message
+		if data, ok := packSuaveError(err); ok {
+			ret = data
+		} else {
+			ret = []byte(err.Error())
+		}
 		err = ErrExecutionReverted
 	}
 
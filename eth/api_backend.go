@@ -43,8 +43,11 @@ import (
 	"github.com/ethereum/go-ethereum/miner"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/suave/beacon"
 	suave "github.com/ethereum/go-ethereum/suave/core"
 	"github.com/ethereum/go-ethereum/suave/cstore"
+	"github.com/ethereum/go-ethereum/suave/plugins"
+	"github.com/ethereum/go-ethereum/suave/records"
 	"github.com/flashbots/go-boost-utils/bls"
 )
 
@@ -60,6 +63,12 @@ type EthAPIBackend struct {
 	suaveEthBackend           suave.ConfidentialEthBackend
 	suaveExternalWhitelist    []string
 	suaveServiceAliasRegistry map[string]string
+	suaveBeaconNetworks       *beacon.Registry
+	suaveBeaconTracker        *beacon.Tracker
+	suaveBundleRecords        *records.Registry
+	suavePlugins              *plugins.Registry
+	suaveBuiltBlobs           *vm.BuiltBlobs
+	suaveMevShare             *vm.MevShareConfig
 }
 
 // For testing purposes
@@ -434,6 +443,14 @@ func (b *EthAPIBackend) StartMining() error {
 	return b.eth.StartMining()
 }
 
+// beaconContext returns the beacon tracker if the kettle follows a beacon node.
+func (b *EthAPIBackend) beaconContext() beacon.ContextProvider {
+	if b.suaveBeaconTracker == nil {
+		return nil
+	}
+	return b.suaveBeaconTracker
+}
+
 func (b *EthAPIBackend) SuaveContext(requestTx *types.Transaction, ccr *types.ConfidentialComputeRequest) vm.SuaveContext {
 	storeTransaction := b.suaveEngine.NewTransactionalStore(requestTx)
 	return vm.SuaveContext{
@@ -449,6 +466,12 @@ func (b *EthAPIBackend) SuaveContext(requestTx *types.Transaction, ccr *types.Co
 			ServiceAliasRegistry:   b.suaveServiceAliasRegistry,
 			ConfidentialStore:      storeTransaction,
 			ConfidentialEthBackend: b.suaveEthBackend,
+			BeaconNetworks:         b.suaveBeaconNetworks,
+			BeaconContext:          b.beaconContext(),
+			BundleRecords:          b.suaveBundleRecords,
+			Plugins:                b.suavePlugins,
+			BuiltBlobs:             b.suaveBuiltBlobs,
+			MevShare:               b.suaveMevShare,
 		},
 	}
 }
@@ -461,6 +484,10 @@ func (b *EthAPIBackend) BuildBlockFromBundles(ctx context.Context, buildArgs *su
 	return b.eth.Miner().BuildBlockFromBundles(ctx, buildArgs, bundles)
 }
 
+func (b *EthAPIBackend) SimulateBundle(ctx context.Context, buildArgs *suave.BuildBlockArgs, bundle *types.SBundle) (*types.SimulateBundleResult, error) {
+	return b.eth.Miner().SimulateBundle(ctx, buildArgs, bundle)
+}
+
 func (b *EthAPIBackend) StateAtBlock(ctx context.Context, block *types.Block, reexec uint64, base *state.StateDB, readOnly bool, preferDisk bool) (*state.StateDB, tracers.StateReleaseFunc, error) {
 	return b.eth.StateAtBlock(ctx, block, reexec, base, readOnly, preferDisk)
 }
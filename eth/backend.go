@@ -59,10 +59,13 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/suave/backends"
 	suave_backends "github.com/ethereum/go-ethereum/suave/backends"
+	suave_beacon "github.com/ethereum/go-ethereum/suave/beacon"
 	suave_builder "github.com/ethereum/go-ethereum/suave/builder"
 	suave_builder_api "github.com/ethereum/go-ethereum/suave/builder/api"
 	suave "github.com/ethereum/go-ethereum/suave/core"
 	"github.com/ethereum/go-ethereum/suave/cstore"
+	suave_plugins "github.com/ethereum/go-ethereum/suave/plugins"
+	suave_records "github.com/ethereum/go-ethereum/suave/records"
 	"github.com/flashbots/go-boost-utils/bls"
 )
 
@@ -285,12 +288,55 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		return nil, err
 	}
 
+	suaveBeaconNetworks := suave_beacon.DefaultRegistry()
+	for _, path := range config.Suave.BeaconSpecs {
+		network, err := suave_beacon.LoadSpecFile(path)
+		if err != nil {
+			return nil, err
+		}
+		suaveBeaconNetworks.Register(network)
+	}
+
+	var suaveBeaconTracker *suave_beacon.Tracker
+	if config.Suave.BeaconEndpoint != "" {
+		suaveBeaconTracker = suave_beacon.NewEndpointTracker(config.Suave.BeaconEndpoint)
+	}
+
+	suaveBundleRecords := suave_records.DefaultRegistry()
+	for _, spec := range config.Suave.BundleRecordTypes {
+		typ, err := suave_records.ParseType(spec)
+		if err != nil {
+			return nil, err
+		}
+		suaveBundleRecords.Register(typ)
+	}
+
+	suavePlugins := suave_plugins.NewRegistry()
+	for _, path := range config.Suave.Plugins {
+		plugin, err := suave_plugins.LoadSpecFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := suavePlugins.Register(plugin); err != nil {
+			return nil, err
+		}
+		log.Info("Registered precompile plugin", "name", plugin.Name, "address", plugin.Address)
+	}
+
+	suaveMevShare := &vm.MevShareConfig{
+		RefundPercent:  config.Suave.MevShareRefundPercent,
+		InclusionRange: config.Suave.MevShareInclusionRange,
+	}
+	if err := suaveMevShare.Validate(); err != nil {
+		return nil, err
+	}
+
 	suaveDaSigner := &cstore.AccountManagerDASigner{Manager: eth.AccountManager()}
 
 	confidentialStoreEngine := cstore.NewEngine(confidentialStoreBackend, confidentialStoreTransport, suaveDaSigner, types.LatestSigner(chainConfig))
 
 	eth.APIBackend = &EthAPIBackend{stack.Config().ExtRPCEnabled(), stack.Config().AllowUnprotectedTxs, eth, nil,
-		suaveEthBundleSigningKey, suaveEthBlockSigningKey, confidentialStoreEngine, suaveEthBackend, config.Suave.ExternalWhitelist, config.Suave.AliasRegistry}
+		suaveEthBundleSigningKey, suaveEthBlockSigningKey, confidentialStoreEngine, suaveEthBackend, config.Suave.ExternalWhitelist, config.Suave.AliasRegistry, suaveBeaconNetworks, suaveBeaconTracker, suaveBundleRecords, suavePlugins, vm.NewBuiltBlobs(), suaveMevShare}
 	if eth.APIBackend.allowUnprotectedTxs {
 		log.Info("Unprotected transactions allowed")
 	}
@@ -319,6 +365,10 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	stack.RegisterProtocols(eth.Protocols())
 	stack.RegisterLifecycle(eth)
 	stack.RegisterLifecycle(confidentialStoreEngine)
+	stack.RegisterLifecycle(suavePlugins)
+	if suaveBeaconTracker != nil {
+		stack.RegisterLifecycle(suaveBeaconTracker)
+	}
 
 	// Successful startup; push a marker and check previous unclean shutdowns.
 	eth.shutdownTracker.MarkStartup()
@@ -354,7 +404,9 @@ func (s *Ethereum) APIs() []rpc.API {
 		Service:   backends.NewEthBackendServer(s.APIBackend),
 	})
 
-	sessionManager := suave_builder.NewSessionManager(s.blockchain, &suave_builder.Config{})
+	sessionManager := suave_builder.NewSessionManager(s.blockchain, &suave_builder.Config{
+		MaxSimulationWorkers: s.config.Suave.BuilderSimulationWorkers,
+	})
 
 	apis = append(apis, rpc.API{
 		Namespace: "suavex",
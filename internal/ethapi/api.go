@@ -26,6 +26,7 @@ import (
 	"math/big"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/ethereum/go-ethereum/accounts"
@@ -48,8 +49,11 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/suave/artifacts"
 	suave "github.com/ethereum/go-ethereum/suave/core"
+	"github.com/ethereum/go-ethereum/suave/plugins"
 	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/exp/slices"
 )
 
 // EthereumAPI provides an API to access Ethereum related information.
@@ -1024,41 +1028,7 @@ func DoCall(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash
 	}
 
 	if args.IsConfidential {
-		if args.KettleAddress == nil {
-			acc := b.AccountManager().Accounts()[0]
-			args.KettleAddress = &acc
-		}
-
-		// DoCall is also used in EstimateGas which does not have to include
-		// gas parameters or nonce. We need to set them to default values.
-		args.setDefaults(ctx, b)
-		tx := args.ToTransaction()
-
-		state, header, err := b.StateAndHeaderByNumber(ctx, rpc.LatestBlockNumber)
-		if state == nil || err != nil {
-			return nil, err
-		}
-
-		msg := &core.Message{
-			Nonce:             tx.Nonce(),
-			GasLimit:          tx.Gas(),
-			GasPrice:          tx.GasPrice(),
-			GasFeeCap:         new(big.Int),
-			GasTipCap:         new(big.Int),
-			To:                tx.To(),
-			Value:             tx.Value(),
-			Data:              tx.Data(),
-			AccessList:        tx.AccessList(),
-			SkipAccountChecks: true,
-		}
-
-		// Run the MEVM but unlike with the send transaction endpoint, do not
-		// finalize the transactional store (third callback param). Otherwise,
-		// the updated kv entries will be committed to the store.
-		_, result, _, err := runMEVM(ctx, b, state, header, tx, msg, true)
-		if err != nil {
-			return nil, err
-		}
+		_, result, err := doConfidentialCall(ctx, b, args, &mevmStateLogger{})
 		return result, err
 	}
 
@@ -1089,6 +1059,48 @@ func DoCall(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash
 	return result, nil
 }
 
+// doConfidentialCall runs a confidential request on the latest state with the
+// tracer, and returns the result transaction of the kettle along with the
+// execution result. The confidential store writes are not finalized.
+func doConfidentialCall(ctx context.Context, b Backend, args TransactionArgs, tracer *mevmStateLogger) (*types.Transaction, *core.ExecutionResult, error) {
+	if args.KettleAddress == nil {
+		acc := b.AccountManager().Accounts()[0]
+		args.KettleAddress = &acc
+	}
+
+	// DoCall is also used in EstimateGas which does not have to include
+	// gas parameters or nonce. We need to set them to default values.
+	args.setDefaults(ctx, b)
+	tx := args.ToTransaction()
+
+	state, header, err := b.StateAndHeaderByNumber(ctx, rpc.LatestBlockNumber)
+	if state == nil || err != nil {
+		return nil, nil, err
+	}
+
+	msg := &core.Message{
+		Nonce:             tx.Nonce(),
+		GasLimit:          tx.Gas(),
+		GasPrice:          tx.GasPrice(),
+		GasFeeCap:         new(big.Int),
+		GasTipCap:         new(big.Int),
+		To:                tx.To(),
+		Value:             tx.Value(),
+		Data:              tx.Data(),
+		AccessList:        tx.AccessList(),
+		SkipAccountChecks: true,
+	}
+
+	// Run the MEVM but unlike with the send transaction endpoint, do not
+	// finalize the transactional store (third callback param). Otherwise,
+	// the updated kv entries will be committed to the store.
+	ntx, result, _, err := traceMEVM(ctx, b, state, header, tx, msg, true, tracer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ntx, result, nil
+}
+
 func newRevertError(result *core.ExecutionResult) *revertError {
 	reason, errUnpack := abi.UnpackRevert(result.Revert())
 	err := errors.New("execution reverted")
@@ -1137,6 +1149,63 @@ func (s *BlockChainAPI) Call(ctx context.Context, args TransactionArgs, blockNrO
 	return result.Return(), result.Err
 }
 
+// TraceConfidentialCall simulates a confidential request, as a confidential call
+// does, and returns its result with the data records it creates and the calls
+// it makes to the confidential precompiles. A failed request is not an error,
+// its trace is returned with the error. The confidential store is left untouched.
+func (s *BlockChainAPI) TraceConfidentialCall(ctx context.Context, args TransactionArgs) (*ConfidentialCallTrace, error) {
+	args.IsConfidential = true
+
+	var cancel context.CancelFunc
+	if timeout := s.b.RPCEVMTimeout(); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	// unlike a call, the trace fails if the gas or the nonce of the request
+	// are neither given nor filled by the defaults
+	if err := args.setDefaults(ctx, s.b); err != nil && (args.Gas == nil || args.Nonce == nil) {
+		return nil, err
+	}
+
+	tracer := &mevmStateLogger{trace: true}
+	tx, result, err := doConfidentialCall(ctx, s.b, args, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	trace := &ConfidentialCallTrace{
+		ReturnData:  result.ReturnData,
+		Logs:        []*types.Log{},
+		Records:     tracer.records(),
+		Precompiles: tracer.calls,
+	}
+	if trace.Precompiles == nil {
+		trace.Precompiles = []*PrecompileCall{}
+	}
+	if result.Failed() {
+		// the error already describes the reverts of the precompiles
+		trace.Error = result.Err.Error()
+		if reason, err := abi.UnpackRevert(result.Revert()); err == nil {
+			trace.Error = fmt.Sprintf("%v: %v", result.Err, reason)
+		}
+		return trace, nil
+	}
+
+	suaveTx, ok := types.CastTxInner[*types.SuaveTransaction](tx)
+	if !ok {
+		return nil, errors.New("invalid result transaction")
+	}
+	computeResult, execResult := suave.SplitComputeResult(suaveTx.ConfidentialComputeResult)
+	trace.ComputeResult = computeResult
+	if len(execResult.Logs) != 0 {
+		trace.Logs = execResult.Logs
+	}
+	return trace, nil
+}
+
 func DoEstimateGas(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, gasCap uint64) (hexutil.Uint64, error) {
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
@@ -1880,6 +1949,9 @@ func (s *TransactionAPI) SendTransaction(ctx context.Context, args TransactionAr
 		if err != nil {
 			return common.Hash{}, err
 		}
+		if err := checkConfidentialRequestNonce(state, account.Address, signed.Nonce()); err != nil {
+			return common.Hash{}, err
+		}
 
 		ntx, _, finalize, err := runMEVM(ctx, s.b, state, header, signed, msg, false)
 		if err != nil {
@@ -1890,7 +1962,7 @@ func (s *TransactionAPI) SendTransaction(ctx context.Context, args TransactionAr
 			log.Error("could not finalize confidential store", "err", err)
 			return tx.Hash(), err
 		}
-		signed = ntx
+		return submitConfidentialResult(ctx, s.b, ntx)
 	}
 	return SubmitTransaction(ctx, s.b, signed)
 }
@@ -1930,6 +2002,9 @@ func (s *TransactionAPI) SendRawTransaction(ctx context.Context, input hexutil.B
 		if err != nil {
 			return common.Hash{}, err
 		}
+		if err := checkConfidentialRequestNonce(state, msg.From, msg.Nonce); err != nil {
+			return tx.Hash(), err
+		}
 
 		ntx, result, finalize, err := runMEVM(ctx, s.b, state, header, tx, msg, false)
 		if err != nil {
@@ -1942,18 +2017,49 @@ func (s *TransactionAPI) SendRawTransaction(ctx context.Context, input hexutil.B
 			log.Error("could not finalize confidential store", "err", err)
 			return tx.Hash(), err
 		}
-		tx = ntx
+		return submitConfidentialResult(ctx, s.b, ntx)
 	}
 
 	return SubmitTransaction(ctx, s.b, tx)
 }
 
+// checkConfidentialRequestNonce fails with core.ErrNonceTooLow if the nonce of
+// a confidential request is stale. It is checked before the request is executed,
+// so that a client can resend the request without running its computation twice.
+func checkConfidentialRequestNonce(state *state.StateDB, from common.Address, nonce uint64) error {
+	if state.GetNonce(from) > nonce {
+		return core.ErrNonceTooLow
+	}
+	return nil
+}
+
+// submitConfidentialResult submits the result transaction of an executed
+// confidential request. The pool errors are wrapped, since resending the
+// request would execute it again.
+func submitConfidentialResult(ctx context.Context, b Backend, tx *types.Transaction) (common.Hash, error) {
+	hash, err := SubmitTransaction(ctx, b, tx)
+	if err != nil {
+		return hash, fmt.Errorf("confidential request executed, but its result was rejected: %w", err)
+	}
+	return hash, nil
+}
+
 type mevmStateLogger struct {
 	suappAddr      common.Address
 	hasStoredState bool
+
+	// trace records the calls to the confidential precompiles and the plugins
+	trace   bool
+	plugins *plugins.Registry
+	calls   []*PrecompileCall
+	// frames are the entered call frames, nil for the ones not entering a precompile
+	frames []*PrecompileCall
 }
 
 func (m *mevmStateLogger) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	if m.trace && env.SuaveContext != nil && env.SuaveContext.Backend != nil {
+		m.plugins = env.SuaveContext.Backend.Plugins
+	}
 }
 
 func (m *mevmStateLogger) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
@@ -1974,18 +2080,147 @@ func (m *mevmStateLogger) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64
 func (m *mevmStateLogger) CaptureEnd(output []byte, gasUsed uint64, err error) {}
 
 func (m *mevmStateLogger) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if !m.trace {
+		return
+	}
+	var call *PrecompileCall
+	if name := m.precompileName(to); name != "" {
+		call = &PrecompileCall{
+			Name:    name,
+			Address: to,
+			Caller:  from,
+			Depth:   len(m.frames) + 1,
+			Input:   common.CopyBytes(input),
+		}
+		m.calls = append(m.calls, call)
+	}
+	m.frames = append(m.frames, call)
 }
 
-func (m *mevmStateLogger) CaptureExit(output []byte, gasUsed uint64, err error) {}
+func (m *mevmStateLogger) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if !m.trace || len(m.frames) == 0 {
+		return
+	}
+	call := m.frames[len(m.frames)-1]
+	m.frames = m.frames[:len(m.frames)-1]
+	if call == nil {
+		return
+	}
+	call.Output = common.CopyBytes(output)
+	if err != nil {
+		call.Error = err.Error()
+		// the precompiles revert with a typed error or with the error message
+		if typedErr := vm.UnpackSuaveError(output); typedErr != nil {
+			call.Error = typedErr.Error()
+		} else if len(output) != 0 && utf8.Valid(output) {
+			call.Error = fmt.Sprintf("%v: %s", err, output)
+		}
+	}
+}
 
 func (m *mevmStateLogger) CaptureTxStart(gasLimit uint64) {}
 
 func (m *mevmStateLogger) CaptureTxEnd(restGas uint64) {}
 
-var magicBytes = hexutil.MustDecode("0x543543")
+// precompileName returns the name of the confidential precompile or plugin at
+// an address, or an empty string if there is none.
+func (m *mevmStateLogger) precompileName(addr common.Address) string {
+	if name := artifacts.PrecompileAddressToName(addr); name != "" {
+		return name
+	}
+	if m.plugins != nil {
+		if plugin := m.plugins.Get(addr); plugin != nil {
+			return plugin.Name
+		}
+	}
+	return ""
+}
+
+// records returns the data records created by the traced precompile calls,
+// with the keys stored in them.
+func (m *mevmStateLogger) records() []*ConfidentialRecord {
+	var (
+		records []*ConfidentialRecord
+		byID    = make(map[types.DataId]*ConfidentialRecord)
+	)
+	for _, call := range m.calls {
+		if call.Error != "" {
+			continue
+		}
+		switch call.Name {
+		case "newDataRecord":
+			res, err := artifacts.SuaveAbi.Methods["newDataRecord"].Outputs.Unpack(call.Output)
+			if err != nil {
+				continue
+			}
+			record := *abi.ConvertType(res[0], new(types.DataRecord)).(*types.DataRecord)
+			byID[record.Id] = &ConfidentialRecord{
+				Id:                  hexutil.Bytes(record.Id[:]),
+				DecryptionCondition: hexutil.Uint64(record.DecryptionCondition),
+				AllowedPeekers:      record.AllowedPeekers,
+				AllowedStores:       record.AllowedStores,
+				Version:             record.Version,
+				Keys:                []string{},
+			}
+			records = append(records, byID[record.Id])
+
+		case "confidentialStore":
+			args, err := artifacts.SuaveAbi.Methods["confidentialStore"].Inputs.Unpack(call.Input)
+			if err != nil {
+				continue
+			}
+			record, ok := byID[types.DataId(args[0].([16]byte))]
+			if !ok {
+				continue
+			}
+			if key := args[1].(string); !slices.Contains(record.Keys, key) {
+				record.Keys = append(record.Keys, key)
+			}
+		}
+	}
+	return records
+}
+
+// PrecompileCall is a call to a confidential precompile, or to a plugin of
+// the kettle, made by a confidential request.
+type PrecompileCall struct {
+	Name    string         `json:"name"`
+	Address common.Address `json:"address"`
+	Caller  common.Address `json:"caller"`
+	Depth   int            `json:"depth"` // call depth, 1 for the calls of the contract of the request
+	Input   hexutil.Bytes  `json:"input"`
+	Output  hexutil.Bytes  `json:"output"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// ConfidentialRecord is a data record created by a confidential request.
+type ConfidentialRecord struct {
+	Id                  hexutil.Bytes    `json:"id"`
+	DecryptionCondition hexutil.Uint64   `json:"decryptionCondition"`
+	AllowedPeekers      []common.Address `json:"allowedPeekers"`
+	AllowedStores       []common.Address `json:"allowedStores"`
+	Version             string           `json:"version"`
+	Keys                []string         `json:"keys"` // keys stored in the record by the request
+}
+
+// ConfidentialCallTrace is the outcome of a confidential request simulated
+// with TraceConfidentialCall.
+type ConfidentialCallTrace struct {
+	ReturnData    hexutil.Bytes         `json:"returnData"`              // return data of the request, or its revert data
+	ComputeResult hexutil.Bytes         `json:"computeResult,omitempty"` // compute result without the logs
+	Logs          []*types.Log          `json:"logs"`
+	Error         string                `json:"error,omitempty"`
+	Records       []*ConfidentialRecord `json:"records"`
+	Precompiles   []*PrecompileCall     `json:"precompiles"`
+}
 
 // TODO: should be its own api
 func runMEVM(ctx context.Context, b Backend, state *state.StateDB, header *types.Header, tx *types.Transaction, msg *core.Message, isCall bool) (*types.Transaction, *core.ExecutionResult, func() error, error) {
+	return traceMEVM(ctx, b, state, header, tx, msg, isCall, &mevmStateLogger{})
+}
+
+// traceMEVM runs a confidential request as runMEVM does, with the given tracer.
+func traceMEVM(ctx context.Context, b Backend, state *state.StateDB, header *types.Header, tx *types.Transaction, msg *core.Message, isCall bool, storageAccessTracer *mevmStateLogger) (*types.Transaction, *core.ExecutionResult, func() error, error) {
 	var cancel context.CancelFunc
 	ctx, cancel = context.WithCancel(ctx)
 	defer cancel()
@@ -2003,9 +2238,7 @@ func runMEVM(ctx context.Context, b Backend, state *state.StateDB, header *types
 		return nil, nil, nil, err
 	}
 
-	storageAccessTracer := &mevmStateLogger{
-		suappAddr: *msg.To,
-	}
+	storageAccessTracer.suappAddr = *msg.To
 
 	blockCtx := core.NewEVMBlockContext(header, NewChainContext(ctx, b), nil)
 	suaveCtx := b.SuaveContext(tx, confidentialRequest)
@@ -2087,7 +2320,7 @@ func runMEVM(ctx context.Context, b Backend, state *state.StateDB, header *types
 			return nil, nil, nil, err
 		}
 
-		computeResult = append(computeResult, magicBytes...)
+		computeResult = append(computeResult, suave.ExecResultMagic...)
 		computeResult = append(computeResult, logsEncoded...)
 	}
 
@@ -2133,6 +2366,9 @@ func parseSuavePrecompileError(ret []byte) error {
 	}
 
 	msg := ret[96 : 96+size]
+	if typedErr := vm.UnpackSuaveError(msg); typedErr != nil {
+		return fmt.Errorf("precompile '%s' reverted: %w", precompileAddr, typedErr)
+	}
 	return fmt.Errorf("precompile '%s' reverted: '%s'", precompileAddr, string(msg))
 }
 
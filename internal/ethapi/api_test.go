@@ -47,6 +47,7 @@ import (
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/suave/artifacts"
 	suave "github.com/ethereum/go-ethereum/suave/core"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/sha3"
@@ -753,4 +754,76 @@ func TestSuave_ParsePrecompileError(t *testing.T) {
 	require.Equal(t, err.Error(), `precompile '0x0000000000000000000000000000000043200002' reverted: 'Get "sepolia": unsupported protocol scheme ""'`)
 }
 
+func TestSuave_ParsePrecompileTypedError(t *testing.T) {
+	typedErr := artifacts.SuaveAbi.Errors["DomainNotAllowed"]
+	args, err := typedErr.Inputs.Pack("example.com")
+	require.NoError(t, err)
+
+	data, err := artifacts.SuaveAbi.Errors["PeekerReverted"].Inputs.Pack(common.HexToAddress("0x43200002"), append(typedErr.ID.Bytes()[:4], args...))
+	require.NoError(t, err)
+
+	err = parseSuavePrecompileError(append(common.FromHex("0x75fff467"), data...))
+	require.Equal(t, err.Error(), `precompile '0x0000000000000000000000000000000043200002' reverted: DomainNotAllowed(domain: "example.com")`)
+
+	var domainErr types.DomainNotAllowedError
+	require.ErrorAs(t, err, &domainErr)
+	require.Equal(t, "example.com", domainErr.Domain)
+}
+
+func TestSuave_TracePrecompileCalls(t *testing.T) {
+	var (
+		suapp       = common.Address{0x1}
+		contract    = common.Address{0x2}
+		newRecord   = artifacts.SuaveMethods["newDataRecord"]
+		store       = artifacts.SuaveMethods["confidentialStore"]
+		httpRequest = artifacts.SuaveMethods["doHTTPRequest"]
+	)
+
+	record := types.DataRecord{Id: types.DataId{0x1}, AllowedPeekers: []common.Address{suapp}, AllowedStores: []common.Address{}, Version: "a"}
+	recordOutput, err := artifacts.SuaveAbi.Methods["newDataRecord"].Outputs.Pack(record)
+	require.NoError(t, err)
+	storeInput, err := artifacts.SuaveAbi.Methods["confidentialStore"].Inputs.Pack(record.Id, "key", []byte{0x1})
+	require.NoError(t, err)
+
+	typedErr := artifacts.SuaveAbi.Errors["DomainNotAllowed"]
+	errArgs, err := typedErr.Inputs.Pack("example.com")
+	require.NoError(t, err)
+
+	tracer := &mevmStateLogger{trace: true}
+	tracer.CaptureEnter(vm.STATICCALL, suapp, newRecord, []byte{0x1}, 0, nil)
+	tracer.CaptureExit(recordOutput, 0, nil)
+
+	// the calls of the other contracts are traced with their depth
+	tracer.CaptureEnter(vm.CALL, suapp, contract, nil, 0, nil)
+	tracer.CaptureEnter(vm.STATICCALL, contract, store, storeInput, 0, nil)
+	tracer.CaptureExit(nil, 0, nil)
+	tracer.CaptureEnter(vm.STATICCALL, contract, store, storeInput, 0, nil)
+	tracer.CaptureExit(nil, 0, nil)
+	tracer.CaptureExit(nil, 0, nil)
+
+	// the reverts are decoded
+	tracer.CaptureEnter(vm.STATICCALL, suapp, httpRequest, nil, 0, nil)
+	tracer.CaptureExit(append(typedErr.ID.Bytes()[:4], errArgs...), 0, vm.ErrExecutionReverted)
+	tracer.CaptureEnter(vm.STATICCALL, suapp, newRecord, nil, 0, nil)
+	tracer.CaptureExit([]byte("failed to unpack input"), 0, vm.ErrExecutionReverted)
+
+	require.Len(t, tracer.calls, 5)
+	require.Equal(t, "newDataRecord", tracer.calls[0].Name)
+	require.Equal(t, 1, tracer.calls[0].Depth)
+	require.Equal(t, "confidentialStore", tracer.calls[1].Name)
+	require.Equal(t, contract, tracer.calls[1].Caller)
+	require.Equal(t, 2, tracer.calls[1].Depth)
+	require.Equal(t, `DomainNotAllowed(domain: "example.com")`, tracer.calls[3].Error)
+	require.Equal(t, "execution reverted: failed to unpack input", tracer.calls[4].Error)
+	require.Empty(t, tracer.frames)
+
+	// the records created are listed with the keys stored in them
+	records := tracer.records()
+	require.Len(t, records, 1)
+	require.Equal(t, hexutil.Bytes(record.Id[:]), records[0].Id)
+	require.Equal(t, record.AllowedPeekers, records[0].AllowedPeekers)
+	require.Equal(t, "a", records[0].Version)
+	require.Equal(t, []string{"key"}, records[0].Keys)
+}
+
 // --- end of suave specific ---
@@ -0,0 +1,360 @@
+package miner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"golang.org/x/exp/slices"
+)
+
+// Block building algorithms selectable with BuildBlockArgs.Algorithm.
+const (
+	// AlgorithmDefault applies the bundles in the given order and fails
+	// the block if any of them fails.
+	AlgorithmDefault = "default"
+	// AlgorithmDiscardFailing applies the bundles in the given order and
+	// leaves out the ones that fail.
+	AlgorithmDiscardFailing = "discard-failing"
+	// AlgorithmGreedy applies the bundles sorted by effective gas price
+	// and leaves out the ones that fail.
+	AlgorithmGreedy = "greedy"
+	// AlgorithmGreedyMerge works like AlgorithmGreedy but also leaves out
+	// the bundles that conflict with the ones already in the block.
+	AlgorithmGreedyMerge = "greedy-merge"
+)
+
+var (
+	errBundleConflict = errors.New("bundle conflicts with the block")
+	errNoProfit       = errors.New("payment does not cover the transfer cost")
+)
+
+// maxPaymentGas is the gas limit of the trial transfer measuring the gas used by
+// a payment to a contract.
+const maxPaymentGas = 100_000
+
+// buildAlgorithm decides which bundles are committed to the block and in which order.
+type buildAlgorithm interface {
+	commitBundles(ctx context.Context, b *bundleBuilder, bundles []types.SBundle) error
+}
+
+func newBuildAlgorithm(name string) (buildAlgorithm, error) {
+	switch name {
+	case "", AlgorithmDefault:
+		return &orderedAlgorithm{}, nil
+	case AlgorithmDiscardFailing:
+		return &orderedAlgorithm{discardFailing: true}, nil
+	case AlgorithmGreedy:
+		return &greedyAlgorithm{}, nil
+	case AlgorithmGreedyMerge:
+		return &greedyAlgorithm{merge: true}, nil
+	default:
+		return nil, fmt.Errorf("unknown block building algorithm '%s'", name)
+	}
+}
+
+// orderedAlgorithm applies the bundles in the order they are given.
+type orderedAlgorithm struct {
+	discardFailing bool
+}
+
+func (a *orderedAlgorithm) commitBundles(ctx context.Context, b *bundleBuilder, bundles []types.SBundle) error {
+	for i := range bundles {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, err := b.commitBundle(&bundles[i], nil); err != nil {
+			if !a.discardFailing {
+				return err
+			}
+			log.Debug("Discarding failing bundle", "index", i, "err", err)
+		}
+	}
+	return nil
+}
+
+// greedyAlgorithm simulates every bundle on top of the current block and
+// applies them by descending effective gas price. With merge enabled, the
+// bundles that share transactions with the block, or that pay less than in
+// their simulation because of an earlier bundle, are left out.
+type greedyAlgorithm struct {
+	merge bool
+}
+
+type simulatedBundle struct {
+	bundle *types.SBundle
+	result *bundleResult
+}
+
+func (a *greedyAlgorithm) commitBundles(ctx context.Context, b *bundleBuilder, bundles []types.SBundle) error {
+	simulated := make([]*simulatedBundle, 0, len(bundles))
+	for i := range bundles {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result, err := b.simulate(&bundles[i])
+		if err != nil {
+			log.Debug("Discarding failing bundle", "index", i, "err", err)
+			continue
+		}
+		simulated = append(simulated, &simulatedBundle{bundle: &bundles[i], result: result})
+	}
+
+	sort.SliceStable(simulated, func(i, j int) bool {
+		return simulated[i].result.effectiveGasPrice().Cmp(simulated[j].result.effectiveGasPrice()) > 0
+	})
+
+	included := make(map[common.Hash]struct{})
+	for _, sim := range simulated {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if a.merge && conflictsWith(sim.bundle, included) {
+			log.Debug("Discarding bundle", "err", errBundleConflict)
+			continue
+		}
+
+		var minProfit *big.Int
+		if a.merge {
+			minProfit = sim.result.profit
+		}
+		if _, err := b.commitBundle(sim.bundle, minProfit); err != nil {
+			log.Debug("Discarding bundle", "err", err)
+			continue
+		}
+
+		for _, tx := range sim.bundle.Txs {
+			included[tx.Hash()] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// conflictsWith reports whether any of the bundle transactions is already in the block.
+func conflictsWith(bundle *types.SBundle, included map[common.Hash]struct{}) bool {
+	for _, tx := range bundle.Txs {
+		if _, ok := included[tx.Hash()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// bundleResult is the outcome of committing a bundle to the block.
+type bundleResult struct {
+	gasUsed uint64
+	// profit is what the bundle pays to the block after the refunds.
+	profit *big.Int
+}
+
+func (r *bundleResult) effectiveGasPrice() *big.Int {
+	if r.gasUsed == 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Div(r.profit, new(big.Int).SetUint64(r.gasUsed))
+}
+
+// bundleBuilder commits bundles to a block whose coinbase is an ephemeral
+// account. The coinbase collects the bundle payments, refunds the bundle
+// senders and pays the proposer at the end of the block.
+type bundleBuilder struct {
+	w   *worker
+	env *environment
+	key *ecdsa.PrivateKey
+}
+
+// simulate applies the bundle and its refunds, and reverts the block afterwards.
+func (b *bundleBuilder) simulate(bundle *types.SBundle) (*bundleResult, error) {
+	snap := b.env.snapshot()
+	result, err := b.applyBundle(b.env, bundle)
+	if err := b.env.revert(snap); err != nil {
+		return nil, err
+	}
+	return result, err
+}
+
+// commitBundle applies the bundle and its refunds atomically. If any of the
+// transactions fails or reverts without being allowed to, or the bundle pays
+// less than minProfit, the block is reverted and an error is returned.
+func (b *bundleBuilder) commitBundle(bundle *types.SBundle, minProfit *big.Int) (*bundleResult, error) {
+	snap := b.env.snapshot()
+
+	result, err := b.applyBundle(b.env, bundle)
+	if err == nil && minProfit != nil && result.profit.Cmp(minProfit) < 0 {
+		err = fmt.Errorf("%w: pays %d instead of %d", errBundleConflict, result.profit, minProfit)
+	}
+	if err != nil {
+		if err := b.env.revert(snap); err != nil {
+			return nil, err
+		}
+		return nil, err
+	}
+	if err := b.env.commitSnapshot(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (b *bundleBuilder) applyBundle(env *environment, bundle *types.SBundle) (*bundleResult, error) {
+	if len(bundle.Txs) == 0 {
+		return nil, errors.New("empty bundle")
+	}
+
+	result := &bundleResult{}
+	profitPre := env.state.GetBalance(env.coinbase)
+
+	for _, tx := range bundle.Txs {
+		if tx.Protected() && !b.w.chainConfig.IsEIP155(env.header.Number) {
+			return nil, fmt.Errorf("invalid reply protected tx %s", tx.Hash())
+		}
+		env.state.SetTxContext(tx.Hash(), env.tcount)
+		if _, err := b.w.commitTransaction(env, tx); err != nil {
+			return nil, fmt.Errorf("tx %s failed: %w", tx.Hash(), err)
+		}
+		env.tcount++
+
+		receipt := env.receipts[len(env.receipts)-1]
+		if receipt.Status == types.ReceiptStatusFailed && !slices.Contains(bundle.RevertingHashes, tx.Hash()) {
+			return nil, fmt.Errorf("tx %s reverted", tx.Hash())
+		}
+		result.gasUsed += receipt.GasUsed
+	}
+
+	bundleProfit := new(big.Int).Sub(env.state.GetBalance(env.coinbase), profitPre)
+
+	refunds, err := bundleRefunds(env.signer, bundle)
+	if err != nil {
+		return nil, err
+	}
+	for _, refund := range refunds {
+		// Note: PoC logic, this could be gamed by not sending any eth to coinbase
+		amount := new(big.Int).Mul(bundleProfit, big.NewInt(int64(refund.Percent)))
+		amount.Div(amount, big.NewInt(100))
+
+		if _, err := b.pay(env, refund.Address, amount); err != nil {
+			if errors.Is(err, errNoProfit) {
+				log.Debug("Skipping refund below the transfer cost", "recipient", refund.Address, "amount", amount)
+				continue
+			}
+			return nil, fmt.Errorf("could not refund %s: %w", refund.Address, err)
+		}
+	}
+
+	result.profit = new(big.Int).Sub(env.state.GetBalance(env.coinbase), profitPre)
+	return result, nil
+}
+
+// bundleRefunds returns the refund recipients of the bundle. Without an explicit
+// refund configuration, a bundle with more than one transaction and a refund
+// percent refunds the sender of its first transaction. An unset refund percent
+// refunds nothing, an explicit one is paid as is, even if it is 0.
+func bundleRefunds(signer types.Signer, bundle *types.SBundle) ([]types.RefundConfig, error) {
+	refunds := bundle.RefundConfig
+	if len(refunds) == 0 {
+		if len(bundle.Txs) < 2 || bundle.RefundPercent == nil {
+			return nil, nil
+		}
+		percent := *bundle.RefundPercent
+		refundAddr, err := types.Sender(signer, bundle.Txs[0])
+		if err != nil {
+			return nil, err
+		}
+		refunds = []types.RefundConfig{{Address: refundAddr, Percent: percent}}
+	}
+
+	total := 0
+	for _, refund := range refunds {
+		if refund.Percent < 0 {
+			return nil, fmt.Errorf("negative refund percent for %s", refund.Address)
+		}
+		total += refund.Percent
+	}
+	if total > 100 {
+		return nil, fmt.Errorf("refund percents add up to %d", total)
+	}
+	return refunds, nil
+}
+
+// pay transfers the amount minus the cost of the transfer from the coinbase to
+// the recipient and returns the transferred value. It fails with errNoProfit
+// if the amount does not cover the cost of the transfer.
+func (b *bundleBuilder) pay(env *environment, to common.Address, amount *big.Int) (*big.Int, error) {
+	gas, err := b.transferGas(env, to, amount)
+	if err != nil {
+		return nil, err
+	}
+	value := new(big.Int).Sub(amount, new(big.Int).Mul(new(big.Int).SetUint64(gas), env.header.BaseFee))
+	if value.Sign() <= 0 {
+		return nil, errNoProfit
+	}
+
+	tx, err := b.paymentTx(env, to, value, gas)
+	if err != nil {
+		return nil, err
+	}
+	env.state.SetTxContext(tx.Hash(), env.tcount)
+	if _, err := b.w.commitTransaction(env, tx); err != nil {
+		return nil, err
+	}
+	env.tcount++
+	if receipt := env.receipts[len(env.receipts)-1]; receipt.Status == types.ReceiptStatusFailed {
+		return nil, fmt.Errorf("payment to %s reverted", to)
+	}
+	return value, nil
+}
+
+// transferGas returns the gas used by a transfer from the coinbase to the
+// recipient. Transfers to contracts are executed and reverted afterwards, with
+// the coinbase funded for the gas of the trial transfer.
+func (b *bundleBuilder) transferGas(env *environment, to common.Address, amount *big.Int) (uint64, error) {
+	if env.state.GetCodeSize(to) == 0 {
+		return params.TxGas, nil
+	}
+	gas := uint64(maxPaymentGas)
+	if left := env.gasPool.Gas(); left < gas {
+		gas = left
+	}
+
+	snap := env.snapshot()
+	env.state.AddBalance(env.coinbase, new(big.Int).Mul(new(big.Int).SetUint64(gas), env.header.BaseFee))
+	used, err := b.applyTransfer(env, to, amount, gas)
+	if err := env.revert(snap); err != nil {
+		return 0, err
+	}
+	return used, err
+}
+
+// applyTransfer applies a transfer from the coinbase to the recipient with the
+// given gas limit, and returns the gas it used.
+func (b *bundleBuilder) applyTransfer(env *environment, to common.Address, amount *big.Int, gas uint64) (uint64, error) {
+	tx, err := b.paymentTx(env, to, amount, gas)
+	if err != nil {
+		return 0, err
+	}
+	env.state.SetTxContext(tx.Hash(), env.tcount)
+	if _, err := b.w.commitTransaction(env, tx); err != nil {
+		return 0, err
+	}
+	receipt := env.receipts[len(env.receipts)-1]
+	if receipt.Status == types.ReceiptStatusFailed {
+		return 0, fmt.Errorf("payment to %s reverted", to)
+	}
+	return receipt.GasUsed, nil
+}
+
+func (b *bundleBuilder) paymentTx(env *environment, to common.Address, value *big.Int, gas uint64) (*types.Transaction, error) {
+	return types.SignTx(types.NewTx(&types.LegacyTx{
+		Nonce:    env.state.GetNonce(env.coinbase),
+		To:       &to,
+		Value:    value,
+		Gas:      gas,
+		GasPrice: env.header.BaseFee,
+	}), env.signer, b.key)
+}
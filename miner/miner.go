@@ -261,3 +261,7 @@ func (miner *Miner) BuildBlockFromTxs(ctx context.Context, buildArgs *types.Buil
 func (miner *Miner) BuildBlockFromBundles(ctx context.Context, buildArgs *types.BuildBlockArgs, bundles []types.SBundle) (*types.Block, *big.Int, error) {
 	return miner.worker.buildBlockFromBundles(ctx, buildArgs, bundles)
 }
+
+func (miner *Miner) SimulateBundle(ctx context.Context, buildArgs *types.BuildBlockArgs, bundle *types.SBundle) (*types.SimulateBundleResult, error) {
+	return miner.worker.simulateBundle(ctx, buildArgs, bundle)
+}
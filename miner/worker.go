@@ -37,6 +37,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/trie"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -83,6 +84,7 @@ var (
 	errBlockInterruptedByNewHead  = errors.New("new head arrived while building block")
 	errBlockInterruptedByRecommit = errors.New("recommit interrupt while building block")
 	errBlockInterruptedByTimeout  = errors.New("timeout while building block")
+	errBlobGasLimitReached        = errors.New("blob gas limit reached")
 )
 
 // environment is the worker's current environment and holds all
@@ -97,6 +99,8 @@ type environment struct {
 	gasPool   *core.GasPool           // available gas used to pack transactions
 	coinbase  common.Address
 
+	blobGasUsed uint64 // data gas used by the blobs of the blob transactions
+
 	header   *types.Header
 	txs      []*types.Transaction
 	receipts []*types.Receipt
@@ -114,6 +118,8 @@ func (env *environment) copy() *environment {
 		coinbase:  env.coinbase,
 		header:    types.CopyHeader(env.header),
 		receipts:  copyReceipts(env.receipts),
+
+		blobGasUsed: env.blobGasUsed,
 	}
 	if env.gasPool != nil {
 		gasPool := *env.gasPool
@@ -130,6 +136,48 @@ func (env *environment) copy() *environment {
 	return cpy
 }
 
+// envSnapshot is a point of the block to which the environment can be reverted.
+type envSnapshot struct {
+	tcount      int
+	txs         int
+	gas         uint64
+	gasUsed     uint64
+	blobGasUsed uint64
+}
+
+// snapshot takes a snapshot of the environment in between transactions, which
+// must be either reverted or committed.
+func (env *environment) snapshot() *envSnapshot {
+	env.state.NewMultiTxSnapshot()
+	return &envSnapshot{
+		tcount:      env.tcount,
+		txs:         len(env.txs),
+		gas:         env.gasPool.Gas(),
+		gasUsed:     env.header.GasUsed,
+		blobGasUsed: env.blobGasUsed,
+	}
+}
+
+// revert reverts the environment to the snapshot, leaving out the transactions
+// committed since it was taken.
+func (env *environment) revert(snap *envSnapshot) error {
+	if err := env.state.MultiTxSnapshotRevert(); err != nil {
+		return err
+	}
+	env.tcount = snap.tcount
+	env.txs = env.txs[:snap.txs]
+	env.receipts = env.receipts[:snap.txs]
+	env.gasPool.SetGas(snap.gas)
+	env.header.GasUsed = snap.gasUsed
+	env.blobGasUsed = snap.blobGasUsed
+	return nil
+}
+
+// commitSnapshot keeps the changes made since the last snapshot.
+func (env *environment) commitSnapshot() error {
+	return env.state.MultiTxSnapshotCommit()
+}
+
 // unclelist returns the contained uncles as the list format.
 func (env *environment) unclelist() []*types.Header {
 	var uncles []*types.Header
@@ -863,6 +911,59 @@ func (w *worker) updateSnapshot(env *environment) {
 }
 
 func (w *worker) commitTransaction(env *environment, tx *types.Transaction) ([]*types.Log, error) {
+	if tx.Type() == types.BlobTxType {
+		return w.commitBlobTransaction(env, tx)
+	}
+	receipt, err := w.applyTransaction(env, tx)
+	if err != nil {
+		return nil, err
+	}
+	env.txs = append(env.txs, tx)
+	env.receipts = append(env.receipts, receipt)
+
+	return receipt.Logs, nil
+}
+
+// commitBlobTransaction commits a blob transaction carrying its blobs. The
+// blobs are left out of the block and count against the blob gas limit.
+func (w *worker) commitBlobTransaction(env *environment, tx *types.Transaction) ([]*types.Log, error) {
+	sidecar := tx.BlobTxSidecar()
+	if sidecar == nil {
+		return nil, types.ErrMissingBlobTxSidecar
+	}
+	if env.blobGasUsed+tx.BlobGas() > params.MaxBlobGasPerBlock {
+		return nil, errBlobGasLimitReached
+	}
+	if err := sidecar.Verify(tx.BlobHashes()); err != nil {
+		return nil, err
+	}
+	receipt, err := w.applyTransaction(env, tx)
+	if err != nil {
+		return nil, err
+	}
+	env.txs = append(env.txs, tx.WithoutBlobTxSidecar())
+	env.receipts = append(env.receipts, receipt)
+	env.blobGasUsed += tx.BlobGas()
+
+	return receipt.Logs, nil
+}
+
+// updateExcessDataGas sets the excess data gas of the sealing block from the
+// data gas used by its blob transactions, if EIP-4844 is enabled.
+func (w *worker) updateExcessDataGas(env *environment) {
+	if !w.chainConfig.IsCancun(env.header.Number, env.header.Time) {
+		return
+	}
+	var parentExcessDataGas *big.Int
+	if parent := w.chain.GetHeaderByHash(env.header.ParentHash); parent != nil {
+		parentExcessDataGas = parent.ExcessDataGas
+	}
+	env.header.ExcessDataGas = misc.CalcExcessDataGas(parentExcessDataGas, env.blobGasUsed)
+}
+
+// applyTransaction applies the transaction on top of the environment, which is
+// left untouched if the transaction cannot be applied.
+func (w *worker) applyTransaction(env *environment, tx *types.Transaction) (*types.Receipt, error) {
 	var (
 		snap = env.state.Snapshot()
 		gp   = env.gasPool.Gas()
@@ -873,10 +974,7 @@ func (w *worker) commitTransaction(env *environment, tx *types.Transaction) ([]*
 		env.gasPool.SetGas(gp)
 		return nil, err
 	}
-	env.txs = append(env.txs, tx)
-	env.receipts = append(env.receipts, receipt)
-
-	return receipt.Logs, nil
+	return receipt, nil
 }
 
 func (w *worker) commitTransactions(env *environment, txs *types.TransactionsByPriceAndNonce, interrupt *atomic.Int32) error {
@@ -1104,6 +1202,7 @@ func (w *worker) generateWork(params *generateParams) (*types.Block, *big.Int, e
 			log.Warn("Block building is interrupted", "allowance", common.PrettyDuration(w.newpayloadTimeout))
 		}
 	}
+	w.updateExcessDataGas(work)
 	block, err := w.engine.FinalizeAndAssemble(w.chain, work.header, work.state, work.txs, work.unclelist(), work.receipts, params.withdrawals)
 	if err != nil {
 		return nil, nil, err
@@ -1190,6 +1289,7 @@ func (w *worker) commit(env *environment, interval func(), update bool, start ti
 		// https://github.com/ethereum/go-ethereum/issues/24299
 		env := env.copy()
 		// Withdrawals are set to nil here, because this is only called in PoW.
+		w.updateExcessDataGas(env)
 		block, err := w.engine.FinalizeAndAssemble(w.chain, env.header, env.state, env.txs, env.unclelist(), env.receipts, nil)
 		if err != nil {
 			return err
@@ -1273,12 +1373,13 @@ func (w *worker) buildBlockFromTxs(ctx context.Context, args *types.BuildBlockAr
 		return nil, nil, err
 	}
 	if args.FillPending {
-		if err := w.commitPendingTxs(work); err != nil {
+		if err := w.commitPendingTxs(ctx, work, time.Duration(args.FillPendingTimeout)*time.Millisecond); err != nil {
 			return nil, nil, err
 		}
 	}
 
 	profitPost := work.state.GetBalance(args.FeeRecipient)
+	w.updateExcessDataGas(work)
 	block, err := w.engine.FinalizeAndAssemble(w.chain, work.header, work.state, work.txs, work.unclelist(), work.receipts, params.withdrawals)
 	if err != nil {
 		return nil, nil, err
@@ -1308,95 +1409,47 @@ func (w *worker) buildBlockFromBundles(ctx context.Context, args *types.BuildBlo
 		noTxs:       false,
 	}
 
-	work, err := w.prepareWork(params)
+	algorithm, err := newBuildAlgorithm(args.Algorithm)
 	if err != nil {
 		return nil, nil, err
 	}
-	defer work.discard()
 
-	// Assume static 28000 gas transfers for both mev-share and proposer payments
-	refundTransferCost := new(big.Int).Mul(big.NewInt(28000), work.header.BaseFee)
-
-	profitPre := work.state.GetBalance(params.coinbase)
-
-	for _, bundle := range bundles {
-		// NOTE: failing bundles will cause the block to not be built!
+	work, err := w.prepareWork(params)
+	if err != nil {
+		return nil, nil, err
+	}
+	if work.gasPool == nil {
+		work.gasPool = new(core.GasPool).AddGas(work.header.GasLimit)
+	}
 
-		// apply bundle
-		profitPreBundle := work.state.GetBalance(params.coinbase)
-		if err := w.rawCommitTransactions(work, bundle.Txs); err != nil {
-			return nil, nil, err
-		}
-		profitPostBundle := work.state.GetBalance(params.coinbase)
-
-		// calc & refund user if bundle has multiple txns and wants refund
-		if len(bundle.Txs) > 1 && bundle.RefundPercent != nil {
-			// Note: PoC logic, this could be gamed by not sending any eth to coinbase
-			refundPrct := *bundle.RefundPercent
-			if refundPrct == 0 {
-				// default refund
-				refundPrct = 10
-			}
-			bundleProfit := new(big.Int).Sub(profitPostBundle, profitPreBundle)
-			refundAmt := new(big.Int).Div(bundleProfit, big.NewInt(int64(refundPrct)))
-			// subtract payment txn transfer costs
-			refundAmt = new(big.Int).Sub(refundAmt, refundTransferCost)
-
-			currNonce := work.state.GetNonce(ephemeralAddr)
-			// HACK to include payment txn
-			// multi refund block untested
-			userTx := bundle.Txs[0] // NOTE : assumes first txn is refund recipient
-			refundAddr, err := types.Sender(types.LatestSignerForChainID(userTx.ChainId()), userTx)
-			if err != nil {
-				return nil, nil, err
-			}
-			paymentTx, err := types.SignTx(types.NewTx(&types.LegacyTx{
-				Nonce:    currNonce,
-				To:       &refundAddr,
-				Value:    refundAmt,
-				Gas:      28000,
-				GasPrice: work.header.BaseFee,
-			}), work.signer, ephemeralPrivKey)
+	b := &bundleBuilder{w: w, env: work, key: ephemeralPrivKey}
+	defer func() { b.env.discard() }()
 
-			if err != nil {
-				return nil, nil, err
-			}
+	profitPre := b.env.state.GetBalance(params.coinbase)
 
-			// commit payment txn
-			if err := w.rawCommitTransactions(work, types.Transactions{paymentTx}); err != nil {
-				return nil, nil, err
-			}
-		}
+	if err := algorithm.commitBundles(ctx, b, bundles); err != nil {
+		return nil, nil, err
 	}
+	work = b.env
+
 	if args.FillPending {
-		if err := w.commitPendingTxs(work); err != nil {
+		if err := w.commitPendingTxs(ctx, work, time.Duration(args.FillPendingTimeout)*time.Millisecond); err != nil {
 			return nil, nil, err
 		}
 	}
 
-	profitPost := work.state.GetBalance(params.coinbase)
-	proposerProfit := new(big.Int).Set(profitPost) // = post-pre-transfer_cost
-	proposerProfit = proposerProfit.Sub(profitPost, profitPre)
-	proposerProfit = proposerProfit.Sub(proposerProfit, refundTransferCost)
-
-	currNonce := work.state.GetNonce(ephemeralAddr)
-	paymentTx, err := types.SignTx(types.NewTx(&types.LegacyTx{
-		Nonce:    currNonce,
-		To:       &args.FeeRecipient,
-		Value:    proposerProfit,
-		Gas:      28000,
-		GasPrice: work.header.BaseFee,
-	}), work.signer, ephemeralPrivKey)
-	if err != nil {
-		return nil, nil, fmt.Errorf("could not sign proposer payment: %w", err)
-	}
-
-	// commit payment txn
-	if err := w.rawCommitTransactions(work, types.Transactions{paymentTx}); err != nil {
-		return nil, nil, fmt.Errorf("could not sign proposer payment: %w", err)
+	// The proposer gets everything collected by the coinbase minus the cost of the payment
+	profit := new(big.Int).Sub(work.state.GetBalance(params.coinbase), profitPre)
+	proposerProfit, err := b.pay(work, args.FeeRecipient, profit)
+	if errors.Is(err, errNoProfit) {
+		log.Debug("Skipping the proposer payment below the transfer cost", "profit", profit)
+		proposerProfit = new(big.Int)
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("could not pay the proposer: %w", err)
 	}
 
 	log.Info("buildBlockFromBundles", "num_bundles", len(bundles), "num_txns", len(work.txs), "profit", proposerProfit)
+	w.updateExcessDataGas(work)
 	block, err := w.engine.FinalizeAndAssemble(w.chain, work.header, work.state, work.txs, work.unclelist(), work.receipts, params.withdrawals)
 	if err != nil {
 		return nil, nil, err
@@ -1404,16 +1457,158 @@ func (w *worker) buildBlockFromBundles(ctx context.Context, args *types.BuildBlo
 	return block, proposerProfit, nil
 }
 
-func (w *worker) commitPendingTxs(work *environment) error {
+// simulateBundle applies the bundle on top of a block built with the given arguments
+// and reports the profit of the fee recipient. The block is discarded afterwards.
+func (w *worker) simulateBundle(ctx context.Context, args *types.BuildBlockArgs, bundle *types.SBundle) (*types.SimulateBundleResult, error) {
+	params := &generateParams{
+		timestamp:   args.Timestamp,
+		forceTime:   true,
+		parentHash:  args.Parent,
+		coinbase:    args.FeeRecipient,
+		gasLimit:    args.GasLimit,
+		random:      args.Random,
+		extra:       args.Extra,
+		withdrawals: args.Withdrawals,
+		noUncle:     true,
+		noTxs:       false,
+	}
+
+	work, err := w.prepareWork(params)
+	if err != nil {
+		return nil, err
+	}
+	defer work.discard()
+
+	if work.gasPool == nil {
+		work.gasPool = new(core.GasPool).AddGas(work.header.GasLimit)
+	}
+
+	result := &types.SimulateBundleResult{
+		CoinbaseProfit: big.NewInt(0),
+		Logs:           []*types.SimulatedLog{},
+	}
+	if err := checkBundleBlockRange(bundle, work.header.Number); err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	profitPre := work.state.GetBalance(args.FeeRecipient)
+
+	for _, tx := range bundle.Txs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		work.state.SetTxContext(tx.Hash(), work.tcount)
+		logs, err := w.commitTransaction(work, tx)
+		if err != nil {
+			result.Error = fmt.Sprintf("tx %s failed: %v", tx.Hash(), err)
+			return result, nil
+		}
+		work.tcount++
+
+		receipt := work.receipts[len(work.receipts)-1]
+		if receipt.Status == types.ReceiptStatusFailed && !slices.Contains(bundle.RevertingHashes, tx.Hash()) {
+			result.Error = fmt.Sprintf("tx %s reverted", tx.Hash())
+			return result, nil
+		}
+
+		result.GasUsed += receipt.GasUsed
+		for _, log := range logs {
+			result.Logs = append(result.Logs, &types.SimulatedLog{
+				Addr:   log.Address,
+				Topics: log.Topics,
+				Data:   log.Data,
+			})
+		}
+	}
+
+	// The base fee is burned, the balance of the fee recipient only
+	// increases with the priority fees and the direct payments.
+	profitPost := work.state.GetBalance(args.FeeRecipient)
+	result.CoinbaseProfit = new(big.Int).Sub(profitPost, profitPre)
+	if result.GasUsed != 0 {
+		result.EffectiveGasPrice = new(big.Int).Div(result.CoinbaseProfit, new(big.Int).SetUint64(result.GasUsed)).Uint64()
+	}
+	result.Success = true
+
+	return result, nil
+}
+
+// checkBundleBlockRange checks that the bundle can be included in the given block.
+// A bundle with a block number and without a max block targets that block only.
+func checkBundleBlockRange(bundle *types.SBundle, number *big.Int) error {
+	if bundle.BlockNumber != nil && number.Cmp(bundle.BlockNumber) < 0 {
+		return fmt.Errorf("bundle not valid until block %d, current %d", bundle.BlockNumber, number)
+	}
+
+	maxBlock := bundle.MaxBlock
+	if maxBlock == nil {
+		maxBlock = bundle.BlockNumber
+	}
+	if maxBlock != nil && number.Cmp(maxBlock) > 0 {
+		return fmt.Errorf("bundle expired at block %d, current %d", maxBlock, number)
+	}
+	return nil
+}
+
+// commitPendingTxs fills the remaining gas of the block with the pending transactions
+// of the pool ordered by tip. The transactions whose sender nonce was already used
+// in the block are skipped. Filling stops once the timeout, capped by the payload
+// timeout of the node, expires or the context is done, and the block keeps the
+// transactions committed so far.
+func (w *worker) commitPendingTxs(ctx context.Context, work *environment, timeout time.Duration) error {
+	if timeout == 0 || timeout > w.newpayloadTimeout {
+		timeout = w.newpayloadTimeout
+	}
 	interrupt := new(atomic.Int32)
-	timer := time.AfterFunc(w.newpayloadTimeout, func() {
+	timer := time.AfterFunc(timeout, func() {
 		interrupt.Store(commitInterruptTimeout)
 	})
 	defer timer.Stop()
-	if err := w.fillTransactions(nil, work); err != nil {
-		return err
+
+	if ctx.Err() != nil {
+		interrupt.Store(commitInterruptTimeout)
 	}
-	return nil
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			interrupt.Store(commitInterruptTimeout)
+		case <-done:
+		}
+	}()
+
+	pending := pendingWithoutConflicts(work, w.eth.TxPool().Pending(true))
+	if len(pending) == 0 {
+		return nil
+	}
+	txs := types.NewTransactionsByPriceAndNonce(work.signer, pending, work.header.BaseFee)
+	err := w.commitTransactions(work, txs, interrupt)
+	if errors.Is(err, errBlockInterruptedByTimeout) {
+		log.Debug("Filling block with pending transactions interrupted", "allowance", common.PrettyDuration(timeout), "txs", len(work.txs), "ctxErr", ctx.Err())
+		return nil
+	}
+	return err
+}
+
+// pendingWithoutConflicts drops the pending transactions whose nonce was used by
+// the transactions already in the block, and the accounts whose pending nonces
+// are not contiguous with their nonce in the block.
+func pendingWithoutConflicts(env *environment, pending map[common.Address]types.Transactions) map[common.Address]types.Transactions {
+	filtered := make(map[common.Address]types.Transactions, len(pending))
+	for addr, txs := range pending {
+		nonce := env.state.GetNonce(addr)
+		for len(txs) > 0 && txs[0].Nonce() < nonce {
+			txs = txs[1:]
+		}
+		if len(txs) == 0 || txs[0].Nonce() != nonce {
+			continue
+		}
+		filtered[addr] = txs
+	}
+	return filtered
 }
 
 func (w *worker) rawCommitTransactions(env *environment, txs types.Transactions) error {
@@ -17,14 +17,17 @@
 package miner
 
 import (
+	"context"
 	"crypto/rand"
 	"errors"
 	"math/big"
+	"reflect"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/beacon/dencun"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/clique"
@@ -36,9 +39,11 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 )
 
 const (
@@ -660,3 +665,495 @@ func testGetSealingWork(t *testing.T, chainConfig *params.ChainConfig, engine co
 		}
 	}
 }
+
+func TestSimulateBundle(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	w, b := newTestWorker(t, ethashChainConfig, engine, rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	parent := b.chain.CurrentBlock()
+	args := &types.BuildBlockArgs{
+		Parent:       parent.Hash(),
+		Timestamp:    parent.Time + 12,
+		FeeRecipient: common.Address{0x1},
+	}
+
+	signer := types.LatestSigner(ethashChainConfig)
+	gasPrice := big.NewInt(2 * params.InitialBaseFee)
+
+	transfer := types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{
+		Nonce:    0,
+		To:       &testUserAddress,
+		Value:    big.NewInt(1000),
+		Gas:      params.TxGas,
+		GasPrice: gasPrice,
+	})
+	// contract creation which reverts (REVERT(0, 0))
+	revert := types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{
+		Nonce:    1,
+		Gas:      100000,
+		GasPrice: gasPrice,
+		Data:     common.FromHex("0x60006000fd"),
+	})
+
+	cases := []struct {
+		name    string
+		bundle  *types.SBundle
+		success bool
+	}{
+		{"Transfer", &types.SBundle{Txs: types.Transactions{transfer}}, true},
+		{"InRange", &types.SBundle{Txs: types.Transactions{transfer}, BlockNumber: big.NewInt(1), MaxBlock: big.NewInt(2)}, true},
+		{"NotValidYet", &types.SBundle{Txs: types.Transactions{transfer}, BlockNumber: big.NewInt(2)}, false},
+		{"Expired", &types.SBundle{Txs: types.Transactions{transfer}, BlockNumber: big.NewInt(0)}, false},
+		{"Reverts", &types.SBundle{Txs: types.Transactions{transfer, revert}}, false},
+		{"RevertAllowed", &types.SBundle{Txs: types.Transactions{transfer, revert}, RevertingHashes: []common.Hash{revert.Hash()}}, true},
+	}
+
+	for _, c := range cases {
+		res, err := w.simulateBundle(context.Background(), args, c.bundle)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if res.Success != c.success {
+			t.Fatalf("%s: expected success %v, got %v (%s)", c.name, c.success, res.Success, res.Error)
+		}
+		if !res.Success {
+			continue
+		}
+		if res.CoinbaseProfit.Sign() <= 0 {
+			t.Fatalf("%s: expected a positive profit, got %d", c.name, res.CoinbaseProfit)
+		}
+		// the base fee is burned so the profit per gas is below the gas price
+		if res.EffectiveGasPrice >= gasPrice.Uint64() {
+			t.Fatalf("%s: expected effective gas price below %d, got %d", c.name, gasPrice, res.EffectiveGasPrice)
+		}
+		if expected := new(big.Int).Div(res.CoinbaseProfit, new(big.Int).SetUint64(res.GasUsed)).Uint64(); res.EffectiveGasPrice != expected {
+			t.Fatalf("%s: expected effective gas price %d, got %d", c.name, expected, res.EffectiveGasPrice)
+		}
+	}
+}
+
+func TestBuildBlockFromBundlesAlgorithms(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	w, b := newTestWorker(t, ethashChainConfig, engine, rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	signer := types.LatestSigner(ethashChainConfig)
+	newTransfer := func(nonce uint64, gasPrice int64) *types.Transaction {
+		return types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{
+			Nonce:    nonce,
+			To:       &testUserAddress,
+			Value:    big.NewInt(1000),
+			Gas:      params.TxGas,
+			GasPrice: big.NewInt(gasPrice * params.InitialBaseFee),
+		})
+	}
+	// contract creation which reverts (REVERT(0, 0))
+	revert := types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{
+		Nonce:    0,
+		Gas:      100000,
+		GasPrice: big.NewInt(2 * params.InitialBaseFee),
+		Data:     common.FromHex("0x60006000fd"),
+	})
+	low, high := newTransfer(0, 2), newTransfer(0, 4)
+
+	cases := []struct {
+		algorithm string
+		bundles   []types.SBundle
+		expected  []common.Hash // bundle txs in the block, nil if the build fails
+	}{
+		{AlgorithmDefault, []types.SBundle{{Txs: types.Transactions{revert}}, {Txs: types.Transactions{low}}}, nil},
+		{AlgorithmDiscardFailing, []types.SBundle{{Txs: types.Transactions{revert}}, {Txs: types.Transactions{low}}}, []common.Hash{low.Hash()}},
+		{AlgorithmDefault, []types.SBundle{{Txs: types.Transactions{low}}, {Txs: types.Transactions{high}}}, nil},
+		{AlgorithmGreedy, []types.SBundle{{Txs: types.Transactions{low}}, {Txs: types.Transactions{high}}}, []common.Hash{high.Hash()}},
+		{AlgorithmGreedyMerge, []types.SBundle{{Txs: types.Transactions{low}}, {Txs: types.Transactions{low, newTransfer(1, 4)}}}, []common.Hash{low.Hash(), newTransfer(1, 4).Hash()}},
+		{"unknown", []types.SBundle{{Txs: types.Transactions{low}}}, nil},
+	}
+
+	for _, c := range cases {
+		parent := b.chain.CurrentBlock()
+		args := &types.BuildBlockArgs{
+			Parent:       parent.Hash(),
+			Timestamp:    parent.Time + 12,
+			FeeRecipient: common.Address{0x1},
+			Algorithm:    c.algorithm,
+		}
+		block, profit, err := w.buildBlockFromBundles(context.Background(), args, c.bundles)
+		if c.expected == nil {
+			if err == nil {
+				t.Fatalf("%s: expected the build to fail", c.algorithm)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.algorithm, err)
+		}
+		if profit.Sign() <= 0 {
+			t.Fatalf("%s: expected a positive profit, got %d", c.algorithm, profit)
+		}
+		// the block ends with the proposer payment
+		txs := block.Transactions()
+		if len(txs) != len(c.expected)+1 {
+			t.Fatalf("%s: expected %d txs, got %d", c.algorithm, len(c.expected)+1, len(txs))
+		}
+		for i, hash := range c.expected {
+			if txs[i].Hash() != hash {
+				t.Fatalf("%s: unexpected tx %d: %s", c.algorithm, i, txs[i].Hash())
+			}
+		}
+		payment := txs[len(txs)-1]
+		if *payment.To() != args.FeeRecipient || payment.Value().Cmp(profit) != 0 || payment.Gas() != params.TxGas {
+			t.Fatalf("%s: unexpected proposer payment to %s of %d with %d gas", c.algorithm, payment.To(), payment.Value(), payment.Gas())
+		}
+	}
+}
+
+func TestBuildBlockFromBundlesNoProfit(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	w, b := newTestWorker(t, ethashChainConfig, engine, rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	parent := b.chain.CurrentBlock()
+	args := &types.BuildBlockArgs{
+		Parent:       parent.Hash(),
+		Timestamp:    parent.Time + 12,
+		FeeRecipient: common.Address{0x1},
+	}
+
+	// a block which does not cover the cost of the proposer payment is built without it
+	block, profit, err := w.buildBlockFromBundles(context.Background(), args, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(block.Transactions()) != 0 {
+		t.Fatalf("expected no txs, got %d", len(block.Transactions()))
+	}
+	if profit.Sign() != 0 {
+		t.Fatalf("expected no profit, got %d", profit)
+	}
+}
+
+func TestBuildBlockFromBundlesRefunds(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	w, b := newTestWorker(t, ethashChainConfig, engine, rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	parent := b.chain.CurrentBlock()
+	args := &types.BuildBlockArgs{
+		Parent:       parent.Hash(),
+		Timestamp:    parent.Time + 12,
+		FeeRecipient: common.Address{0x1},
+	}
+
+	signer := types.LatestSigner(ethashChainConfig)
+	gasPrice := big.NewInt(10 * params.InitialBaseFee)
+	tx := types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{
+		Nonce:    0,
+		To:       &testUserAddress,
+		Value:    big.NewInt(1000),
+		Gas:      params.TxGas,
+		GasPrice: gasPrice,
+	})
+	bundle := types.SBundle{
+		Txs: types.Transactions{tx},
+		RefundConfig: []types.RefundConfig{
+			{Address: common.Address{0x2}, Percent: 50},
+			{Address: common.Address{0x3}, Percent: 30},
+		},
+	}
+
+	block, profit, err := w.buildBlockFromBundles(context.Background(), args, []types.SBundle{bundle})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	txs := block.Transactions()
+	if len(txs) != 4 {
+		t.Fatalf("expected 4 txs, got %d", len(txs))
+	}
+
+	// the bundle pays the priority fee, every payment pays the exact transfer cost
+	baseFee := block.BaseFee()
+	bundleProfit := new(big.Int).Mul(new(big.Int).Sub(gasPrice, baseFee), big.NewInt(int64(params.TxGas)))
+	transferCost := new(big.Int).Mul(baseFee, big.NewInt(int64(params.TxGas)))
+
+	remaining := new(big.Int).Set(bundleProfit)
+	for i, refund := range bundle.RefundConfig {
+		share := new(big.Int).Div(new(big.Int).Mul(bundleProfit, big.NewInt(int64(refund.Percent))), big.NewInt(100))
+		expected := new(big.Int).Sub(share, transferCost)
+
+		payment := txs[i+1]
+		if *payment.To() != refund.Address || payment.Value().Cmp(expected) != 0 {
+			t.Fatalf("refund %d: expected %d to %s, got %d to %s", i, expected, refund.Address, payment.Value(), payment.To())
+		}
+		remaining.Sub(remaining, share)
+	}
+	if expected := new(big.Int).Sub(remaining, transferCost); profit.Cmp(expected) != 0 {
+		t.Fatalf("expected proposer profit %d, got %d", expected, profit)
+	}
+}
+
+func TestBuildBlockFromBundlesContractRecipients(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	w, b := newTestWorker(t, ethashChainConfig, engine, rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	// the first bundle deploys a contract logging the transfers it receives,
+	// the second one refunds it and the proposer is paid to it as well
+	contract := crypto.CreateAddress(testBankAddress, 0)
+	parent := b.chain.CurrentBlock()
+	args := &types.BuildBlockArgs{
+		Parent:       parent.Hash(),
+		Timestamp:    parent.Time + 12,
+		FeeRecipient: contract,
+	}
+
+	signer := types.LatestSigner(ethashChainConfig)
+	gasPrice := big.NewInt(10 * params.InitialBaseFee)
+	deploy := types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{
+		Nonce:    0,
+		Gas:      100_000,
+		GasPrice: gasPrice,
+		Data:     common.FromHex("0x6560006000a0006000526006601af3"),
+	})
+	tx := types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{
+		Nonce:    1,
+		To:       &testUserAddress,
+		Value:    big.NewInt(1000),
+		Gas:      params.TxGas,
+		GasPrice: gasPrice,
+	})
+	bundles := []types.SBundle{
+		{Txs: types.Transactions{deploy}},
+		{Txs: types.Transactions{tx}, RefundConfig: []types.RefundConfig{{Address: contract, Percent: 50}}},
+	}
+
+	block, profit, err := w.buildBlockFromBundles(context.Background(), args, bundles)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if profit.Sign() <= 0 {
+		t.Fatalf("expected a proposer profit, got %d", profit)
+	}
+	txs := block.Transactions()
+	if len(txs) != 4 {
+		t.Fatalf("expected 4 txs, got %d", len(txs))
+	}
+
+	// the refund and the proposer payment pay for the logging of the contract
+	for i, payment := range txs[2:] {
+		if *payment.To() != contract || payment.Gas() <= params.TxGas {
+			t.Fatalf("payment %d: unexpected payment to %s with %d gas", i, payment.To(), payment.Gas())
+		}
+	}
+}
+
+func TestBundleRefunds(t *testing.T) {
+	signer := types.LatestSigner(ethashChainConfig)
+	newTx := func(nonce uint64) *types.Transaction {
+		return types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{
+			Nonce:    nonce,
+			To:       &testUserAddress,
+			Gas:      params.TxGas,
+			GasPrice: big.NewInt(params.InitialBaseFee),
+		})
+	}
+	txs := types.Transactions{newTx(0), newTx(1)}
+	percent := func(p int) *int { return &p }
+
+	// an unset refund percent refunds nothing
+	refunds, err := bundleRefunds(signer, &types.SBundle{Txs: txs})
+	if err != nil || refunds != nil {
+		t.Fatalf("unset refund: expected no refunds, got %v (err %v)", refunds, err)
+	}
+
+	// an explicit refund percent is paid as is, even if it is 0
+	for _, p := range []int{0, 25} {
+		refunds, err = bundleRefunds(signer, &types.SBundle{Txs: txs, RefundPercent: percent(p)})
+		if err != nil {
+			t.Fatalf("refund %d: unexpected error: %v", p, err)
+		}
+		if expected := []types.RefundConfig{{Address: testBankAddress, Percent: p}}; !reflect.DeepEqual(refunds, expected) {
+			t.Fatalf("refund %d: expected %v, got %v", p, expected, refunds)
+		}
+	}
+
+	// the refund config refunds single transaction bundles
+	config := []types.RefundConfig{{Address: common.Address{0x2}, Percent: 10}}
+	refunds, err = bundleRefunds(signer, &types.SBundle{Txs: txs[:1], RefundConfig: config})
+	if err != nil || !reflect.DeepEqual(refunds, config) {
+		t.Fatalf("refund config: expected %v, got %v (err %v)", config, refunds, err)
+	}
+}
+
+func TestBuildBlockFillPending(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	// the pool holds the bank transactions with nonce 0 and 1
+	w, b := newTestWorker(t, ethashChainConfig, engine, rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+	b.txPool.AddLocals(newTxs)
+
+	parent := b.chain.CurrentBlock()
+	args := &types.BuildBlockArgs{
+		Parent:       parent.Hash(),
+		Timestamp:    parent.Time + 12,
+		FeeRecipient: common.Address{0x1},
+		FillPending:  true,
+	}
+
+	signer := types.LatestSigner(ethashChainConfig)
+	// uses the nonce of the first pending transaction
+	bundleTx := types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{
+		Nonce:    0,
+		To:       &testUserAddress,
+		Value:    big.NewInt(1000),
+		Gas:      params.TxGas,
+		GasPrice: big.NewInt(2 * params.InitialBaseFee),
+	})
+
+	block, _, err := w.buildBlockFromTxs(context.Background(), args, types.Transactions{bundleTx})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if txs := block.Transactions(); len(txs) != 2 || txs[0].Hash() != bundleTx.Hash() || txs[1].Hash() != newTxs[0].Hash() {
+		t.Fatalf("unexpected block transactions %v", txs)
+	}
+
+	block, _, err = w.buildBlockFromBundles(context.Background(), args, []types.SBundle{{Txs: types.Transactions{bundleTx}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the block ends with the proposer payment
+	if txs := block.Transactions(); len(txs) != 3 || txs[0].Hash() != bundleTx.Hash() || txs[1].Hash() != newTxs[0].Hash() {
+		t.Fatalf("unexpected block transactions %v", txs)
+	}
+
+	// without a conflicting bundle the pending transactions are included in order
+	block, _, err = w.buildBlockFromTxs(context.Background(), args, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if txs := block.Transactions(); len(txs) != 2 || txs[0].Hash() != pendingTxs[0].Hash() || txs[1].Hash() != newTxs[0].Hash() {
+		t.Fatalf("unexpected block transactions %v", txs)
+	}
+
+	// an expired context interrupts the filling, the block keeps the transactions committed so far
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	block, _, err = w.buildBlockFromTxs(ctx, args, types.Transactions{bundleTx})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if txs := block.Transactions(); len(txs) != 1 || txs[0].Hash() != bundleTx.Hash() {
+		t.Fatalf("unexpected block transactions %v", txs)
+	}
+}
+
+func TestBuildBlockBlobTxs(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	chainConfig := new(params.ChainConfig)
+	*chainConfig = *ethashChainConfig
+	chainConfig.ShanghaiTime = new(uint64)
+	chainConfig.CancunTime = new(uint64)
+
+	w, b := newTestWorker(t, chainConfig, engine, rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	parent := b.chain.CurrentBlock()
+	args := &types.BuildBlockArgs{
+		Parent:       parent.Hash(),
+		Timestamp:    parent.Time + 12,
+		FeeRecipient: common.Address{0x1},
+	}
+
+	var blob kzg4844.Blob
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	if err != nil {
+		t.Fatalf("could not compute commitment: %v", err)
+	}
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	if err != nil {
+		t.Fatalf("could not compute proof: %v", err)
+	}
+	newSidecar := func(blobs int) *types.BlobTxSidecar {
+		sidecar := &types.BlobTxSidecar{}
+		for i := 0; i < blobs; i++ {
+			sidecar.Blobs = append(sidecar.Blobs, blob)
+			sidecar.Commitments = append(sidecar.Commitments, commitment)
+			sidecar.Proofs = append(sidecar.Proofs, proof)
+		}
+		return sidecar
+	}
+
+	signer := types.LatestSigner(chainConfig)
+	newBlobTx := func(sidecar *types.BlobTxSidecar) *types.Transaction {
+		tx := types.MustSignNewTx(testBankKey, signer, &types.BlobTx{
+			ChainID:    uint256.MustFromBig(chainConfig.ChainID),
+			Nonce:      0,
+			GasTipCap:  uint256.NewInt(params.InitialBaseFee),
+			GasFeeCap:  uint256.NewInt(2 * params.InitialBaseFee),
+			Gas:        params.TxGas,
+			To:         &testUserAddress,
+			Value:      uint256.NewInt(1000),
+			BlobFeeCap: uint256.NewInt(1),
+			BlobHashes: sidecar.BlobHashes(),
+		})
+		withSidecar, err := tx.WithBlobTxSidecar(sidecar)
+		if err != nil {
+			t.Fatalf("could not attach sidecar: %v", err)
+		}
+		return withSidecar
+	}
+
+	tx := newBlobTx(newSidecar(1))
+	block, _, err := w.buildBlockFromTxs(context.Background(), args, types.Transactions{tx})
+	if err != nil {
+		t.Fatalf("could not build block: %v", err)
+	}
+	if len(block.Transactions()) != 1 || block.Transactions()[0].Hash() != tx.Hash() {
+		t.Fatalf("expected the blob transaction in the block")
+	}
+	if block.Transactions()[0].BlobTxSidecar() != nil {
+		t.Fatalf("expected the blobs to be left out of the block")
+	}
+
+	// the blob gas is carried by the header and the payload of the block
+	maxBlobs := int(params.MaxBlobGasPerBlock / params.BlobTxDataGasPerBlob)
+	block, _, err = w.buildBlockFromTxs(context.Background(), args, types.Transactions{newBlobTx(newSidecar(maxBlobs))})
+	if err != nil {
+		t.Fatalf("could not build block: %v", err)
+	}
+	wantExcess := uint64(params.MaxBlobGasPerBlock - params.TargetBlobGasPerBlock)
+	if excess := block.Header().ExcessDataGas; excess == nil || excess.Uint64() != wantExcess {
+		t.Fatalf("expected excess data gas %d, got %v", wantExcess, excess)
+	}
+	payload := dencun.BlockToExecutableData(block, common.Big0, nil).ExecutionPayload
+	if payload.BlobGasUsed == nil || *payload.BlobGasUsed != params.MaxBlobGasPerBlock {
+		t.Fatalf("expected blob gas used %d, got %v", params.MaxBlobGasPerBlock, payload.BlobGasUsed)
+	}
+	if payload.ExcessBlobGas == nil || *payload.ExcessBlobGas != wantExcess {
+		t.Fatalf("expected excess blob gas %d, got %v", wantExcess, payload.ExcessBlobGas)
+	}
+
+	if _, _, err := w.buildBlockFromTxs(context.Background(), args, types.Transactions{tx.WithoutBlobTxSidecar()}); !errors.Is(err, types.ErrMissingBlobTxSidecar) {
+		t.Fatalf("expected %v, got %v", types.ErrMissingBlobTxSidecar, err)
+	}
+
+	blobs := int(params.MaxBlobGasPerBlock/params.BlobTxDataGasPerBlob) + 1
+	if _, _, err := w.buildBlockFromTxs(context.Background(), args, types.Transactions{newBlobTx(newSidecar(blobs))}); !errors.Is(err, errBlobGasLimitReached) {
+		t.Fatalf("expected %v, got %v", errBlobGasLimitReached, err)
+	}
+}
@@ -163,6 +163,9 @@ const (
 	BlobTxDataGasPerBlob             = 1 << 17 // Gas consumption of a single data blob (== blob byte size)
 	BlobTxMinDataGasprice            = 1       // Minimum gas price for data blobs
 	BlobTxDataGaspriceUpdateFraction = 2225652 // Controls the maximum rate of change for data gas price
+
+	MaxBlobGasPerBlock    = 6 * BlobTxDataGasPerBlob // Maximum data gas consumed by the blobs of a block
+	TargetBlobGasPerBlock = 3 * BlobTxDataGasPerBlob // Target data gas consumed by the blobs of a block
 )
 
 // Gas discount table for BLS12-381 G1 and G2 multi exponentiation operations
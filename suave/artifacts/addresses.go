@@ -1,5 +1,5 @@
 // Code generated by suave/gen. DO NOT EDIT.
-// Hash: 93d0334f831efa37bf623305bc1fadbb86200c71f9e3c4a97337f7879f4216c1
+// Hash: 5104f60b291f4b2d27870e420886b6c1f908af10b5d69aecafeceffcf050ee07
 package artifacts
 
 import (
@@ -8,59 +8,87 @@ import (
 
 // List of suave precompile addresses
 var (
-	aesDecryptAddr            = common.HexToAddress("0x000000000000000000000000000000005670000d")
-	aesEncryptAddr            = common.HexToAddress("0x000000000000000000000000000000005670000e")
-	buildEthBlockAddr         = common.HexToAddress("0x0000000000000000000000000000000042100001")
-	buildEthBlockToAddr       = common.HexToAddress("0x0000000000000000000000000000000042100006")
-	confidentialInputsAddr    = common.HexToAddress("0x0000000000000000000000000000000042010001")
-	confidentialRetrieveAddr  = common.HexToAddress("0x0000000000000000000000000000000042020001")
-	confidentialStoreAddr     = common.HexToAddress("0x0000000000000000000000000000000042020000")
-	contextGetAddr            = common.HexToAddress("0x0000000000000000000000000000000053300003")
-	doHTTPRequestAddr         = common.HexToAddress("0x0000000000000000000000000000000043200002")
-	doHTTPRequest2Addr        = common.HexToAddress("0x0000000000000000000000000000000043200003")
-	ethcallAddr               = common.HexToAddress("0x0000000000000000000000000000000042100003")
-	extractHintAddr           = common.HexToAddress("0x0000000000000000000000000000000042100037")
-	fetchDataRecordsAddr      = common.HexToAddress("0x0000000000000000000000000000000042030001")
-	fillMevShareBundleAddr    = common.HexToAddress("0x0000000000000000000000000000000043200001")
-	getInsecureTimeAddr       = common.HexToAddress("0x000000000000000000000000000000007770000c")
-	newBuilderAddr            = common.HexToAddress("0x0000000000000000000000000000000053200001")
-	newDataRecordAddr         = common.HexToAddress("0x0000000000000000000000000000000042030000")
-	privateKeyGenAddr         = common.HexToAddress("0x0000000000000000000000000000000053200003")
-	randomBytesAddr           = common.HexToAddress("0x000000000000000000000000000000007770000b")
-	signEthTransactionAddr    = common.HexToAddress("0x0000000000000000000000000000000040100001")
-	signMessageAddr           = common.HexToAddress("0x0000000000000000000000000000000040100003")
-	simulateBundleAddr        = common.HexToAddress("0x0000000000000000000000000000000042100000")
-	simulateTransactionAddr   = common.HexToAddress("0x0000000000000000000000000000000053200002")
-	submitBundleJsonRPCAddr   = common.HexToAddress("0x0000000000000000000000000000000043000001")
-	submitEthBlockToRelayAddr = common.HexToAddress("0x0000000000000000000000000000000042100002")
+	aesDecryptAddr                = common.HexToAddress("0x000000000000000000000000000000005670000d")
+	aesEncryptAddr                = common.HexToAddress("0x000000000000000000000000000000005670000e")
+	buildEthBlockAddr             = common.HexToAddress("0x0000000000000000000000000000000042100001")
+	buildEthBlockToAddr           = common.HexToAddress("0x0000000000000000000000000000000042100006")
+	confidentialInputsAddr        = common.HexToAddress("0x0000000000000000000000000000000042010001")
+	confidentialRetrieveAddr      = common.HexToAddress("0x0000000000000000000000000000000042020001")
+	confidentialStoreAddr         = common.HexToAddress("0x0000000000000000000000000000000042020000")
+	contextGetAddr                = common.HexToAddress("0x0000000000000000000000000000000053300003")
+	doHTTPRequestAddr             = common.HexToAddress("0x0000000000000000000000000000000043200002")
+	doHTTPRequest2Addr            = common.HexToAddress("0x0000000000000000000000000000000043200003")
+	ethcallAddr                   = common.HexToAddress("0x0000000000000000000000000000000042100003")
+	extractHintAddr               = common.HexToAddress("0x0000000000000000000000000000000042100037")
+	extractHintsAddr              = common.HexToAddress("0x000000000000000000000000000000004210000b")
+	fetchDataRecordsAddr          = common.HexToAddress("0x0000000000000000000000000000000042030001")
+	fillMevShareBundleAddr        = common.HexToAddress("0x0000000000000000000000000000000043200001")
+	getBalanceAddr                = common.HexToAddress("0x000000000000000000000000000000004210000d")
+	getBeaconContextAddr          = common.HexToAddress("0x000000000000000000000000000000004210000a")
+	getBlockHeaderAddr            = common.HexToAddress("0x0000000000000000000000000000000042100010")
+	getCodeAddr                   = common.HexToAddress("0x000000000000000000000000000000004210000e")
+	getInsecureTimeAddr           = common.HexToAddress("0x000000000000000000000000000000007770000c")
+	getNonceAddr                  = common.HexToAddress("0x000000000000000000000000000000004210000c")
+	getRelayBidTracesAddr         = common.HexToAddress("0x0000000000000000000000000000000042100008")
+	getRelayDeliveredPayloadsAddr = common.HexToAddress("0x0000000000000000000000000000000042100009")
+	getRelayValidatorsAddr        = common.HexToAddress("0x0000000000000000000000000000000042100007")
+	getStorageAtAddr              = common.HexToAddress("0x000000000000000000000000000000004210000f")
+	getTransactionReceiptAddr     = common.HexToAddress("0x0000000000000000000000000000000042100011")
+	newBuilderAddr                = common.HexToAddress("0x0000000000000000000000000000000053200001")
+	newDataRecordAddr             = common.HexToAddress("0x0000000000000000000000000000000042030000")
+	privateKeyGenAddr             = common.HexToAddress("0x0000000000000000000000000000000053200003")
+	randomBytesAddr               = common.HexToAddress("0x000000000000000000000000000000007770000b")
+	signEthTransactionAddr        = common.HexToAddress("0x0000000000000000000000000000000040100001")
+	signEthTypedTransactionAddr   = common.HexToAddress("0x0000000000000000000000000000000040100002")
+	signMessageAddr               = common.HexToAddress("0x0000000000000000000000000000000040100003")
+	simulateBundleAddr            = common.HexToAddress("0x0000000000000000000000000000000042100000")
+	simulateBundleWithArgsAddr    = common.HexToAddress("0x0000000000000000000000000000000042100004")
+	simulateTransactionAddr       = common.HexToAddress("0x0000000000000000000000000000000053200002")
+	submitBundleJsonRPCAddr       = common.HexToAddress("0x0000000000000000000000000000000043000001")
+	submitEthBlockToRelayAddr     = common.HexToAddress("0x0000000000000000000000000000000042100002")
+	submitEthBlockToRelaysAddr    = common.HexToAddress("0x0000000000000000000000000000000042100005")
 )
 
 var SuaveMethods = map[string]common.Address{
-	"aesDecrypt":            aesDecryptAddr,
-	"aesEncrypt":            aesEncryptAddr,
-	"buildEthBlock":         buildEthBlockAddr,
-	"buildEthBlockTo":       buildEthBlockToAddr,
-	"confidentialInputs":    confidentialInputsAddr,
-	"confidentialRetrieve":  confidentialRetrieveAddr,
-	"confidentialStore":     confidentialStoreAddr,
-	"contextGet":            contextGetAddr,
-	"doHTTPRequest":         doHTTPRequestAddr,
-	"doHTTPRequest2":        doHTTPRequest2Addr,
-	"ethcall":               ethcallAddr,
-	"extractHint":           extractHintAddr,
-	"fetchDataRecords":      fetchDataRecordsAddr,
-	"fillMevShareBundle":    fillMevShareBundleAddr,
-	"getInsecureTime":       getInsecureTimeAddr,
-	"newBuilder":            newBuilderAddr,
-	"newDataRecord":         newDataRecordAddr,
-	"privateKeyGen":         privateKeyGenAddr,
-	"randomBytes":           randomBytesAddr,
-	"signEthTransaction":    signEthTransactionAddr,
-	"signMessage":           signMessageAddr,
-	"simulateBundle":        simulateBundleAddr,
-	"simulateTransaction":   simulateTransactionAddr,
-	"submitBundleJsonRPC":   submitBundleJsonRPCAddr,
-	"submitEthBlockToRelay": submitEthBlockToRelayAddr,
+	"aesDecrypt":                aesDecryptAddr,
+	"aesEncrypt":                aesEncryptAddr,
+	"buildEthBlock":             buildEthBlockAddr,
+	"buildEthBlockTo":           buildEthBlockToAddr,
+	"confidentialInputs":        confidentialInputsAddr,
+	"confidentialRetrieve":      confidentialRetrieveAddr,
+	"confidentialStore":         confidentialStoreAddr,
+	"contextGet":                contextGetAddr,
+	"doHTTPRequest":             doHTTPRequestAddr,
+	"doHTTPRequest2":            doHTTPRequest2Addr,
+	"ethcall":                   ethcallAddr,
+	"extractHint":               extractHintAddr,
+	"extractHints":              extractHintsAddr,
+	"fetchDataRecords":          fetchDataRecordsAddr,
+	"fillMevShareBundle":        fillMevShareBundleAddr,
+	"getBalance":                getBalanceAddr,
+	"getBeaconContext":          getBeaconContextAddr,
+	"getBlockHeader":            getBlockHeaderAddr,
+	"getCode":                   getCodeAddr,
+	"getInsecureTime":           getInsecureTimeAddr,
+	"getNonce":                  getNonceAddr,
+	"getRelayBidTraces":         getRelayBidTracesAddr,
+	"getRelayDeliveredPayloads": getRelayDeliveredPayloadsAddr,
+	"getRelayValidators":        getRelayValidatorsAddr,
+	"getStorageAt":              getStorageAtAddr,
+	"getTransactionReceipt":     getTransactionReceiptAddr,
+	"newBuilder":                newBuilderAddr,
+	"newDataRecord":             newDataRecordAddr,
+	"privateKeyGen":             privateKeyGenAddr,
+	"randomBytes":               randomBytesAddr,
+	"signEthTransaction":        signEthTransactionAddr,
+	"signEthTypedTransaction":   signEthTypedTransactionAddr,
+	"signMessage":               signMessageAddr,
+	"simulateBundle":            simulateBundleAddr,
+	"simulateBundleWithArgs":    simulateBundleWithArgsAddr,
+	"simulateTransaction":       simulateTransactionAddr,
+	"submitBundleJsonRPC":       submitBundleJsonRPCAddr,
+	"submitEthBlockToRelay":     submitEthBlockToRelayAddr,
+	"submitEthBlockToRelays":    submitEthBlockToRelaysAddr,
 }
 
 func PrecompileAddressToName(addr common.Address) string {
@@ -89,12 +117,34 @@ func PrecompileAddressToName(addr common.Address) string {
 		return "ethcall"
 	case extractHintAddr:
 		return "extractHint"
+	case extractHintsAddr:
+		return "extractHints"
 	case fetchDataRecordsAddr:
 		return "fetchDataRecords"
 	case fillMevShareBundleAddr:
 		return "fillMevShareBundle"
+	case getBalanceAddr:
+		return "getBalance"
+	case getBeaconContextAddr:
+		return "getBeaconContext"
+	case getBlockHeaderAddr:
+		return "getBlockHeader"
+	case getCodeAddr:
+		return "getCode"
 	case getInsecureTimeAddr:
 		return "getInsecureTime"
+	case getNonceAddr:
+		return "getNonce"
+	case getRelayBidTracesAddr:
+		return "getRelayBidTraces"
+	case getRelayDeliveredPayloadsAddr:
+		return "getRelayDeliveredPayloads"
+	case getRelayValidatorsAddr:
+		return "getRelayValidators"
+	case getStorageAtAddr:
+		return "getStorageAt"
+	case getTransactionReceiptAddr:
+		return "getTransactionReceipt"
 	case newBuilderAddr:
 		return "newBuilder"
 	case newDataRecordAddr:
@@ -105,16 +155,22 @@ func PrecompileAddressToName(addr common.Address) string {
 		return "randomBytes"
 	case signEthTransactionAddr:
 		return "signEthTransaction"
+	case signEthTypedTransactionAddr:
+		return "signEthTypedTransaction"
 	case signMessageAddr:
 		return "signMessage"
 	case simulateBundleAddr:
 		return "simulateBundle"
+	case simulateBundleWithArgsAddr:
+		return "simulateBundleWithArgs"
 	case simulateTransactionAddr:
 		return "simulateTransaction"
 	case submitBundleJsonRPCAddr:
 		return "submitBundleJsonRPC"
 	case submitEthBlockToRelayAddr:
 		return "submitEthBlockToRelay"
+	case submitEthBlockToRelaysAddr:
+		return "submitEthBlockToRelays"
 	}
 	return ""
 }
@@ -0,0 +1,1219 @@
+# Code generated by suave/gen. DO NOT EDIT.
+# Hash: 5104f60b291f4b2d27870e420886b6c1f908af10b5d69aecafeceffcf050ee07
+
+"""Types and ABI encoders of the Suave MEVM precompiles for eth_abi."""
+
+from __future__ import annotations
+
+import warnings
+from dataclasses import dataclass
+from enum import IntEnum
+from typing import List, Optional, Tuple, Union
+
+from eth_abi import decode, encode
+
+DataId = bytes
+
+
+class CryptoSignature(IntEnum):
+    SECP256 = 0
+    BLS = 1
+
+
+@dataclass
+class AccessListEntry:
+    """Entry of the access list of a transaction."""
+
+    #: Address accessed by the transaction
+    addr: str
+
+    #: Storage slots of the address accessed by the transaction
+    storageKeys: List[bytes]
+
+    ABI_TYPE = "(address,bytes32[])"
+
+    def to_abi(self) -> tuple:
+        return (self.addr, self.storageKeys)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> AccessListEntry:
+        return cls(value[0], list(value[1]))
+
+
+@dataclass
+class BeaconContext:
+    """Beacon chain data required to build the block of a slot."""
+
+    #: Slot of the block
+    slot: int
+
+    #: Timestamp of the block
+    timestamp: int
+
+    #: Index of the proposer
+    proposerIndex: int
+
+    #: Public key of the proposer
+    proposerPubkey: bytes
+
+    #: Randao mix of the block
+    random: bytes
+
+    #: Withdrawals expected in the block
+    withdrawals: List[Withdrawal]
+
+    #: Root of the parent beacon block
+    beaconRoot: bytes
+
+    #: Hash of the parent execution block
+    parentHash: bytes
+
+    ABI_TYPE = "(uint64,uint64,uint64,bytes,bytes32,(uint64,uint64,address,uint64)[],bytes32,bytes32)"
+
+    def to_abi(self) -> tuple:
+        return (self.slot, self.timestamp, self.proposerIndex, self.proposerPubkey, self.random, [v.to_abi() for v in self.withdrawals], self.beaconRoot, self.parentHash)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> BeaconContext:
+        return cls(value[0], value[1], value[2], value[3], value[4], [Withdrawal.from_abi(v) for v in value[5]], value[6], value[7])
+
+
+@dataclass
+class BuildBlockArgs:
+    """Arguments to build the block."""
+
+    #: Slot number of the block
+    slot: int
+
+    #: Public key of the proposer
+    proposerPubkey: bytes
+
+    #: Hash of the parent block
+    parent: bytes
+
+    #: Timestamp of the block
+    timestamp: int
+
+    #: Address of the fee recipient
+    feeRecipient: str
+
+    #: Gas limit of the block
+    gasLimit: int
+
+    #: Randomness of the block
+    random: bytes
+
+    #: List of withdrawals
+    withdrawals: List[Withdrawal]
+
+    #: Extra data of the block
+    extra: bytes
+
+    #: Root of the beacon chain
+    beaconRoot: bytes
+
+    #: Whether to fill the block with pending transactions
+    fillPending: bool
+
+    #: Algorithm used to build the block from bundles: 'default', 'discard-failing', 'greedy' or 'greedy-merge'
+    algorithm: str
+
+    #: Maximum time in milliseconds spent filling the block with pending transactions, 0 for the node default
+    fillPendingTimeout: int
+
+    ABI_TYPE = "(uint64,bytes,bytes32,uint64,address,uint64,bytes32,(uint64,uint64,address,uint64)[],bytes,bytes32,bool,string,uint64)"
+
+    def to_abi(self) -> tuple:
+        return (self.slot, self.proposerPubkey, self.parent, self.timestamp, self.feeRecipient, self.gasLimit, self.random, [v.to_abi() for v in self.withdrawals], self.extra, self.beaconRoot, self.fillPending, self.algorithm, self.fillPendingTimeout)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> BuildBlockArgs:
+        return cls(value[0], value[1], value[2], value[3], value[4], value[5], value[6], [Withdrawal.from_abi(v) for v in value[7]], value[8], value[9], value[10], value[11], value[12])
+
+
+@dataclass
+class BundleHint:
+    """Hints of a bundle shared in an orderflow auction."""
+
+    #: Hints of the transactions of the bundle, in order
+    txs: List[TxHint]
+
+    #: Logs emitted during the simulation of the bundle
+    logs: List[SimulatedLog]
+
+    ABI_TYPE = "((bytes32,address,uint256,bytes4,bytes)[],(bytes,address,bytes32[])[])"
+
+    def to_abi(self) -> tuple:
+        return ([v.to_abi() for v in self.txs], [v.to_abi() for v in self.logs])
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> BundleHint:
+        return cls([TxHint.from_abi(v) for v in value[0]], [SimulatedLog.from_abi(v) for v in value[1]])
+
+
+@dataclass
+class DataRecord:
+    """A record of data stored in the ConfidentialStore."""
+
+    #: ID of the data record
+    id: DataId
+
+    #: Salt used to derive the encryption key
+    salt: DataId
+
+    #: Up to which block this data record is valid
+    decryptionCondition: int
+
+    #: Addresses which can get data
+    allowedPeekers: List[str]
+
+    #: Addresses can set data
+    allowedStores: List[str]
+
+    #: Namespace of the data record
+    version: str
+
+    ABI_TYPE = "(bytes16,bytes16,uint64,address[],address[],string)"
+
+    def to_abi(self) -> tuple:
+        return (self.id, self.salt, self.decryptionCondition, self.allowedPeekers, self.allowedStores, self.version)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> DataRecord:
+        return cls(value[0], value[1], value[2], list(value[3]), list(value[4]), value[5])
+
+
+@dataclass
+class EthBlockHeader:
+    """Header of a block of the execution node."""
+
+    #: Hash of the block
+    hash: bytes
+
+    #: Hash of the parent block
+    parentHash: bytes
+
+    #: Number of the block
+    number: int
+
+    #: Timestamp of the block
+    timestamp: int
+
+    #: Fee recipient of the block
+    coinbase: str
+
+    #: Root of the state trie after the block
+    stateRoot: bytes
+
+    #: Root of the receipts trie of the block
+    receiptsRoot: bytes
+
+    #: Gas limit of the block
+    gasLimit: int
+
+    #: Gas used by the transactions of the block
+    gasUsed: int
+
+    #: Base fee per gas of the block
+    baseFee: int
+
+    #: Randomness of the beacon chain for the block
+    prevRandao: bytes
+
+    #: Extra data of the block
+    extraData: bytes
+
+    ABI_TYPE = "(bytes32,bytes32,uint64,uint64,address,bytes32,bytes32,uint64,uint64,uint256,bytes32,bytes)"
+
+    def to_abi(self) -> tuple:
+        return (self.hash, self.parentHash, self.number, self.timestamp, self.coinbase, self.stateRoot, self.receiptsRoot, self.gasLimit, self.gasUsed, self.baseFee, self.prevRandao, self.extraData)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> EthBlockHeader:
+        return cls(value[0], value[1], value[2], value[3], value[4], value[5], value[6], value[7], value[8], value[9], value[10], value[11])
+
+
+@dataclass
+class EthReceipt:
+    """Receipt of a transaction included by the execution node."""
+
+    #: Hash of the transaction
+    txHash: bytes
+
+    #: Hash of the block of the transaction
+    blockHash: bytes
+
+    #: Number of the block of the transaction
+    blockNumber: int
+
+    #: Index of the transaction in the block
+    transactionIndex: int
+
+    #: 1 if the transaction succeeded, 0 if it reverted
+    status: int
+
+    #: Gas used by the transaction
+    gasUsed: int
+
+    #: Gas used by the block up to and including the transaction
+    cumulativeGasUsed: int
+
+    #: Price per gas paid by the transaction
+    effectiveGasPrice: int
+
+    #: Address of the contract created by the transaction, if any
+    contractAddress: str
+
+    #: Logs emitted by the transaction
+    logs: List[SimulatedLog]
+
+    ABI_TYPE = "(bytes32,bytes32,uint64,uint64,uint64,uint64,uint64,uint256,address,(bytes,address,bytes32[])[])"
+
+    def to_abi(self) -> tuple:
+        return (self.txHash, self.blockHash, self.blockNumber, self.transactionIndex, self.status, self.gasUsed, self.cumulativeGasUsed, self.effectiveGasPrice, self.contractAddress, [v.to_abi() for v in self.logs])
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> EthReceipt:
+        return cls(value[0], value[1], value[2], value[3], value[4], value[5], value[6], value[7], value[8], [SimulatedLog.from_abi(v) for v in value[9]])
+
+
+@dataclass
+class EthTransaction:
+    """Unsigned Ethereum transaction of any type. The fields which do not apply to the type are ignored."""
+
+    #: Type of the transaction: 0 (legacy), 1 (EIP-2930), 2 (EIP-1559) or 3 (EIP-4844)
+    txType: int
+
+    #: Id of the chain to sign for
+    chainId: int
+
+    #: Nonce of the sender
+    nonce: int
+
+    #: Gas price of the legacy and EIP-2930 transactions
+    gasPrice: int
+
+    #: Maximum priority fee per gas of the EIP-1559 and EIP-4844 transactions
+    gasTipCap: int
+
+    #: Maximum fee per gas of the EIP-1559 and EIP-4844 transactions
+    gasFeeCap: int
+
+    #: Gas limit of the transaction
+    gas: int
+
+    #: Recipient of the transaction, the zero address for a contract creation
+    to: str
+
+    #: Value transferred by the transaction
+    value: int
+
+    #: Calldata of the transaction
+    data: bytes
+
+    #: Access list of the typed transactions
+    accessList: List[AccessListEntry]
+
+    #: Maximum fee per blob gas of the EIP-4844 transactions
+    blobFeeCap: int
+
+    #: Versioned hashes of the blobs of the EIP-4844 transactions
+    blobHashes: List[bytes]
+
+    ABI_TYPE = "(uint8,uint256,uint64,uint256,uint256,uint256,uint64,address,uint256,bytes,(address,bytes32[])[],uint256,bytes32[])"
+
+    def to_abi(self) -> tuple:
+        return (self.txType, self.chainId, self.nonce, self.gasPrice, self.gasTipCap, self.gasFeeCap, self.gas, self.to, self.value, self.data, [v.to_abi() for v in self.accessList], self.blobFeeCap, self.blobHashes)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> EthTransaction:
+        return cls(value[0], value[1], value[2], value[3], value[4], value[5], value[6], value[7], value[8], value[9], [AccessListEntry.from_abi(v) for v in value[10]], value[11], list(value[12]))
+
+
+@dataclass
+class HttpRequest:
+    """Description of an HTTP request."""
+
+    #: Target url of the request
+    url: str
+
+    #: HTTP method of the request
+    method: str
+
+    #: HTTP Headers
+    headers: List[str]
+
+    #: Body of the request (if Post or Put)
+    body: bytes
+
+    #: Whether to include the Flashbots signature
+    withFlashbotsSignature: bool
+
+    #: Timeout of the request in milliseconds
+    timeout: int
+
+    ABI_TYPE = "(string,string,string[],bytes,bool,uint64)"
+
+    def to_abi(self) -> tuple:
+        return (self.url, self.method, self.headers, self.body, self.withFlashbotsSignature, self.timeout)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> HttpRequest:
+        return cls(value[0], value[1], list(value[2]), value[3], value[4], value[5])
+
+
+@dataclass
+class HttpResponse:
+    """Description of an HTTP response."""
+
+    #: HTTP status code of the response
+    status: int
+
+    #: Body of the response
+    body: bytes
+
+    #: Error message if any
+    error: bytes
+
+    ABI_TYPE = "(uint64,bytes,bytes)"
+
+    def to_abi(self) -> tuple:
+        return (self.status, self.body, self.error)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> HttpResponse:
+        return cls(value[0], value[1], value[2])
+
+
+@dataclass
+class RelayBidTrace:
+    """Bid received or delivered by a relay."""
+
+    #: Slot of the bid
+    slot: int
+
+    #: Hash of the parent block
+    parentHash: bytes
+
+    #: Hash of the block
+    blockHash: bytes
+
+    #: Public key of the builder
+    builderPubkey: bytes
+
+    #: Public key of the proposer
+    proposerPubkey: bytes
+
+    #: Fee recipient of the proposer
+    proposerFeeRecipient: str
+
+    #: Gas limit of the block
+    gasLimit: int
+
+    #: Gas used by the block
+    gasUsed: int
+
+    #: Value paid to the proposer in wei
+    value: int
+
+    #: Number of the block
+    blockNumber: int
+
+    #: Number of transactions in the block
+    numTx: int
+
+    #: Time the relay received the bid in milliseconds, 0 for delivered payloads
+    timestampMs: int
+
+    ABI_TYPE = "(uint64,bytes32,bytes32,bytes,bytes,address,uint64,uint64,uint256,uint64,uint64,uint64)"
+
+    def to_abi(self) -> tuple:
+        return (self.slot, self.parentHash, self.blockHash, self.builderPubkey, self.proposerPubkey, self.proposerFeeRecipient, self.gasLimit, self.gasUsed, self.value, self.blockNumber, self.numTx, self.timestampMs)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> RelayBidTrace:
+        return cls(value[0], value[1], value[2], value[3], value[4], value[5], value[6], value[7], value[8], value[9], value[10], value[11])
+
+
+@dataclass
+class RelaySubmissionOptions:
+    """Options of the submission of a block bid to the relays."""
+
+    #: Whether to encode the bid with SSZ instead of JSON
+    ssz: bool
+
+    #: Whether to compress the bid with gzip
+    gzip: bool
+
+    #: Number of retries of a submission failing with a transient error, at most 5
+    maxRetries: int
+
+    #: Unix timestamp in milliseconds after which no more attempts are made, at most and by default one slot from now
+    deadline: int
+
+    ABI_TYPE = "(bool,bool,uint64,uint64)"
+
+    def to_abi(self) -> tuple:
+        return (self.ssz, self.gzip, self.maxRetries, self.deadline)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> RelaySubmissionOptions:
+        return cls(value[0], value[1], value[2], value[3])
+
+
+@dataclass
+class RelaySubmissionResult:
+    """Result of the submission of a block bid to a relay."""
+
+    #: URL of the relay
+    relayUrl: str
+
+    #: Whether the relay accepted the bid
+    success: bool
+
+    #: HTTP status code of the last attempt, 0 if the relay could not be reached
+    statusCode: int
+
+    #: Number of attempts made
+    attempts: int
+
+    #: Error of the last attempt if the submission failed
+    error: str
+
+    ABI_TYPE = "(string,bool,uint64,uint64,string)"
+
+    def to_abi(self) -> tuple:
+        return (self.relayUrl, self.success, self.statusCode, self.attempts, self.error)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> RelaySubmissionResult:
+        return cls(value[0], value[1], value[2], value[3], value[4])
+
+
+@dataclass
+class SimulateBundleResult:
+    """Result of a simulated bundle."""
+
+    #: Whether the bundle can be included in the block
+    success: bool
+
+    #: Error message if any
+    error: str
+
+    #: Gas used by the bundle
+    gasUsed: int
+
+    #: Profit of the fee recipient net of the base fee
+    coinbaseProfit: int
+
+    #: Profit of the fee recipient per unit of gas used
+    effectiveGasPrice: int
+
+    #: Logs emitted during the simulation
+    logs: List[SimulatedLog]
+
+    ABI_TYPE = "(bool,string,uint64,uint256,uint64,(bytes,address,bytes32[])[])"
+
+    def to_abi(self) -> tuple:
+        return (self.success, self.error, self.gasUsed, self.coinbaseProfit, self.effectiveGasPrice, [v.to_abi() for v in self.logs])
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> SimulateBundleResult:
+        return cls(value[0], value[1], value[2], value[3], value[4], [SimulatedLog.from_abi(v) for v in value[5]])
+
+
+@dataclass
+class SimulateTransactionResult:
+    """Result of a simulated transaction."""
+
+    #: Effective Gas Price of the transaction
+    egp: int
+
+    #: Logs emitted during the simulation
+    logs: List[SimulatedLog]
+
+    #: Whether the transaction was successful or not
+    success: bool
+
+    #: Error message if any
+    error: str
+
+    #: Gas used by the transaction
+    gasUsed: int
+
+    #: Direct transfers to the coinbase of the block, the transaction fees are not included
+    coinbasePayment: int
+
+    #: Decoded revert reason if the transaction reverted
+    revertReason: str
+
+    #: Storage slots accessed during the simulation
+    touchedSlots: List[SimulatedStorageAccess]
+
+    ABI_TYPE = "(uint64,(bytes,address,bytes32[])[],bool,string,uint64,uint256,string,(address,bytes32[])[])"
+
+    def to_abi(self) -> tuple:
+        return (self.egp, [v.to_abi() for v in self.logs], self.success, self.error, self.gasUsed, self.coinbasePayment, self.revertReason, [v.to_abi() for v in self.touchedSlots])
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> SimulateTransactionResult:
+        return cls(value[0], [SimulatedLog.from_abi(v) for v in value[1]], value[2], value[3], value[4], value[5], value[6], [SimulatedStorageAccess.from_abi(v) for v in value[7]])
+
+
+@dataclass
+class SimulatedLog:
+    """A log emitted during the simulation of a transaction."""
+
+    #: Data of the log
+    data: bytes
+
+    #: Address of the contract that emitted the log
+    addr: str
+
+    #: Topics of the log
+    topics: List[bytes]
+
+    ABI_TYPE = "(bytes,address,bytes32[])"
+
+    def to_abi(self) -> tuple:
+        return (self.data, self.addr, self.topics)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> SimulatedLog:
+        return cls(value[0], value[1], list(value[2]))
+
+
+@dataclass
+class SimulatedStorageAccess:
+    """Storage slots of a contract accessed during the simulation of a transaction."""
+
+    #: Address of the contract
+    addr: str
+
+    #: Storage slots accessed
+    slots: List[bytes]
+
+    ABI_TYPE = "(address,bytes32[])"
+
+    def to_abi(self) -> tuple:
+        return (self.addr, self.slots)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> SimulatedStorageAccess:
+        return cls(value[0], list(value[1]))
+
+
+@dataclass
+class TxHint:
+    """Hint of a transaction of a bundle. The fields not selected by the hint policy are left empty."""
+
+    #: Hash of the transaction
+    hash: bytes
+
+    #: Recipient of the transaction
+    to: str
+
+    #: Value transferred by the transaction
+    value: int
+
+    #: First four bytes of the calldata
+    functionSelector: bytes
+
+    #: Calldata of the transaction
+    callData: bytes
+
+    ABI_TYPE = "(bytes32,address,uint256,bytes4,bytes)"
+
+    def to_abi(self) -> tuple:
+        return (self.hash, self.to, self.value, self.functionSelector, self.callData)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> TxHint:
+        return cls(value[0], value[1], value[2], value[3], value[4])
+
+
+@dataclass
+class ValidatorDuty:
+    """Validator registered in a relay which proposes in an upcoming slot."""
+
+    #: Slot of the proposal
+    slot: int
+
+    #: Index of the validator
+    validatorIndex: int
+
+    #: Public key of the validator
+    pubkey: bytes
+
+    #: Fee recipient registered by the validator
+    feeRecipient: str
+
+    #: Gas limit registered by the validator
+    gasLimit: int
+
+    #: Timestamp of the registration
+    timestamp: int
+
+    ABI_TYPE = "(uint64,uint64,bytes,address,uint64,uint64)"
+
+    def to_abi(self) -> tuple:
+        return (self.slot, self.validatorIndex, self.pubkey, self.feeRecipient, self.gasLimit, self.timestamp)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> ValidatorDuty:
+        return cls(value[0], value[1], value[2], value[3], value[4], value[5])
+
+
+@dataclass
+class Withdrawal:
+    """A withdrawal from the beacon chain."""
+
+    #: Index of the withdrawal
+    index: int
+
+    #: ID of the validator
+    validator: int
+
+    #: Address to withdraw to
+    Address: str
+
+    #: Amount to be withdrawn
+    amount: int
+
+    ABI_TYPE = "(uint64,uint64,address,uint64)"
+
+    def to_abi(self) -> tuple:
+        return (self.index, self.validator, self.Address, self.amount)
+
+    @classmethod
+    def from_abi(cls, value: tuple) -> Withdrawal:
+        return cls(value[0], value[1], value[2], value[3])
+
+
+@dataclass
+class ContextValueNotFoundError:
+    """The key is not set in the context."""
+
+    #: Key of the value
+    key: str
+
+    SELECTOR = bytes.fromhex("b09312f3")
+
+
+@dataclass
+class DomainNotAllowedError:
+    """The domain of the request is not allowed by the kettle."""
+
+    #: Domain of the request
+    domain: str
+
+    SELECTOR = bytes.fromhex("d82e9c0b")
+
+
+@dataclass
+class HttpRequestFailedError:
+    """The HTTP request returned an unsuccessful status code."""
+
+    #: Status code of the response
+    status: int
+
+    #: Body of the response
+    body: bytes
+
+    SELECTOR = bytes.fromhex("e289b1f9")
+
+
+SuaveError = Union[ContextValueNotFoundError, DomainNotAllowedError, HttpRequestFailedError]
+"""Typed error a precompile reverts with."""
+
+PEEKER_REVERTED_SELECTOR = bytes.fromhex("75fff467")
+
+IS_CONFIDENTIAL_ADDR = "0x0000000000000000000000000000000042010000"
+AES_DECRYPT = "0x000000000000000000000000000000005670000d"
+AES_ENCRYPT = "0x000000000000000000000000000000005670000e"
+BUILD_ETH_BLOCK = "0x0000000000000000000000000000000042100001"
+BUILD_ETH_BLOCK_TO = "0x0000000000000000000000000000000042100006"
+CONFIDENTIAL_INPUTS = "0x0000000000000000000000000000000042010001"
+CONFIDENTIAL_RETRIEVE = "0x0000000000000000000000000000000042020001"
+CONFIDENTIAL_STORE = "0x0000000000000000000000000000000042020000"
+CONTEXT_GET = "0x0000000000000000000000000000000053300003"
+DO_HTTPREQUEST = "0x0000000000000000000000000000000043200002"
+DO_HTTPREQUEST2 = "0x0000000000000000000000000000000043200003"
+ETHCALL = "0x0000000000000000000000000000000042100003"
+EXTRACT_HINT = "0x0000000000000000000000000000000042100037"
+EXTRACT_HINTS = "0x000000000000000000000000000000004210000b"
+FETCH_DATA_RECORDS = "0x0000000000000000000000000000000042030001"
+FILL_MEV_SHARE_BUNDLE = "0x0000000000000000000000000000000043200001"
+GET_BALANCE = "0x000000000000000000000000000000004210000d"
+GET_BEACON_CONTEXT = "0x000000000000000000000000000000004210000a"
+GET_BLOCK_HEADER = "0x0000000000000000000000000000000042100010"
+GET_CODE = "0x000000000000000000000000000000004210000e"
+GET_INSECURE_TIME = "0x000000000000000000000000000000007770000c"
+GET_NONCE = "0x000000000000000000000000000000004210000c"
+GET_RELAY_BID_TRACES = "0x0000000000000000000000000000000042100008"
+GET_RELAY_DELIVERED_PAYLOADS = "0x0000000000000000000000000000000042100009"
+GET_RELAY_VALIDATORS = "0x0000000000000000000000000000000042100007"
+GET_STORAGE_AT = "0x000000000000000000000000000000004210000f"
+GET_TRANSACTION_RECEIPT = "0x0000000000000000000000000000000042100011"
+NEW_BUILDER = "0x0000000000000000000000000000000053200001"
+NEW_DATA_RECORD = "0x0000000000000000000000000000000042030000"
+PRIVATE_KEY_GEN = "0x0000000000000000000000000000000053200003"
+RANDOM_BYTES = "0x000000000000000000000000000000007770000b"
+SIGN_ETH_TRANSACTION = "0x0000000000000000000000000000000040100001"
+SIGN_ETH_TYPED_TRANSACTION = "0x0000000000000000000000000000000040100002"
+SIGN_MESSAGE = "0x0000000000000000000000000000000040100003"
+SIMULATE_BUNDLE = "0x0000000000000000000000000000000042100000"
+SIMULATE_BUNDLE_WITH_ARGS = "0x0000000000000000000000000000000042100004"
+SIMULATE_TRANSACTION = "0x0000000000000000000000000000000053200002"
+SUBMIT_BUNDLE_JSON_RPC = "0x0000000000000000000000000000000043000001"
+SUBMIT_ETH_BLOCK_TO_RELAY = "0x0000000000000000000000000000000042100002"
+SUBMIT_ETH_BLOCK_TO_RELAYS = "0x0000000000000000000000000000000042100005"
+
+
+def encode_aes_decrypt_input(key: bytes, ciphertext: bytes) -> bytes:
+    """Encodes the input of the aesDecrypt precompile. Decrypts a message using given bytes as a cipher."""
+    return encode(["bytes", "bytes"], [key, ciphertext])
+
+
+def decode_aes_decrypt_output(data: bytes) -> bytes:
+    """Decodes the output of the aesDecrypt precompile."""
+    (value,) = decode(["bytes"], data)
+    return value
+
+
+def encode_aes_encrypt_input(key: bytes, message: bytes) -> bytes:
+    """Encodes the input of the aesEncrypt precompile. Encrypts a message using given bytes as a cipher."""
+    return encode(["bytes", "bytes"], [key, message])
+
+
+def decode_aes_encrypt_output(data: bytes) -> bytes:
+    """Decodes the output of the aesEncrypt precompile."""
+    (value,) = decode(["bytes"], data)
+    return value
+
+
+def encode_build_eth_block_input(blockArgs: BuildBlockArgs, dataId: DataId, relayUrl: str) -> bytes:
+    """Encodes the input of the buildEthBlock precompile. Constructs an Ethereum block based on the provided data records. No blobs are returned."""
+    return encode(["(uint64,bytes,bytes32,uint64,address,uint64,bytes32,(uint64,uint64,address,uint64)[],bytes,bytes32,bool,string,uint64)", "bytes16", "string"], [blockArgs.to_abi(), dataId, relayUrl])
+
+
+def decode_build_eth_block_output(data: bytes) -> Tuple[bytes, bytes]:
+    """Decodes the output of the buildEthBlock precompile."""
+    values = decode(["bytes", "bytes"], data)
+    return (values[0], values[1])
+
+
+def encode_build_eth_block_to_input(executionNodeURL: str, blockArgs: BuildBlockArgs, dataId: DataId, relayUrl: str) -> bytes:
+    """Encodes the input of the buildEthBlockTo precompile. Constructs an Ethereum block based on the provided data records. No blobs are returned."""
+    return encode(["string", "(uint64,bytes,bytes32,uint64,address,uint64,bytes32,(uint64,uint64,address,uint64)[],bytes,bytes32,bool,string,uint64)", "bytes16", "string"], [executionNodeURL, blockArgs.to_abi(), dataId, relayUrl])
+
+
+def decode_build_eth_block_to_output(data: bytes) -> Tuple[bytes, bytes]:
+    """Decodes the output of the buildEthBlockTo precompile."""
+    values = decode(["bytes", "bytes"], data)
+    return (values[0], values[1])
+
+
+def encode_confidential_inputs_input() -> bytes:
+    """Encodes the input of the confidentialInputs precompile. Provides the confidential inputs associated with a confidential computation request. Outputs are in bytes format."""
+    return encode([], [])
+
+
+def decode_confidential_inputs_output(data: bytes) -> bytes:
+    """Decodes the output of the confidentialInputs precompile."""
+    return data
+
+
+def encode_confidential_retrieve_input(dataId: DataId, key: str) -> bytes:
+    """Encodes the input of the confidentialRetrieve precompile. Retrieves data from the confidential store. Also mandates the caller's presence in the `AllowedPeekers` list."""
+    return encode(["bytes16", "string"], [dataId, key])
+
+
+def decode_confidential_retrieve_output(data: bytes) -> bytes:
+    """Decodes the output of the confidentialRetrieve precompile."""
+    return data
+
+
+def encode_confidential_store_input(dataId: DataId, key: str, value: bytes) -> bytes:
+    """Encodes the input of the confidentialStore precompile. Stores data in the confidential store. Requires the caller to be part of the `AllowedPeekers` for the associated data record."""
+    return encode(["bytes16", "string", "bytes"], [dataId, key, value])
+
+
+def encode_context_get_input(key: str) -> bytes:
+    """Encodes the input of the contextGet precompile. Retrieves a value from the context"""
+    return encode(["string"], [key])
+
+
+def decode_context_get_output(data: bytes) -> bytes:
+    """Decodes the output of the contextGet precompile."""
+    (value,) = decode(["bytes"], data)
+    return value
+
+
+def encode_do_http_request_input(request: HttpRequest) -> bytes:
+    """Encodes the input of the doHTTPRequest precompile. Performs an HTTP request and returns the response. `request` is the request to perform."""
+    return encode(["(string,string,string[],bytes,bool,uint64)"], [request.to_abi()])
+
+
+def decode_do_http_request_output(data: bytes) -> bytes:
+    """Decodes the output of the doHTTPRequest precompile."""
+    (value,) = decode(["bytes"], data)
+    return value
+
+
+def encode_do_http_request2_input(request: HttpRequest) -> bytes:
+    """Encodes the input of the doHTTPRequest2 precompile. Performs an HTTP request and returns the response. `request` is the request to perform."""
+    return encode(["(string,string,string[],bytes,bool,uint64)"], [request.to_abi()])
+
+
+def decode_do_http_request2_output(data: bytes) -> HttpResponse:
+    """Decodes the output of the doHTTPRequest2 precompile."""
+    (value,) = decode(["(uint64,bytes,bytes)"], data)
+    return HttpResponse.from_abi(value)
+
+
+def encode_ethcall_input(contractAddr: str, input1: bytes) -> bytes:
+    """Encodes the input of the ethcall precompile. Uses the `eth_call` JSON RPC method to let you simulate a function call and return the response."""
+    return encode(["address", "bytes"], [contractAddr, input1])
+
+
+def decode_ethcall_output(data: bytes) -> bytes:
+    """Decodes the output of the ethcall precompile."""
+    (value,) = decode(["bytes"], data)
+    return value
+
+
+def encode_extract_hint_input(bundleData: bytes) -> bytes:
+    """Encodes the input of the extractHint precompile. Interprets the bundle data and extracts the `To` address and calldata of its first transaction. Use `extractHints` to select the hints.
+
+    Deprecated: Use extractHints, which selects the hints with a policy.
+    """
+    warnings.warn("extractHint is deprecated: Use extractHints, which selects the hints with a policy.", DeprecationWarning, stacklevel=2)
+    return encode(["bytes"], [bundleData])
+
+
+def decode_extract_hint_output(data: bytes) -> bytes:
+    """Decodes the output of the extractHint precompile."""
+    return data
+
+
+def encode_extract_hints_input(bundleData: bytes, policy: int) -> bytes:
+    """Encodes the input of the extractHints precompile. Extracts the hints of the bundle selected by the policy. The policy is a bitmask of: 1 contract address, 2 function selector, 4 calldata, 8 logs of the simulation on top of the latest block, 16 transaction hash, 32 value."""
+    return encode(["bytes", "uint64"], [bundleData, policy])
+
+
+def decode_extract_hints_output(data: bytes) -> BundleHint:
+    """Decodes the output of the extractHints precompile."""
+    (value,) = decode(["((bytes32,address,uint256,bytes4,bytes)[],(bytes,address,bytes32[])[])"], data)
+    return BundleHint.from_abi(value)
+
+
+def encode_fetch_data_records_input(cond: int, namespace: str) -> bytes:
+    """Encodes the input of the fetchDataRecords precompile. Retrieves all data records correlating with a specified decryption condition and namespace"""
+    return encode(["uint64", "string"], [cond, namespace])
+
+
+def decode_fetch_data_records_output(data: bytes) -> List[DataRecord]:
+    """Decodes the output of the fetchDataRecords precompile."""
+    (value,) = decode(["(bytes16,bytes16,uint64,address[],address[],string)[]"], data)
+    return [DataRecord.from_abi(v) for v in value]
+
+
+def encode_fill_mev_share_bundle_input(dataId: DataId) -> bytes:
+    """Encodes the input of the fillMevShareBundle precompile. Joins the user's transaction and with the backrun, and returns encoded mev-share bundle. The bundle is ready to be sent via `SubmitBundleJsonRPC`."""
+    return encode(["bytes16"], [dataId])
+
+
+def decode_fill_mev_share_bundle_output(data: bytes) -> bytes:
+    """Decodes the output of the fillMevShareBundle precompile."""
+    return data
+
+
+def encode_get_balance_input(account: str) -> bytes:
+    """Encodes the input of the getBalance precompile. Returns the balance of an account in the latest state of the execution node."""
+    return encode(["address"], [account])
+
+
+def decode_get_balance_output(data: bytes) -> int:
+    """Decodes the output of the getBalance precompile."""
+    (value,) = decode(["uint256"], data)
+    return value
+
+
+def encode_get_beacon_context_input(slot: int) -> bytes:
+    """Encodes the input of the getBeaconContext precompile. Returns the proposer, randao, withdrawals and parent roots of the slot after the beacon head, as followed by the kettle from its beacon node."""
+    return encode(["uint64"], [slot])
+
+
+def decode_get_beacon_context_output(data: bytes) -> BeaconContext:
+    """Decodes the output of the getBeaconContext precompile."""
+    (value,) = decode(["(uint64,uint64,uint64,bytes,bytes32,(uint64,uint64,address,uint64)[],bytes32,bytes32)"], data)
+    return BeaconContext.from_abi(value)
+
+
+def encode_get_block_header_input(number: int) -> bytes:
+    """Encodes the input of the getBlockHeader precompile. Returns the header of a block of the execution node."""
+    return encode(["uint64"], [number])
+
+
+def decode_get_block_header_output(data: bytes) -> EthBlockHeader:
+    """Decodes the output of the getBlockHeader precompile."""
+    (value,) = decode(["(bytes32,bytes32,uint64,uint64,address,bytes32,bytes32,uint64,uint64,uint256,bytes32,bytes)"], data)
+    return EthBlockHeader.from_abi(value)
+
+
+def encode_get_code_input(account: str) -> bytes:
+    """Encodes the input of the getCode precompile. Returns the code of an account in the latest state of the execution node."""
+    return encode(["address"], [account])
+
+
+def decode_get_code_output(data: bytes) -> bytes:
+    """Decodes the output of the getCode precompile."""
+    (value,) = decode(["bytes"], data)
+    return value
+
+
+def encode_get_insecure_time_input() -> bytes:
+    """Encodes the input of the getInsecureTime precompile. Returns the current Kettle Unix time in milliseconds. Insecure because it assumes trust in Kettle's clock."""
+    return encode([], [])
+
+
+def decode_get_insecure_time_output(data: bytes) -> int:
+    """Decodes the output of the getInsecureTime precompile."""
+    (value,) = decode(["uint256"], data)
+    return value
+
+
+def encode_get_nonce_input(account: str) -> bytes:
+    """Encodes the input of the getNonce precompile. Returns the pending nonce of an account in the execution node, counting its transactions in the pool."""
+    return encode(["address"], [account])
+
+
+def decode_get_nonce_output(data: bytes) -> int:
+    """Decodes the output of the getNonce precompile."""
+    (value,) = decode(["uint64"], data)
+    return value
+
+
+def encode_get_relay_bid_traces_input(relayUrl: str, slot: int) -> bytes:
+    """Encodes the input of the getRelayBidTraces precompile. Returns the bids received by a relay for a slot sorted by descending value, the first one being the top bid."""
+    return encode(["string", "uint64"], [relayUrl, slot])
+
+
+def decode_get_relay_bid_traces_output(data: bytes) -> List[RelayBidTrace]:
+    """Decodes the output of the getRelayBidTraces precompile."""
+    (value,) = decode(["(uint64,bytes32,bytes32,bytes,bytes,address,uint64,uint64,uint256,uint64,uint64,uint64)[]"], data)
+    return [RelayBidTrace.from_abi(v) for v in value]
+
+
+def encode_get_relay_delivered_payloads_input(relayUrl: str, slot: int) -> bytes:
+    """Encodes the input of the getRelayDeliveredPayloads precompile. Returns the payloads delivered by a relay to the proposer of a slot."""
+    return encode(["string", "uint64"], [relayUrl, slot])
+
+
+def decode_get_relay_delivered_payloads_output(data: bytes) -> List[RelayBidTrace]:
+    """Decodes the output of the getRelayDeliveredPayloads precompile."""
+    (value,) = decode(["(uint64,bytes32,bytes32,bytes,bytes,address,uint64,uint64,uint256,uint64,uint64,uint64)[]"], data)
+    return [RelayBidTrace.from_abi(v) for v in value]
+
+
+def encode_get_relay_validators_input(relayUrl: str) -> bytes:
+    """Encodes the input of the getRelayValidators precompile. Returns the proposer duties of the current and next epoch registered in a relay."""
+    return encode(["string"], [relayUrl])
+
+
+def decode_get_relay_validators_output(data: bytes) -> List[ValidatorDuty]:
+    """Decodes the output of the getRelayValidators precompile."""
+    (value,) = decode(["(uint64,uint64,bytes,address,uint64,uint64)[]"], data)
+    return [ValidatorDuty.from_abi(v) for v in value]
+
+
+def encode_get_storage_at_input(account: str, slot: bytes) -> bytes:
+    """Encodes the input of the getStorageAt precompile. Returns the value of a storage slot of an account in the latest state of the execution node."""
+    return encode(["address", "bytes32"], [account, slot])
+
+
+def decode_get_storage_at_output(data: bytes) -> bytes:
+    """Decodes the output of the getStorageAt precompile."""
+    (value,) = decode(["bytes32"], data)
+    return value
+
+
+def encode_get_transaction_receipt_input(txHash: bytes) -> bytes:
+    """Encodes the input of the getTransactionReceipt precompile. Returns the receipt of a transaction included by the execution node. Reverts if the transaction is not included."""
+    return encode(["bytes32"], [txHash])
+
+
+def decode_get_transaction_receipt_output(data: bytes) -> EthReceipt:
+    """Decodes the output of the getTransactionReceipt precompile."""
+    (value,) = decode(["(bytes32,bytes32,uint64,uint64,uint64,uint64,uint64,uint256,address,(bytes,address,bytes32[])[])"], data)
+    return EthReceipt.from_abi(value)
+
+
+def encode_new_builder_input() -> bytes:
+    """Encodes the input of the newBuilder precompile. Initializes a new remote builder session"""
+    return encode([], [])
+
+
+def decode_new_builder_output(data: bytes) -> str:
+    """Decodes the output of the newBuilder precompile."""
+    (value,) = decode(["string"], data)
+    return value
+
+
+def encode_new_data_record_input(decryptionCondition: int, allowedPeekers: List[str], allowedStores: List[str], dataType: str) -> bytes:
+    """Encodes the input of the newDataRecord precompile. Initializes data records within the ConfidentialStore. Prior to storing data, all data records should undergo initialization via this precompile."""
+    return encode(["uint64", "address[]", "address[]", "string"], [decryptionCondition, allowedPeekers, allowedStores, dataType])
+
+
+def decode_new_data_record_output(data: bytes) -> DataRecord:
+    """Decodes the output of the newDataRecord precompile."""
+    (value,) = decode(["(bytes16,bytes16,uint64,address[],address[],string)"], data)
+    return DataRecord.from_abi(value)
+
+
+def encode_private_key_gen_input(crypto: CryptoSignature) -> bytes:
+    """Encodes the input of the privateKeyGen precompile. Generates a private key in ECDA secp256k1 format"""
+    return encode(["uint8"], [int(crypto)])
+
+
+def decode_private_key_gen_output(data: bytes) -> str:
+    """Decodes the output of the privateKeyGen precompile."""
+    (value,) = decode(["string"], data)
+    return value
+
+
+def encode_random_bytes_input(numBytes: int) -> bytes:
+    """Encodes the input of the randomBytes precompile. Generates a number of random bytes, given by the argument numBytes."""
+    return encode(["uint8"], [numBytes])
+
+
+def decode_random_bytes_output(data: bytes) -> bytes:
+    """Decodes the output of the randomBytes precompile."""
+    (value,) = decode(["bytes"], data)
+    return value
+
+
+def encode_sign_eth_transaction_input(txn: bytes, chainId: str, signingKey: str) -> bytes:
+    """Encodes the input of the signEthTransaction precompile. Signs an Ethereum Transaction, 1559 or Legacy, and returns raw signed transaction bytes. `txn` is binary encoding of the transaction."""
+    return encode(["bytes", "string", "string"], [txn, chainId, signingKey])
+
+
+def decode_sign_eth_transaction_output(data: bytes) -> bytes:
+    """Decodes the output of the signEthTransaction precompile."""
+    (value,) = decode(["bytes"], data)
+    return value
+
+
+def encode_sign_eth_typed_transaction_input(txn: EthTransaction, signingKey: str) -> bytes:
+    """Encodes the input of the signEthTypedTransaction precompile. Builds a transaction of any type from its fields, signs it for the chain of the transaction and returns the raw signed transaction bytes and its hash."""
+    return encode(["(uint8,uint256,uint64,uint256,uint256,uint256,uint64,address,uint256,bytes,(address,bytes32[])[],uint256,bytes32[])", "string"], [txn.to_abi(), signingKey])
+
+
+def decode_sign_eth_typed_transaction_output(data: bytes) -> Tuple[bytes, bytes]:
+    """Decodes the output of the signEthTypedTransaction precompile."""
+    values = decode(["bytes", "bytes32"], data)
+    return (values[0], values[1])
+
+
+def encode_sign_message_input(digest: bytes, crypto: CryptoSignature, signingKey: str) -> bytes:
+    """Encodes the input of the signMessage precompile. Signs a message and returns the signature."""
+    return encode(["bytes", "uint8", "string"], [digest, int(crypto), signingKey])
+
+
+def decode_sign_message_output(data: bytes) -> bytes:
+    """Decodes the output of the signMessage precompile."""
+    (value,) = decode(["bytes"], data)
+    return value
+
+
+def encode_simulate_bundle_input(bundleData: bytes) -> bytes:
+    """Encodes the input of the simulateBundle precompile. Performs a simulation of the bundle on top of the latest block and returns its effective gas price. Reverts if the bundle cannot be included."""
+    return encode(["bytes"], [bundleData])
+
+
+def decode_simulate_bundle_output(data: bytes) -> int:
+    """Decodes the output of the simulateBundle precompile."""
+    (value,) = decode(["uint64"], data)
+    return value
+
+
+def encode_simulate_bundle_with_args_input(blockArgs: BuildBlockArgs, bundleData: bytes) -> bytes:
+    """Encodes the input of the simulateBundleWithArgs precompile. Simulates a bundle on top of a block built with the given arguments. Honors the block range and the reverting hashes of the bundle."""
+    return encode(["(uint64,bytes,bytes32,uint64,address,uint64,bytes32,(uint64,uint64,address,uint64)[],bytes,bytes32,bool,string,uint64)", "bytes"], [blockArgs.to_abi(), bundleData])
+
+
+def decode_simulate_bundle_with_args_output(data: bytes) -> SimulateBundleResult:
+    """Decodes the output of the simulateBundleWithArgs precompile."""
+    (value,) = decode(["(bool,string,uint64,uint256,uint64,(bytes,address,bytes32[])[])"], data)
+    return SimulateBundleResult.from_abi(value)
+
+
+def encode_simulate_transaction_input(sessionid: str, txn: bytes) -> bytes:
+    """Encodes the input of the simulateTransaction precompile. Simulates a transaction on a remote builder session"""
+    return encode(["string", "bytes"], [sessionid, txn])
+
+
+def decode_simulate_transaction_output(data: bytes) -> SimulateTransactionResult:
+    """Decodes the output of the simulateTransaction precompile."""
+    (value,) = decode(["(uint64,(bytes,address,bytes32[])[],bool,string,uint64,uint256,string,(address,bytes32[])[])"], data)
+    return SimulateTransactionResult.from_abi(value)
+
+
+def encode_submit_bundle_json_rpc_input(url: str, method: str, params: bytes) -> bytes:
+    """Encodes the input of the submitBundleJsonRPC precompile. Submits bytes as JSONRPC message to the specified URL with the specified method. As this call is intended for bundles, it also signs the params and adds `X-Flashbots-Signature` header, as usual with bundles. Regular eth bundles don't need any processing to be sent."""
+    return encode(["string", "string", "bytes"], [url, method, params])
+
+
+def decode_submit_bundle_json_rpc_output(data: bytes) -> bytes:
+    """Decodes the output of the submitBundleJsonRPC precompile."""
+    return data
+
+
+def encode_submit_eth_block_to_relay_input(relayUrl: str, builderBid: bytes) -> bytes:
+    """Encodes the input of the submitEthBlockToRelay precompile. Submits a given builderBid to a mev-boost relay."""
+    return encode(["string", "bytes"], [relayUrl, builderBid])
+
+
+def decode_submit_eth_block_to_relay_output(data: bytes) -> bytes:
+    """Decodes the output of the submitEthBlockToRelay precompile."""
+    return data
+
+
+def encode_submit_eth_block_to_relays_input(relayUrls: List[str], builderBid: bytes, options: RelaySubmissionOptions) -> bytes:
+    """Encodes the input of the submitEthBlockToRelays precompile. Submits a given builderBid to multiple mev-boost relays concurrently, retrying transient failures until the deadline."""
+    return encode(["string[]", "bytes", "(bool,bool,uint64,uint64)"], [relayUrls, builderBid, options.to_abi()])
+
+
+def decode_submit_eth_block_to_relays_output(data: bytes) -> List[RelaySubmissionResult]:
+    """Decodes the output of the submitEthBlockToRelays precompile."""
+    (value,) = decode(["(string,bool,uint64,uint64,string)[]"], data)
+    return [RelaySubmissionResult.from_abi(v) for v in value]
+
+
+def decode_suave_error(data: bytes) -> Optional[SuaveError]:
+    """Decodes a typed error of the precompiles, or returns None if the data does not carry one."""
+    if data[:4] == ContextValueNotFoundError.SELECTOR:
+        values = decode(["string"], data[4:])
+        return ContextValueNotFoundError(values[0])
+    if data[:4] == DomainNotAllowedError.SELECTOR:
+        values = decode(["string"], data[4:])
+        return DomainNotAllowedError(values[0])
+    if data[:4] == HttpRequestFailedError.SELECTOR:
+        values = decode(["uint64", "bytes"], data[4:])
+        return HttpRequestFailedError(values[0], values[1])
+    return None
+
+
+def decode_precompile_revert(data: bytes) -> Optional[Tuple[str, Union[SuaveError, str]]]:
+    """Decodes the PeekerReverted(address, bytes) revert data of a precompile into
+    the address of the precompile and either its typed error or its error message.
+    Returns None if the data is not a PeekerReverted error.
+    """
+    if data[:4] != PEEKER_REVERTED_SELECTOR:
+        return None
+    precompile, reason = decode(["address", "bytes"], data[4:])
+    error = decode_suave_error(reason)
+    if error is None:
+        return precompile, reason.decode(errors="replace")
+    return precompile, error
@@ -0,0 +1,3070 @@
+// Code generated by suave/gen. DO NOT EDIT.
+// Hash: 5104f60b291f4b2d27870e420886b6c1f908af10b5d69aecafeceffcf050ee07
+
+// Types and ABI encoders of the Suave MEVM precompiles for viem.
+
+import { decodeAbiParameters, encodeAbiParameters, hexToString, type Address, type Hex } from 'viem'
+
+export type DataId = Hex
+
+export enum CryptoSignature {
+  SECP256 = 0,
+  BLS = 1,
+}
+
+/** Entry of the access list of a transaction. */
+export type AccessListEntry = {
+  /** Address accessed by the transaction */
+  addr: Address
+  /** Storage slots of the address accessed by the transaction */
+  storageKeys: Hex[]
+}
+
+/** Beacon chain data required to build the block of a slot. */
+export type BeaconContext = {
+  /** Slot of the block */
+  slot: bigint
+  /** Timestamp of the block */
+  timestamp: bigint
+  /** Index of the proposer */
+  proposerIndex: bigint
+  /** Public key of the proposer */
+  proposerPubkey: Hex
+  /** Randao mix of the block */
+  random: Hex
+  /** Withdrawals expected in the block */
+  withdrawals: Withdrawal[]
+  /** Root of the parent beacon block */
+  beaconRoot: Hex
+  /** Hash of the parent execution block */
+  parentHash: Hex
+}
+
+/** Arguments to build the block. */
+export type BuildBlockArgs = {
+  /** Slot number of the block */
+  slot: bigint
+  /** Public key of the proposer */
+  proposerPubkey: Hex
+  /** Hash of the parent block */
+  parent: Hex
+  /** Timestamp of the block */
+  timestamp: bigint
+  /** Address of the fee recipient */
+  feeRecipient: Address
+  /** Gas limit of the block */
+  gasLimit: bigint
+  /** Randomness of the block */
+  random: Hex
+  /** List of withdrawals */
+  withdrawals: Withdrawal[]
+  /** Extra data of the block */
+  extra: Hex
+  /** Root of the beacon chain */
+  beaconRoot: Hex
+  /** Whether to fill the block with pending transactions */
+  fillPending: boolean
+  /** Algorithm used to build the block from bundles: 'default', 'discard-failing', 'greedy' or 'greedy-merge' */
+  algorithm: string
+  /** Maximum time in milliseconds spent filling the block with pending transactions, 0 for the node default */
+  fillPendingTimeout: bigint
+}
+
+/** Hints of a bundle shared in an orderflow auction. */
+export type BundleHint = {
+  /** Hints of the transactions of the bundle, in order */
+  txs: TxHint[]
+  /** Logs emitted during the simulation of the bundle */
+  logs: SimulatedLog[]
+}
+
+/** A record of data stored in the ConfidentialStore. */
+export type DataRecord = {
+  /** ID of the data record */
+  id: DataId
+  /** Salt used to derive the encryption key */
+  salt: DataId
+  /** Up to which block this data record is valid */
+  decryptionCondition: bigint
+  /** Addresses which can get data */
+  allowedPeekers: Address[]
+  /** Addresses can set data */
+  allowedStores: Address[]
+  /** Namespace of the data record */
+  version: string
+}
+
+/** Header of a block of the execution node. */
+export type EthBlockHeader = {
+  /** Hash of the block */
+  hash: Hex
+  /** Hash of the parent block */
+  parentHash: Hex
+  /** Number of the block */
+  number: bigint
+  /** Timestamp of the block */
+  timestamp: bigint
+  /** Fee recipient of the block */
+  coinbase: Address
+  /** Root of the state trie after the block */
+  stateRoot: Hex
+  /** Root of the receipts trie of the block */
+  receiptsRoot: Hex
+  /** Gas limit of the block */
+  gasLimit: bigint
+  /** Gas used by the transactions of the block */
+  gasUsed: bigint
+  /** Base fee per gas of the block */
+  baseFee: bigint
+  /** Randomness of the beacon chain for the block */
+  prevRandao: Hex
+  /** Extra data of the block */
+  extraData: Hex
+}
+
+/** Receipt of a transaction included by the execution node. */
+export type EthReceipt = {
+  /** Hash of the transaction */
+  txHash: Hex
+  /** Hash of the block of the transaction */
+  blockHash: Hex
+  /** Number of the block of the transaction */
+  blockNumber: bigint
+  /** Index of the transaction in the block */
+  transactionIndex: bigint
+  /** 1 if the transaction succeeded, 0 if it reverted */
+  status: bigint
+  /** Gas used by the transaction */
+  gasUsed: bigint
+  /** Gas used by the block up to and including the transaction */
+  cumulativeGasUsed: bigint
+  /** Price per gas paid by the transaction */
+  effectiveGasPrice: bigint
+  /** Address of the contract created by the transaction, if any */
+  contractAddress: Address
+  /** Logs emitted by the transaction */
+  logs: SimulatedLog[]
+}
+
+/** Unsigned Ethereum transaction of any type. The fields which do not apply to the type are ignored. */
+export type EthTransaction = {
+  /** Type of the transaction: 0 (legacy), 1 (EIP-2930), 2 (EIP-1559) or 3 (EIP-4844) */
+  txType: number
+  /** Id of the chain to sign for */
+  chainId: bigint
+  /** Nonce of the sender */
+  nonce: bigint
+  /** Gas price of the legacy and EIP-2930 transactions */
+  gasPrice: bigint
+  /** Maximum priority fee per gas of the EIP-1559 and EIP-4844 transactions */
+  gasTipCap: bigint
+  /** Maximum fee per gas of the EIP-1559 and EIP-4844 transactions */
+  gasFeeCap: bigint
+  /** Gas limit of the transaction */
+  gas: bigint
+  /** Recipient of the transaction, the zero address for a contract creation */
+  to: Address
+  /** Value transferred by the transaction */
+  value: bigint
+  /** Calldata of the transaction */
+  data: Hex
+  /** Access list of the typed transactions */
+  accessList: AccessListEntry[]
+  /** Maximum fee per blob gas of the EIP-4844 transactions */
+  blobFeeCap: bigint
+  /** Versioned hashes of the blobs of the EIP-4844 transactions */
+  blobHashes: Hex[]
+}
+
+/** Description of an HTTP request. */
+export type HttpRequest = {
+  /** Target url of the request */
+  url: string
+  /** HTTP method of the request */
+  method: string
+  /** HTTP Headers */
+  headers: string[]
+  /** Body of the request (if Post or Put) */
+  body: Hex
+  /** Whether to include the Flashbots signature */
+  withFlashbotsSignature: boolean
+  /** Timeout of the request in milliseconds */
+  timeout: bigint
+}
+
+/** Description of an HTTP response. */
+export type HttpResponse = {
+  /** HTTP status code of the response */
+  status: bigint
+  /** Body of the response */
+  body: Hex
+  /** Error message if any */
+  error: Hex
+}
+
+/** Bid received or delivered by a relay. */
+export type RelayBidTrace = {
+  /** Slot of the bid */
+  slot: bigint
+  /** Hash of the parent block */
+  parentHash: Hex
+  /** Hash of the block */
+  blockHash: Hex
+  /** Public key of the builder */
+  builderPubkey: Hex
+  /** Public key of the proposer */
+  proposerPubkey: Hex
+  /** Fee recipient of the proposer */
+  proposerFeeRecipient: Address
+  /** Gas limit of the block */
+  gasLimit: bigint
+  /** Gas used by the block */
+  gasUsed: bigint
+  /** Value paid to the proposer in wei */
+  value: bigint
+  /** Number of the block */
+  blockNumber: bigint
+  /** Number of transactions in the block */
+  numTx: bigint
+  /** Time the relay received the bid in milliseconds, 0 for delivered payloads */
+  timestampMs: bigint
+}
+
+/** Options of the submission of a block bid to the relays. */
+export type RelaySubmissionOptions = {
+  /** Whether to encode the bid with SSZ instead of JSON */
+  ssz: boolean
+  /** Whether to compress the bid with gzip */
+  gzip: boolean
+  /** Number of retries of a submission failing with a transient error, at most 5 */
+  maxRetries: bigint
+  /** Unix timestamp in milliseconds after which no more attempts are made, at most and by default one slot from now */
+  deadline: bigint
+}
+
+/** Result of the submission of a block bid to a relay. */
+export type RelaySubmissionResult = {
+  /** URL of the relay */
+  relayUrl: string
+  /** Whether the relay accepted the bid */
+  success: boolean
+  /** HTTP status code of the last attempt, 0 if the relay could not be reached */
+  statusCode: bigint
+  /** Number of attempts made */
+  attempts: bigint
+  /** Error of the last attempt if the submission failed */
+  error: string
+}
+
+/** Result of a simulated bundle. */
+export type SimulateBundleResult = {
+  /** Whether the bundle can be included in the block */
+  success: boolean
+  /** Error message if any */
+  error: string
+  /** Gas used by the bundle */
+  gasUsed: bigint
+  /** Profit of the fee recipient net of the base fee */
+  coinbaseProfit: bigint
+  /** Profit of the fee recipient per unit of gas used */
+  effectiveGasPrice: bigint
+  /** Logs emitted during the simulation */
+  logs: SimulatedLog[]
+}
+
+/** Result of a simulated transaction. */
+export type SimulateTransactionResult = {
+  /** Effective Gas Price of the transaction */
+  egp: bigint
+  /** Logs emitted during the simulation */
+  logs: SimulatedLog[]
+  /** Whether the transaction was successful or not */
+  success: boolean
+  /** Error message if any */
+  error: string
+  /** Gas used by the transaction */
+  gasUsed: bigint
+  /** Direct transfers to the coinbase of the block, the transaction fees are not included */
+  coinbasePayment: bigint
+  /** Decoded revert reason if the transaction reverted */
+  revertReason: string
+  /** Storage slots accessed during the simulation */
+  touchedSlots: SimulatedStorageAccess[]
+}
+
+/** A log emitted during the simulation of a transaction. */
+export type SimulatedLog = {
+  /** Data of the log */
+  data: Hex
+  /** Address of the contract that emitted the log */
+  addr: Address
+  /** Topics of the log */
+  topics: Hex[]
+}
+
+/** Storage slots of a contract accessed during the simulation of a transaction. */
+export type SimulatedStorageAccess = {
+  /** Address of the contract */
+  addr: Address
+  /** Storage slots accessed */
+  slots: Hex[]
+}
+
+/** Hint of a transaction of a bundle. The fields not selected by the hint policy are left empty. */
+export type TxHint = {
+  /** Hash of the transaction */
+  hash: Hex
+  /** Recipient of the transaction */
+  to: Address
+  /** Value transferred by the transaction */
+  value: bigint
+  /** First four bytes of the calldata */
+  functionSelector: Hex
+  /** Calldata of the transaction */
+  callData: Hex
+}
+
+/** Validator registered in a relay which proposes in an upcoming slot. */
+export type ValidatorDuty = {
+  /** Slot of the proposal */
+  slot: bigint
+  /** Index of the validator */
+  validatorIndex: bigint
+  /** Public key of the validator */
+  pubkey: Hex
+  /** Fee recipient registered by the validator */
+  feeRecipient: Address
+  /** Gas limit registered by the validator */
+  gasLimit: bigint
+  /** Timestamp of the registration */
+  timestamp: bigint
+}
+
+/** A withdrawal from the beacon chain. */
+export type Withdrawal = {
+  /** Index of the withdrawal */
+  index: bigint
+  /** ID of the validator */
+  validator: bigint
+  /** Address to withdraw to */
+  Address: Address
+  /** Amount to be withdrawn */
+  amount: bigint
+}
+
+/** The key is not set in the context. */
+export type ContextValueNotFoundError = {
+  /** Key of the value */
+  key: string
+}
+
+/** The domain of the request is not allowed by the kettle. */
+export type DomainNotAllowedError = {
+  /** Domain of the request */
+  domain: string
+}
+
+/** The HTTP request returned an unsuccessful status code. */
+export type HttpRequestFailedError = {
+  /** Status code of the response */
+  status: bigint
+  /** Body of the response */
+  body: Hex
+}
+
+/** ABI of the Suave library, its functions are the precompiles. */
+export const suaveLibAbi = [
+  {
+    "type": "error",
+    "name": "PeekerReverted",
+    "inputs": [
+      {
+        "name": "addr",
+        "type": "address"
+      },
+      {
+        "name": "err",
+        "type": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "error",
+    "name": "ContextValueNotFound",
+    "inputs": [
+      {
+        "name": "key",
+        "type": "string",
+        "internalType": "string"
+      }
+    ]
+  },
+  {
+    "type": "error",
+    "name": "DomainNotAllowed",
+    "inputs": [
+      {
+        "name": "domain",
+        "type": "string",
+        "internalType": "string"
+      }
+    ]
+  },
+  {
+    "type": "error",
+    "name": "HttpRequestFailed",
+    "inputs": [
+      {
+        "name": "status",
+        "type": "uint64",
+        "internalType": "uint64"
+      },
+      {
+        "name": "body",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "aesDecrypt",
+    "inputs": [
+      {
+        "name": "key",
+        "type": "bytes",
+        "internalType": "bytes"
+      },
+      {
+        "name": "ciphertext",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "message",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "aesEncrypt",
+    "inputs": [
+      {
+        "name": "key",
+        "type": "bytes",
+        "internalType": "bytes"
+      },
+      {
+        "name": "message",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "ciphertext",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "buildEthBlock",
+    "inputs": [
+      {
+        "name": "blockArgs",
+        "type": "tuple",
+        "internalType": "struct Suave.BuildBlockArgs",
+        "components": [
+          {
+            "name": "slot",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "proposerPubkey",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "parent",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "timestamp",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "feeRecipient",
+            "type": "address",
+            "internalType": "address"
+          },
+          {
+            "name": "gasLimit",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "random",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "withdrawals",
+            "type": "tuple[]",
+            "internalType": "struct Suave.Withdrawal[]",
+            "components": [
+              {
+                "name": "index",
+                "type": "uint64",
+                "internalType": "uint64"
+              },
+              {
+                "name": "validator",
+                "type": "uint64",
+                "internalType": "uint64"
+              },
+              {
+                "name": "Address",
+                "type": "address",
+                "internalType": "address"
+              },
+              {
+                "name": "amount",
+                "type": "uint64",
+                "internalType": "uint64"
+              }
+            ]
+          },
+          {
+            "name": "extra",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "beaconRoot",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "fillPending",
+            "type": "bool",
+            "internalType": "bool"
+          },
+          {
+            "name": "algorithm",
+            "type": "string",
+            "internalType": "string"
+          },
+          {
+            "name": "fillPendingTimeout",
+            "type": "uint64",
+            "internalType": "uint64"
+          }
+        ]
+      },
+      {
+        "name": "dataId",
+        "type": "bytes16",
+        "internalType": "struct Suave.DataId"
+      },
+      {
+        "name": "relayUrl",
+        "type": "string",
+        "internalType": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "blockBid",
+        "type": "bytes",
+        "internalType": "bytes"
+      },
+      {
+        "name": "executionPayload",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "buildEthBlockTo",
+    "inputs": [
+      {
+        "name": "executionNodeURL",
+        "type": "string",
+        "internalType": "string"
+      },
+      {
+        "name": "blockArgs",
+        "type": "tuple",
+        "internalType": "struct Suave.BuildBlockArgs",
+        "components": [
+          {
+            "name": "slot",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "proposerPubkey",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "parent",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "timestamp",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "feeRecipient",
+            "type": "address",
+            "internalType": "address"
+          },
+          {
+            "name": "gasLimit",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "random",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "withdrawals",
+            "type": "tuple[]",
+            "internalType": "struct Suave.Withdrawal[]",
+            "components": [
+              {
+                "name": "index",
+                "type": "uint64",
+                "internalType": "uint64"
+              },
+              {
+                "name": "validator",
+                "type": "uint64",
+                "internalType": "uint64"
+              },
+              {
+                "name": "Address",
+                "type": "address",
+                "internalType": "address"
+              },
+              {
+                "name": "amount",
+                "type": "uint64",
+                "internalType": "uint64"
+              }
+            ]
+          },
+          {
+            "name": "extra",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "beaconRoot",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "fillPending",
+            "type": "bool",
+            "internalType": "bool"
+          },
+          {
+            "name": "algorithm",
+            "type": "string",
+            "internalType": "string"
+          },
+          {
+            "name": "fillPendingTimeout",
+            "type": "uint64",
+            "internalType": "uint64"
+          }
+        ]
+      },
+      {
+        "name": "dataId",
+        "type": "bytes16",
+        "internalType": "struct Suave.DataId"
+      },
+      {
+        "name": "relayUrl",
+        "type": "string",
+        "internalType": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "blockBid",
+        "type": "bytes",
+        "internalType": "bytes"
+      },
+      {
+        "name": "executionPayload",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "confidentialInputs",
+    "outputs": [
+      {
+        "name": "confindentialData",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "confidentialRetrieve",
+    "inputs": [
+      {
+        "name": "dataId",
+        "type": "bytes16",
+        "internalType": "struct Suave.DataId"
+      },
+      {
+        "name": "key",
+        "type": "string",
+        "internalType": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "value",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "confidentialStore",
+    "inputs": [
+      {
+        "name": "dataId",
+        "type": "bytes16",
+        "internalType": "struct Suave.DataId"
+      },
+      {
+        "name": "key",
+        "type": "string",
+        "internalType": "string"
+      },
+      {
+        "name": "value",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "contextGet",
+    "inputs": [
+      {
+        "name": "key",
+        "type": "string",
+        "internalType": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "value",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "doHTTPRequest",
+    "inputs": [
+      {
+        "name": "request",
+        "type": "tuple",
+        "internalType": "struct Suave.HttpRequest",
+        "components": [
+          {
+            "name": "url",
+            "type": "string",
+            "internalType": "string"
+          },
+          {
+            "name": "method",
+            "type": "string",
+            "internalType": "string"
+          },
+          {
+            "name": "headers",
+            "type": "string[]",
+            "internalType": "string[]"
+          },
+          {
+            "name": "body",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "withFlashbotsSignature",
+            "type": "bool",
+            "internalType": "bool"
+          },
+          {
+            "name": "timeout",
+            "type": "uint64",
+            "internalType": "uint64"
+          }
+        ]
+      }
+    ],
+    "outputs": [
+      {
+        "name": "httpResponse",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "doHTTPRequest2",
+    "inputs": [
+      {
+        "name": "request",
+        "type": "tuple",
+        "internalType": "struct Suave.HttpRequest",
+        "components": [
+          {
+            "name": "url",
+            "type": "string",
+            "internalType": "string"
+          },
+          {
+            "name": "method",
+            "type": "string",
+            "internalType": "string"
+          },
+          {
+            "name": "headers",
+            "type": "string[]",
+            "internalType": "string[]"
+          },
+          {
+            "name": "body",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "withFlashbotsSignature",
+            "type": "bool",
+            "internalType": "bool"
+          },
+          {
+            "name": "timeout",
+            "type": "uint64",
+            "internalType": "uint64"
+          }
+        ]
+      }
+    ],
+    "outputs": [
+      {
+        "name": "httpResponse",
+        "type": "tuple",
+        "internalType": "struct Suave.HttpResponse",
+        "components": [
+          {
+            "name": "status",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "body",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "error",
+            "type": "bytes",
+            "internalType": "bytes"
+          }
+        ]
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "ethcall",
+    "inputs": [
+      {
+        "name": "contractAddr",
+        "type": "address",
+        "internalType": "address"
+      },
+      {
+        "name": "input1",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "callOutput",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "extractHint",
+    "inputs": [
+      {
+        "name": "bundleData",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "hints",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "extractHints",
+    "inputs": [
+      {
+        "name": "bundleData",
+        "type": "bytes",
+        "internalType": "bytes"
+      },
+      {
+        "name": "policy",
+        "type": "uint64",
+        "internalType": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "hint",
+        "type": "tuple",
+        "internalType": "struct Suave.BundleHint",
+        "components": [
+          {
+            "name": "txs",
+            "type": "tuple[]",
+            "internalType": "struct Suave.TxHint[]",
+            "components": [
+              {
+                "name": "hash",
+                "type": "bytes32",
+                "internalType": "bytes32"
+              },
+              {
+                "name": "to",
+                "type": "address",
+                "internalType": "address"
+              },
+              {
+                "name": "value",
+                "type": "uint256",
+                "internalType": "uint256"
+              },
+              {
+                "name": "functionSelector",
+                "type": "bytes4",
+                "internalType": "bytes4"
+              },
+              {
+                "name": "callData",
+                "type": "bytes",
+                "internalType": "bytes"
+              }
+            ]
+          },
+          {
+            "name": "logs",
+            "type": "tuple[]",
+            "internalType": "struct Suave.SimulatedLog[]",
+            "components": [
+              {
+                "name": "data",
+                "type": "bytes",
+                "internalType": "bytes"
+              },
+              {
+                "name": "addr",
+                "type": "address",
+                "internalType": "address"
+              },
+              {
+                "name": "topics",
+                "type": "bytes32[]",
+                "internalType": "bytes32[]"
+              }
+            ]
+          }
+        ]
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "fetchDataRecords",
+    "inputs": [
+      {
+        "name": "cond",
+        "type": "uint64",
+        "internalType": "uint64"
+      },
+      {
+        "name": "namespace",
+        "type": "string",
+        "internalType": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "dataRecords",
+        "type": "tuple[]",
+        "internalType": "struct Suave.DataRecord[]",
+        "components": [
+          {
+            "name": "id",
+            "type": "bytes16",
+            "internalType": "struct Suave.DataId"
+          },
+          {
+            "name": "salt",
+            "type": "bytes16",
+            "internalType": "struct Suave.DataId"
+          },
+          {
+            "name": "decryptionCondition",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "allowedPeekers",
+            "type": "address[]",
+            "internalType": "address[]"
+          },
+          {
+            "name": "allowedStores",
+            "type": "address[]",
+            "internalType": "address[]"
+          },
+          {
+            "name": "version",
+            "type": "string",
+            "internalType": "string"
+          }
+        ]
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "fillMevShareBundle",
+    "inputs": [
+      {
+        "name": "dataId",
+        "type": "bytes16",
+        "internalType": "struct Suave.DataId"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "encodedBundle",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "getBalance",
+    "inputs": [
+      {
+        "name": "account",
+        "type": "address",
+        "internalType": "address"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "balance",
+        "type": "uint256",
+        "internalType": "uint256"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "getBeaconContext",
+    "inputs": [
+      {
+        "name": "slot",
+        "type": "uint64",
+        "internalType": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "beaconContext",
+        "type": "tuple",
+        "internalType": "struct Suave.BeaconContext",
+        "components": [
+          {
+            "name": "slot",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "timestamp",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "proposerIndex",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "proposerPubkey",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "random",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "withdrawals",
+            "type": "tuple[]",
+            "internalType": "struct Suave.Withdrawal[]",
+            "components": [
+              {
+                "name": "index",
+                "type": "uint64",
+                "internalType": "uint64"
+              },
+              {
+                "name": "validator",
+                "type": "uint64",
+                "internalType": "uint64"
+              },
+              {
+                "name": "Address",
+                "type": "address",
+                "internalType": "address"
+              },
+              {
+                "name": "amount",
+                "type": "uint64",
+                "internalType": "uint64"
+              }
+            ]
+          },
+          {
+            "name": "beaconRoot",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "parentHash",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          }
+        ]
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "getBlockHeader",
+    "inputs": [
+      {
+        "name": "number",
+        "type": "uint64",
+        "internalType": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "header",
+        "type": "tuple",
+        "internalType": "struct Suave.EthBlockHeader",
+        "components": [
+          {
+            "name": "hash",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "parentHash",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "number",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "timestamp",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "coinbase",
+            "type": "address",
+            "internalType": "address"
+          },
+          {
+            "name": "stateRoot",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "receiptsRoot",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "gasLimit",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "gasUsed",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "baseFee",
+            "type": "uint256",
+            "internalType": "uint256"
+          },
+          {
+            "name": "prevRandao",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "extraData",
+            "type": "bytes",
+            "internalType": "bytes"
+          }
+        ]
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "getCode",
+    "inputs": [
+      {
+        "name": "account",
+        "type": "address",
+        "internalType": "address"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "code",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "getInsecureTime",
+    "outputs": [
+      {
+        "name": "time",
+        "type": "uint256",
+        "internalType": "uint256"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "getNonce",
+    "inputs": [
+      {
+        "name": "account",
+        "type": "address",
+        "internalType": "address"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "nonce",
+        "type": "uint64",
+        "internalType": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "getRelayBidTraces",
+    "inputs": [
+      {
+        "name": "relayUrl",
+        "type": "string",
+        "internalType": "string"
+      },
+      {
+        "name": "slot",
+        "type": "uint64",
+        "internalType": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "bids",
+        "type": "tuple[]",
+        "internalType": "struct Suave.RelayBidTrace[]",
+        "components": [
+          {
+            "name": "slot",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "parentHash",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "blockHash",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "builderPubkey",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "proposerPubkey",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "proposerFeeRecipient",
+            "type": "address",
+            "internalType": "address"
+          },
+          {
+            "name": "gasLimit",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "gasUsed",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "value",
+            "type": "uint256",
+            "internalType": "uint256"
+          },
+          {
+            "name": "blockNumber",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "numTx",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "timestampMs",
+            "type": "uint64",
+            "internalType": "uint64"
+          }
+        ]
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "getRelayDeliveredPayloads",
+    "inputs": [
+      {
+        "name": "relayUrl",
+        "type": "string",
+        "internalType": "string"
+      },
+      {
+        "name": "slot",
+        "type": "uint64",
+        "internalType": "uint64"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "payloads",
+        "type": "tuple[]",
+        "internalType": "struct Suave.RelayBidTrace[]",
+        "components": [
+          {
+            "name": "slot",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "parentHash",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "blockHash",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "builderPubkey",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "proposerPubkey",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "proposerFeeRecipient",
+            "type": "address",
+            "internalType": "address"
+          },
+          {
+            "name": "gasLimit",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "gasUsed",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "value",
+            "type": "uint256",
+            "internalType": "uint256"
+          },
+          {
+            "name": "blockNumber",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "numTx",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "timestampMs",
+            "type": "uint64",
+            "internalType": "uint64"
+          }
+        ]
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "getRelayValidators",
+    "inputs": [
+      {
+        "name": "relayUrl",
+        "type": "string",
+        "internalType": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "duties",
+        "type": "tuple[]",
+        "internalType": "struct Suave.ValidatorDuty[]",
+        "components": [
+          {
+            "name": "slot",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "validatorIndex",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "pubkey",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "feeRecipient",
+            "type": "address",
+            "internalType": "address"
+          },
+          {
+            "name": "gasLimit",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "timestamp",
+            "type": "uint64",
+            "internalType": "uint64"
+          }
+        ]
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "getStorageAt",
+    "inputs": [
+      {
+        "name": "account",
+        "type": "address",
+        "internalType": "address"
+      },
+      {
+        "name": "slot",
+        "type": "bytes32",
+        "internalType": "bytes32"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "value",
+        "type": "bytes32",
+        "internalType": "bytes32"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "getTransactionReceipt",
+    "inputs": [
+      {
+        "name": "txHash",
+        "type": "bytes32",
+        "internalType": "bytes32"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "receipt",
+        "type": "tuple",
+        "internalType": "struct Suave.EthReceipt",
+        "components": [
+          {
+            "name": "txHash",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "blockHash",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "blockNumber",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "transactionIndex",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "status",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "gasUsed",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "cumulativeGasUsed",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "effectiveGasPrice",
+            "type": "uint256",
+            "internalType": "uint256"
+          },
+          {
+            "name": "contractAddress",
+            "type": "address",
+            "internalType": "address"
+          },
+          {
+            "name": "logs",
+            "type": "tuple[]",
+            "internalType": "struct Suave.SimulatedLog[]",
+            "components": [
+              {
+                "name": "data",
+                "type": "bytes",
+                "internalType": "bytes"
+              },
+              {
+                "name": "addr",
+                "type": "address",
+                "internalType": "address"
+              },
+              {
+                "name": "topics",
+                "type": "bytes32[]",
+                "internalType": "bytes32[]"
+              }
+            ]
+          }
+        ]
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "newBuilder",
+    "outputs": [
+      {
+        "name": "sessionid",
+        "type": "string",
+        "internalType": "string"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "newDataRecord",
+    "inputs": [
+      {
+        "name": "decryptionCondition",
+        "type": "uint64",
+        "internalType": "uint64"
+      },
+      {
+        "name": "allowedPeekers",
+        "type": "address[]",
+        "internalType": "address[]"
+      },
+      {
+        "name": "allowedStores",
+        "type": "address[]",
+        "internalType": "address[]"
+      },
+      {
+        "name": "dataType",
+        "type": "string",
+        "internalType": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "dataRecord",
+        "type": "tuple",
+        "internalType": "struct Suave.DataRecord",
+        "components": [
+          {
+            "name": "id",
+            "type": "bytes16",
+            "internalType": "struct Suave.DataId"
+          },
+          {
+            "name": "salt",
+            "type": "bytes16",
+            "internalType": "struct Suave.DataId"
+          },
+          {
+            "name": "decryptionCondition",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "allowedPeekers",
+            "type": "address[]",
+            "internalType": "address[]"
+          },
+          {
+            "name": "allowedStores",
+            "type": "address[]",
+            "internalType": "address[]"
+          },
+          {
+            "name": "version",
+            "type": "string",
+            "internalType": "string"
+          }
+        ]
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "privateKeyGen",
+    "inputs": [
+      {
+        "name": "crypto",
+        "type": "uint8",
+        "internalType": "struct Suave.CryptoSignature"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "privateKey",
+        "type": "string",
+        "internalType": "string"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "randomBytes",
+    "inputs": [
+      {
+        "name": "numBytes",
+        "type": "uint8",
+        "internalType": "uint8"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "value",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "signEthTransaction",
+    "inputs": [
+      {
+        "name": "txn",
+        "type": "bytes",
+        "internalType": "bytes"
+      },
+      {
+        "name": "chainId",
+        "type": "string",
+        "internalType": "string"
+      },
+      {
+        "name": "signingKey",
+        "type": "string",
+        "internalType": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "signedTxn",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "signEthTypedTransaction",
+    "inputs": [
+      {
+        "name": "txn",
+        "type": "tuple",
+        "internalType": "struct Suave.EthTransaction",
+        "components": [
+          {
+            "name": "txType",
+            "type": "uint8",
+            "internalType": "uint8"
+          },
+          {
+            "name": "chainId",
+            "type": "uint256",
+            "internalType": "uint256"
+          },
+          {
+            "name": "nonce",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "gasPrice",
+            "type": "uint256",
+            "internalType": "uint256"
+          },
+          {
+            "name": "gasTipCap",
+            "type": "uint256",
+            "internalType": "uint256"
+          },
+          {
+            "name": "gasFeeCap",
+            "type": "uint256",
+            "internalType": "uint256"
+          },
+          {
+            "name": "gas",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "to",
+            "type": "address",
+            "internalType": "address"
+          },
+          {
+            "name": "value",
+            "type": "uint256",
+            "internalType": "uint256"
+          },
+          {
+            "name": "data",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "accessList",
+            "type": "tuple[]",
+            "internalType": "struct Suave.AccessListEntry[]",
+            "components": [
+              {
+                "name": "addr",
+                "type": "address",
+                "internalType": "address"
+              },
+              {
+                "name": "storageKeys",
+                "type": "bytes32[]",
+                "internalType": "bytes32[]"
+              }
+            ]
+          },
+          {
+            "name": "blobFeeCap",
+            "type": "uint256",
+            "internalType": "uint256"
+          },
+          {
+            "name": "blobHashes",
+            "type": "bytes32[]",
+            "internalType": "bytes32[]"
+          }
+        ]
+      },
+      {
+        "name": "signingKey",
+        "type": "string",
+        "internalType": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "signedTxn",
+        "type": "bytes",
+        "internalType": "bytes"
+      },
+      {
+        "name": "txHash",
+        "type": "bytes32",
+        "internalType": "bytes32"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "signMessage",
+    "inputs": [
+      {
+        "name": "digest",
+        "type": "bytes",
+        "internalType": "bytes"
+      },
+      {
+        "name": "crypto",
+        "type": "uint8",
+        "internalType": "struct Suave.CryptoSignature"
+      },
+      {
+        "name": "signingKey",
+        "type": "string",
+        "internalType": "string"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "signature",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "simulateBundle",
+    "inputs": [
+      {
+        "name": "bundleData",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "effectiveGasPrice",
+        "type": "uint64",
+        "internalType": "uint64"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "simulateBundleWithArgs",
+    "inputs": [
+      {
+        "name": "blockArgs",
+        "type": "tuple",
+        "internalType": "struct Suave.BuildBlockArgs",
+        "components": [
+          {
+            "name": "slot",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "proposerPubkey",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "parent",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "timestamp",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "feeRecipient",
+            "type": "address",
+            "internalType": "address"
+          },
+          {
+            "name": "gasLimit",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "random",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "withdrawals",
+            "type": "tuple[]",
+            "internalType": "struct Suave.Withdrawal[]",
+            "components": [
+              {
+                "name": "index",
+                "type": "uint64",
+                "internalType": "uint64"
+              },
+              {
+                "name": "validator",
+                "type": "uint64",
+                "internalType": "uint64"
+              },
+              {
+                "name": "Address",
+                "type": "address",
+                "internalType": "address"
+              },
+              {
+                "name": "amount",
+                "type": "uint64",
+                "internalType": "uint64"
+              }
+            ]
+          },
+          {
+            "name": "extra",
+            "type": "bytes",
+            "internalType": "bytes"
+          },
+          {
+            "name": "beaconRoot",
+            "type": "bytes32",
+            "internalType": "bytes32"
+          },
+          {
+            "name": "fillPending",
+            "type": "bool",
+            "internalType": "bool"
+          },
+          {
+            "name": "algorithm",
+            "type": "string",
+            "internalType": "string"
+          },
+          {
+            "name": "fillPendingTimeout",
+            "type": "uint64",
+            "internalType": "uint64"
+          }
+        ]
+      },
+      {
+        "name": "bundleData",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "simulationResult",
+        "type": "tuple",
+        "internalType": "struct Suave.SimulateBundleResult",
+        "components": [
+          {
+            "name": "success",
+            "type": "bool",
+            "internalType": "bool"
+          },
+          {
+            "name": "error",
+            "type": "string",
+            "internalType": "string"
+          },
+          {
+            "name": "gasUsed",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "coinbaseProfit",
+            "type": "uint256",
+            "internalType": "uint256"
+          },
+          {
+            "name": "effectiveGasPrice",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "logs",
+            "type": "tuple[]",
+            "internalType": "struct Suave.SimulatedLog[]",
+            "components": [
+              {
+                "name": "data",
+                "type": "bytes",
+                "internalType": "bytes"
+              },
+              {
+                "name": "addr",
+                "type": "address",
+                "internalType": "address"
+              },
+              {
+                "name": "topics",
+                "type": "bytes32[]",
+                "internalType": "bytes32[]"
+              }
+            ]
+          }
+        ]
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "simulateTransaction",
+    "inputs": [
+      {
+        "name": "sessionid",
+        "type": "string",
+        "internalType": "string"
+      },
+      {
+        "name": "txn",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "simulationResult",
+        "type": "tuple",
+        "internalType": "struct Suave.SimulateTransactionResult",
+        "components": [
+          {
+            "name": "egp",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "logs",
+            "type": "tuple[]",
+            "internalType": "struct Suave.SimulatedLog[]",
+            "components": [
+              {
+                "name": "data",
+                "type": "bytes",
+                "internalType": "bytes"
+              },
+              {
+                "name": "addr",
+                "type": "address",
+                "internalType": "address"
+              },
+              {
+                "name": "topics",
+                "type": "bytes32[]",
+                "internalType": "bytes32[]"
+              }
+            ]
+          },
+          {
+            "name": "success",
+            "type": "bool",
+            "internalType": "bool"
+          },
+          {
+            "name": "error",
+            "type": "string",
+            "internalType": "string"
+          },
+          {
+            "name": "gasUsed",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "coinbasePayment",
+            "type": "uint256",
+            "internalType": "uint256"
+          },
+          {
+            "name": "revertReason",
+            "type": "string",
+            "internalType": "string"
+          },
+          {
+            "name": "touchedSlots",
+            "type": "tuple[]",
+            "internalType": "struct Suave.SimulatedStorageAccess[]",
+            "components": [
+              {
+                "name": "addr",
+                "type": "address",
+                "internalType": "address"
+              },
+              {
+                "name": "slots",
+                "type": "bytes32[]",
+                "internalType": "bytes32[]"
+              }
+            ]
+          }
+        ]
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "submitBundleJsonRPC",
+    "inputs": [
+      {
+        "name": "url",
+        "type": "string",
+        "internalType": "string"
+      },
+      {
+        "name": "method",
+        "type": "string",
+        "internalType": "string"
+      },
+      {
+        "name": "params",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "errorMessage",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "submitEthBlockToRelay",
+    "inputs": [
+      {
+        "name": "relayUrl",
+        "type": "string",
+        "internalType": "string"
+      },
+      {
+        "name": "builderBid",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ],
+    "outputs": [
+      {
+        "name": "blockBid",
+        "type": "bytes",
+        "internalType": "bytes"
+      }
+    ]
+  },
+  {
+    "type": "function",
+    "name": "submitEthBlockToRelays",
+    "inputs": [
+      {
+        "name": "relayUrls",
+        "type": "string[]",
+        "internalType": "string[]"
+      },
+      {
+        "name": "builderBid",
+        "type": "bytes",
+        "internalType": "bytes"
+      },
+      {
+        "name": "options",
+        "type": "tuple",
+        "internalType": "struct Suave.RelaySubmissionOptions",
+        "components": [
+          {
+            "name": "ssz",
+            "type": "bool",
+            "internalType": "bool"
+          },
+          {
+            "name": "gzip",
+            "type": "bool",
+            "internalType": "bool"
+          },
+          {
+            "name": "maxRetries",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "deadline",
+            "type": "uint64",
+            "internalType": "uint64"
+          }
+        ]
+      }
+    ],
+    "outputs": [
+      {
+        "name": "results",
+        "type": "tuple[]",
+        "internalType": "struct Suave.RelaySubmissionResult[]",
+        "components": [
+          {
+            "name": "relayUrl",
+            "type": "string",
+            "internalType": "string"
+          },
+          {
+            "name": "success",
+            "type": "bool",
+            "internalType": "bool"
+          },
+          {
+            "name": "statusCode",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "attempts",
+            "type": "uint64",
+            "internalType": "uint64"
+          },
+          {
+            "name": "error",
+            "type": "string",
+            "internalType": "string"
+          }
+        ]
+      }
+    ]
+  }
+] as const
+
+/** Addresses of the precompiles. */
+export const precompiles = {
+  isConfidential: '0x0000000000000000000000000000000042010000',
+  aesDecrypt: '0x000000000000000000000000000000005670000d',
+  aesEncrypt: '0x000000000000000000000000000000005670000e',
+  buildEthBlock: '0x0000000000000000000000000000000042100001',
+  buildEthBlockTo: '0x0000000000000000000000000000000042100006',
+  confidentialInputs: '0x0000000000000000000000000000000042010001',
+  confidentialRetrieve: '0x0000000000000000000000000000000042020001',
+  confidentialStore: '0x0000000000000000000000000000000042020000',
+  contextGet: '0x0000000000000000000000000000000053300003',
+  doHTTPRequest: '0x0000000000000000000000000000000043200002',
+  doHTTPRequest2: '0x0000000000000000000000000000000043200003',
+  ethcall: '0x0000000000000000000000000000000042100003',
+  extractHint: '0x0000000000000000000000000000000042100037',
+  extractHints: '0x000000000000000000000000000000004210000b',
+  fetchDataRecords: '0x0000000000000000000000000000000042030001',
+  fillMevShareBundle: '0x0000000000000000000000000000000043200001',
+  getBalance: '0x000000000000000000000000000000004210000d',
+  getBeaconContext: '0x000000000000000000000000000000004210000a',
+  getBlockHeader: '0x0000000000000000000000000000000042100010',
+  getCode: '0x000000000000000000000000000000004210000e',
+  getInsecureTime: '0x000000000000000000000000000000007770000c',
+  getNonce: '0x000000000000000000000000000000004210000c',
+  getRelayBidTraces: '0x0000000000000000000000000000000042100008',
+  getRelayDeliveredPayloads: '0x0000000000000000000000000000000042100009',
+  getRelayValidators: '0x0000000000000000000000000000000042100007',
+  getStorageAt: '0x000000000000000000000000000000004210000f',
+  getTransactionReceipt: '0x0000000000000000000000000000000042100011',
+  newBuilder: '0x0000000000000000000000000000000053200001',
+  newDataRecord: '0x0000000000000000000000000000000042030000',
+  privateKeyGen: '0x0000000000000000000000000000000053200003',
+  randomBytes: '0x000000000000000000000000000000007770000b',
+  signEthTransaction: '0x0000000000000000000000000000000040100001',
+  signEthTypedTransaction: '0x0000000000000000000000000000000040100002',
+  signMessage: '0x0000000000000000000000000000000040100003',
+  simulateBundle: '0x0000000000000000000000000000000042100000',
+  simulateBundleWithArgs: '0x0000000000000000000000000000000042100004',
+  simulateTransaction: '0x0000000000000000000000000000000053200002',
+  submitBundleJsonRPC: '0x0000000000000000000000000000000043000001',
+  submitEthBlockToRelay: '0x0000000000000000000000000000000042100002',
+  submitEthBlockToRelays: '0x0000000000000000000000000000000042100005',
+} as const
+
+/**
+ * Encodes the input of the aesDecrypt precompile. Decrypts a message using given bytes as a cipher.
+ * @param key Private key used to decrypt the ciphertext
+ * @param ciphertext Message to decrypt
+ */
+export function encodeAesDecryptInput(key: Hex, ciphertext: Hex): Hex {
+  return encodeAbiParameters(suaveLibAbi[4].inputs, [key, ciphertext])
+}
+
+/**
+ * Decodes the output of the aesDecrypt precompile.
+ * @returns message Decrypted message
+ */
+export function decodeAesDecryptOutput(data: Hex): Hex {
+  return decodeAbiParameters(suaveLibAbi[4].outputs, data)[0] as Hex
+}
+
+/**
+ * Encodes the input of the aesEncrypt precompile. Encrypts a message using given bytes as a cipher.
+ * @param key Private key used to encrypt the message
+ * @param message Message to encrypt
+ */
+export function encodeAesEncryptInput(key: Hex, message: Hex): Hex {
+  return encodeAbiParameters(suaveLibAbi[5].inputs, [key, message])
+}
+
+/**
+ * Decodes the output of the aesEncrypt precompile.
+ * @returns ciphertext Encrypted message
+ */
+export function decodeAesEncryptOutput(data: Hex): Hex {
+  return decodeAbiParameters(suaveLibAbi[5].outputs, data)[0] as Hex
+}
+
+/**
+ * Encodes the input of the buildEthBlock precompile. Constructs an Ethereum block based on the provided data records. No blobs are returned.
+ * @param blockArgs Arguments to build the block
+ * @param dataId ID of the data record with mev-share bundle data
+ * @param relayUrl If specified the built block will be submitted to the relay
+ */
+export function encodeBuildEthBlockInput(blockArgs: BuildBlockArgs, dataId: DataId, relayUrl: string): Hex {
+  return encodeAbiParameters(suaveLibAbi[6].inputs, [blockArgs, dataId, relayUrl])
+}
+
+/**
+ * Decodes the output of the buildEthBlock precompile.
+ * @returns blockBid Block Bid encoded in JSON
+ * @returns executionPayload Execution payload encoded in JSON
+ */
+export function decodeBuildEthBlockOutput(data: Hex): [Hex, Hex] {
+  return decodeAbiParameters(suaveLibAbi[6].outputs, data) as unknown as [Hex, Hex]
+}
+
+/**
+ * Encodes the input of the buildEthBlockTo precompile. Constructs an Ethereum block based on the provided data records. No blobs are returned.
+ * @param executionNodeURL URL (or service name) of the execution node
+ * @param blockArgs Arguments to build the block
+ * @param dataId ID of the data record with mev-share bundle data
+ * @param relayUrl If specified the built block will be submitted to the relay
+ */
+export function encodeBuildEthBlockToInput(executionNodeURL: string, blockArgs: BuildBlockArgs, dataId: DataId, relayUrl: string): Hex {
+  return encodeAbiParameters(suaveLibAbi[7].inputs, [executionNodeURL, blockArgs, dataId, relayUrl])
+}
+
+/**
+ * Decodes the output of the buildEthBlockTo precompile.
+ * @returns blockBid Block Bid encoded in JSON
+ * @returns executionPayload Execution payload encoded in JSON
+ */
+export function decodeBuildEthBlockToOutput(data: Hex): [Hex, Hex] {
+  return decodeAbiParameters(suaveLibAbi[7].outputs, data) as unknown as [Hex, Hex]
+}
+
+/**
+ * Encodes the input of the confidentialInputs precompile. Provides the confidential inputs associated with a confidential computation request. Outputs are in bytes format.
+ */
+export function encodeConfidentialInputsInput(): Hex {
+  return '0x'
+}
+
+/**
+ * Decodes the output of the confidentialInputs precompile.
+ * @returns confindentialData Confidential inputs
+ */
+export function decodeConfidentialInputsOutput(data: Hex): Hex {
+  return data
+}
+
+/**
+ * Encodes the input of the confidentialRetrieve precompile. Retrieves data from the confidential store. Also mandates the caller's presence in the `AllowedPeekers` list.
+ * @param dataId ID of the data record to retrieve
+ * @param key Key slot of the data to retrieve
+ */
+export function encodeConfidentialRetrieveInput(dataId: DataId, key: string): Hex {
+  return encodeAbiParameters(suaveLibAbi[9].inputs, [dataId, key])
+}
+
+/**
+ * Decodes the output of the confidentialRetrieve precompile.
+ * @returns value Value of the data
+ */
+export function decodeConfidentialRetrieveOutput(data: Hex): Hex {
+  return data
+}
+
+/**
+ * Encodes the input of the confidentialStore precompile. Stores data in the confidential store. Requires the caller to be part of the `AllowedPeekers` for the associated data record.
+ * @param dataId ID of the data record to store
+ * @param key Key slot of the data to store
+ * @param value Value of the data to store
+ */
+export function encodeConfidentialStoreInput(dataId: DataId, key: string, value: Hex): Hex {
+  return encodeAbiParameters(suaveLibAbi[10].inputs, [dataId, key, value])
+}
+
+/**
+ * Encodes the input of the contextGet precompile. Retrieves a value from the context
+ * @param key Key of the value to retrieve
+ */
+export function encodeContextGetInput(key: string): Hex {
+  return encodeAbiParameters(suaveLibAbi[11].inputs, [key])
+}
+
+/**
+ * Decodes the output of the contextGet precompile.
+ * @returns value Value of the key
+ */
+export function decodeContextGetOutput(data: Hex): Hex {
+  return decodeAbiParameters(suaveLibAbi[11].outputs, data)[0] as Hex
+}
+
+/**
+ * Encodes the input of the doHTTPRequest precompile. Performs an HTTP request and returns the response. `request` is the request to perform.
+ * @param request Request to perform
+ */
+export function encodeDoHTTPRequestInput(request: HttpRequest): Hex {
+  return encodeAbiParameters(suaveLibAbi[12].inputs, [request])
+}
+
+/**
+ * Decodes the output of the doHTTPRequest precompile.
+ * @returns httpResponse Body of the response
+ */
+export function decodeDoHTTPRequestOutput(data: Hex): Hex {
+  return decodeAbiParameters(suaveLibAbi[12].outputs, data)[0] as Hex
+}
+
+/**
+ * Encodes the input of the doHTTPRequest2 precompile. Performs an HTTP request and returns the response. `request` is the request to perform.
+ * @param request Request to perform
+ */
+export function encodeDoHTTPRequest2Input(request: HttpRequest): Hex {
+  return encodeAbiParameters(suaveLibAbi[13].inputs, [request])
+}
+
+/**
+ * Decodes the output of the doHTTPRequest2 precompile.
+ * @returns httpResponse Response of the request
+ */
+export function decodeDoHTTPRequest2Output(data: Hex): HttpResponse {
+  return decodeAbiParameters(suaveLibAbi[13].outputs, data)[0] as HttpResponse
+}
+
+/**
+ * Encodes the input of the ethcall precompile. Uses the `eth_call` JSON RPC method to let you simulate a function call and return the response.
+ * @param contractAddr Address of the contract to call
+ * @param input1 Data to send to the contract
+ */
+export function encodeEthcallInput(contractAddr: Address, input1: Hex): Hex {
+  return encodeAbiParameters(suaveLibAbi[14].inputs, [contractAddr, input1])
+}
+
+/**
+ * Decodes the output of the ethcall precompile.
+ * @returns callOutput Output of the contract call
+ */
+export function decodeEthcallOutput(data: Hex): Hex {
+  return decodeAbiParameters(suaveLibAbi[14].outputs, data)[0] as Hex
+}
+
+/**
+ * Encodes the input of the extractHint precompile. Interprets the bundle data and extracts the `To` address and calldata of its first transaction. Use `extractHints` to select the hints.
+ * @param bundleData Bundle object encoded in JSON
+ * @deprecated Use extractHints, which selects the hints with a policy.
+ */
+export function encodeExtractHintInput(bundleData: Hex): Hex {
+  return encodeAbiParameters(suaveLibAbi[15].inputs, [bundleData])
+}
+
+/**
+ * Decodes the output of the extractHint precompile.
+ * @returns hints List of hints encoded in JSON
+ */
+export function decodeExtractHintOutput(data: Hex): Hex {
+  return data
+}
+
+/**
+ * Encodes the input of the extractHints precompile. Extracts the hints of the bundle selected by the policy. The policy is a bitmask of: 1 contract address, 2 function selector, 4 calldata, 8 logs of the simulation on top of the latest block, 16 transaction hash, 32 value.
+ * @param bundleData Bundle object encoded in JSON
+ * @param policy Bitmask of the hints to extract
+ */
+export function encodeExtractHintsInput(bundleData: Hex, policy: bigint): Hex {
+  return encodeAbiParameters(suaveLibAbi[16].inputs, [bundleData, policy])
+}
+
+/**
+ * Decodes the output of the extractHints precompile.
+ * @returns hint Hints of the bundle
+ */
+export function decodeExtractHintsOutput(data: Hex): BundleHint {
+  return decodeAbiParameters(suaveLibAbi[16].outputs, data)[0] as BundleHint
+}
+
+/**
+ * Encodes the input of the fetchDataRecords precompile. Retrieves all data records correlating with a specified decryption condition and namespace
+ * @param cond Filter for the decryption condition
+ * @param namespace Filter for the namespace of the data records
+ */
+export function encodeFetchDataRecordsInput(cond: bigint, namespace: string): Hex {
+  return encodeAbiParameters(suaveLibAbi[17].inputs, [cond, namespace])
+}
+
+/**
+ * Decodes the output of the fetchDataRecords precompile.
+ * @returns dataRecords List of data records that match the filter
+ */
+export function decodeFetchDataRecordsOutput(data: Hex): DataRecord[] {
+  return decodeAbiParameters(suaveLibAbi[17].outputs, data)[0] as DataRecord[]
+}
+
+/**
+ * Encodes the input of the fillMevShareBundle precompile. Joins the user's transaction and with the backrun, and returns encoded mev-share bundle. The bundle is ready to be sent via `SubmitBundleJsonRPC`.
+ * @param dataId ID of the data record with mev-share bundle data
+ */
+export function encodeFillMevShareBundleInput(dataId: DataId): Hex {
+  return encodeAbiParameters(suaveLibAbi[18].inputs, [dataId])
+}
+
+/**
+ * Decodes the output of the fillMevShareBundle precompile.
+ * @returns encodedBundle Mev-Share bundle encoded in JSON
+ */
+export function decodeFillMevShareBundleOutput(data: Hex): Hex {
+  return data
+}
+
+/**
+ * Encodes the input of the getBalance precompile. Returns the balance of an account in the latest state of the execution node.
+ * @param account Address of the account
+ */
+export function encodeGetBalanceInput(account: Address): Hex {
+  return encodeAbiParameters(suaveLibAbi[19].inputs, [account])
+}
+
+/**
+ * Decodes the output of the getBalance precompile.
+ * @returns balance Balance of the account in wei
+ */
+export function decodeGetBalanceOutput(data: Hex): bigint {
+  return decodeAbiParameters(suaveLibAbi[19].outputs, data)[0] as bigint
+}
+
+/**
+ * Encodes the input of the getBeaconContext precompile. Returns the proposer, randao, withdrawals and parent roots of the slot after the beacon head, as followed by the kettle from its beacon node.
+ * @param slot Slot after the beacon head to build the block for
+ */
+export function encodeGetBeaconContextInput(slot: bigint): Hex {
+  return encodeAbiParameters(suaveLibAbi[20].inputs, [slot])
+}
+
+/**
+ * Decodes the output of the getBeaconContext precompile.
+ * @returns beaconContext Beacon chain data of the slot
+ */
+export function decodeGetBeaconContextOutput(data: Hex): BeaconContext {
+  return decodeAbiParameters(suaveLibAbi[20].outputs, data)[0] as BeaconContext
+}
+
+/**
+ * Encodes the input of the getBlockHeader precompile. Returns the header of a block of the execution node.
+ * @param number Number of the block, type(uint64).max for the latest block
+ */
+export function encodeGetBlockHeaderInput(number: bigint): Hex {
+  return encodeAbiParameters(suaveLibAbi[21].inputs, [number])
+}
+
+/**
+ * Decodes the output of the getBlockHeader precompile.
+ * @returns header Header of the block
+ */
+export function decodeGetBlockHeaderOutput(data: Hex): EthBlockHeader {
+  return decodeAbiParameters(suaveLibAbi[21].outputs, data)[0] as EthBlockHeader
+}
+
+/**
+ * Encodes the input of the getCode precompile. Returns the code of an account in the latest state of the execution node.
+ * @param account Address of the account
+ */
+export function encodeGetCodeInput(account: Address): Hex {
+  return encodeAbiParameters(suaveLibAbi[22].inputs, [account])
+}
+
+/**
+ * Decodes the output of the getCode precompile.
+ * @returns code Code of the account, empty if the account is not a contract
+ */
+export function decodeGetCodeOutput(data: Hex): Hex {
+  return decodeAbiParameters(suaveLibAbi[22].outputs, data)[0] as Hex
+}
+
+/**
+ * Encodes the input of the getInsecureTime precompile. Returns the current Kettle Unix time in milliseconds. Insecure because it assumes trust in Kettle's clock.
+ */
+export function encodeGetInsecureTimeInput(): Hex {
+  return '0x'
+}
+
+/**
+ * Decodes the output of the getInsecureTime precompile.
+ * @returns time Current Unix time in milliseconds
+ */
+export function decodeGetInsecureTimeOutput(data: Hex): bigint {
+  return decodeAbiParameters(suaveLibAbi[23].outputs, data)[0] as bigint
+}
+
+/**
+ * Encodes the input of the getNonce precompile. Returns the pending nonce of an account in the execution node, counting its transactions in the pool.
+ * @param account Address of the account
+ */
+export function encodeGetNonceInput(account: Address): Hex {
+  return encodeAbiParameters(suaveLibAbi[24].inputs, [account])
+}
+
+/**
+ * Decodes the output of the getNonce precompile.
+ * @returns nonce Nonce of the account
+ */
+export function decodeGetNonceOutput(data: Hex): bigint {
+  return decodeAbiParameters(suaveLibAbi[24].outputs, data)[0] as bigint
+}
+
+/**
+ * Encodes the input of the getRelayBidTraces precompile. Returns the bids received by a relay for a slot sorted by descending value, the first one being the top bid.
+ * @param relayUrl URL (or service name) of the relay
+ * @param slot Slot of the bids
+ */
+export function encodeGetRelayBidTracesInput(relayUrl: string, slot: bigint): Hex {
+  return encodeAbiParameters(suaveLibAbi[25].inputs, [relayUrl, slot])
+}
+
+/**
+ * Decodes the output of the getRelayBidTraces precompile.
+ * @returns bids Bids received for the slot
+ */
+export function decodeGetRelayBidTracesOutput(data: Hex): RelayBidTrace[] {
+  return decodeAbiParameters(suaveLibAbi[25].outputs, data)[0] as RelayBidTrace[]
+}
+
+/**
+ * Encodes the input of the getRelayDeliveredPayloads precompile. Returns the payloads delivered by a relay to the proposer of a slot.
+ * @param relayUrl URL (or service name) of the relay
+ * @param slot Slot of the payloads
+ */
+export function encodeGetRelayDeliveredPayloadsInput(relayUrl: string, slot: bigint): Hex {
+  return encodeAbiParameters(suaveLibAbi[26].inputs, [relayUrl, slot])
+}
+
+/**
+ * Decodes the output of the getRelayDeliveredPayloads precompile.
+ * @returns payloads Payloads delivered for the slot
+ */
+export function decodeGetRelayDeliveredPayloadsOutput(data: Hex): RelayBidTrace[] {
+  return decodeAbiParameters(suaveLibAbi[26].outputs, data)[0] as RelayBidTrace[]
+}
+
+/**
+ * Encodes the input of the getRelayValidators precompile. Returns the proposer duties of the current and next epoch registered in a relay.
+ * @param relayUrl URL (or service name) of the relay
+ */
+export function encodeGetRelayValidatorsInput(relayUrl: string): Hex {
+  return encodeAbiParameters(suaveLibAbi[27].inputs, [relayUrl])
+}
+
+/**
+ * Decodes the output of the getRelayValidators precompile.
+ * @returns duties Duties of the validators registered in the relay
+ */
+export function decodeGetRelayValidatorsOutput(data: Hex): ValidatorDuty[] {
+  return decodeAbiParameters(suaveLibAbi[27].outputs, data)[0] as ValidatorDuty[]
+}
+
+/**
+ * Encodes the input of the getStorageAt precompile. Returns the value of a storage slot of an account in the latest state of the execution node.
+ * @param account Address of the account
+ * @param slot Storage slot to read
+ */
+export function encodeGetStorageAtInput(account: Address, slot: Hex): Hex {
+  return encodeAbiParameters(suaveLibAbi[28].inputs, [account, slot])
+}
+
+/**
+ * Decodes the output of the getStorageAt precompile.
+ * @returns value Value of the storage slot
+ */
+export function decodeGetStorageAtOutput(data: Hex): Hex {
+  return decodeAbiParameters(suaveLibAbi[28].outputs, data)[0] as Hex
+}
+
+/**
+ * Encodes the input of the getTransactionReceipt precompile. Returns the receipt of a transaction included by the execution node. Reverts if the transaction is not included.
+ * @param txHash Hash of the transaction
+ */
+export function encodeGetTransactionReceiptInput(txHash: Hex): Hex {
+  return encodeAbiParameters(suaveLibAbi[29].inputs, [txHash])
+}
+
+/**
+ * Decodes the output of the getTransactionReceipt precompile.
+ * @returns receipt Receipt of the transaction
+ */
+export function decodeGetTransactionReceiptOutput(data: Hex): EthReceipt {
+  return decodeAbiParameters(suaveLibAbi[29].outputs, data)[0] as EthReceipt
+}
+
+/**
+ * Encodes the input of the newBuilder precompile. Initializes a new remote builder session
+ */
+export function encodeNewBuilderInput(): Hex {
+  return '0x'
+}
+
+/**
+ * Decodes the output of the newBuilder precompile.
+ * @returns sessionid ID of the remote builder session
+ */
+export function decodeNewBuilderOutput(data: Hex): string {
+  return decodeAbiParameters(suaveLibAbi[30].outputs, data)[0] as string
+}
+
+/**
+ * Encodes the input of the newDataRecord precompile. Initializes data records within the ConfidentialStore. Prior to storing data, all data records should undergo initialization via this precompile.
+ * @param decryptionCondition Up to which block this data record is valid. Used during `fillMevShareBundle` precompie.
+ * @param allowedPeekers Addresses which can get data
+ * @param allowedStores Addresses can set data
+ * @param dataType Namespace of the data
+ */
+export function encodeNewDataRecordInput(decryptionCondition: bigint, allowedPeekers: Address[], allowedStores: Address[], dataType: string): Hex {
+  return encodeAbiParameters(suaveLibAbi[31].inputs, [decryptionCondition, allowedPeekers, allowedStores, dataType])
+}
+
+/**
+ * Decodes the output of the newDataRecord precompile.
+ * @returns dataRecord Data record that was created
+ */
+export function decodeNewDataRecordOutput(data: Hex): DataRecord {
+  return decodeAbiParameters(suaveLibAbi[31].outputs, data)[0] as DataRecord
+}
+
+/**
+ * Encodes the input of the privateKeyGen precompile. Generates a private key in ECDA secp256k1 format
+ * @param crypto Type of the private key to generate
+ */
+export function encodePrivateKeyGenInput(crypto: CryptoSignature): Hex {
+  return encodeAbiParameters(suaveLibAbi[32].inputs, [crypto])
+}
+
+/**
+ * Decodes the output of the privateKeyGen precompile.
+ * @returns privateKey Hex encoded string of the ECDSA private key. Exactly as a signMessage precompile wants.
+ */
+export function decodePrivateKeyGenOutput(data: Hex): string {
+  return decodeAbiParameters(suaveLibAbi[32].outputs, data)[0] as string
+}
+
+/**
+ * Encodes the input of the randomBytes precompile. Generates a number of random bytes, given by the argument numBytes.
+ * @param numBytes Number of random bytes to generate
+ */
+export function encodeRandomBytesInput(numBytes: number): Hex {
+  return encodeAbiParameters(suaveLibAbi[33].inputs, [numBytes])
+}
+
+/**
+ * Decodes the output of the randomBytes precompile.
+ * @returns value Randomly-generated bytes
+ */
+export function decodeRandomBytesOutput(data: Hex): Hex {
+  return decodeAbiParameters(suaveLibAbi[33].outputs, data)[0] as Hex
+}
+
+/**
+ * Encodes the input of the signEthTransaction precompile. Signs an Ethereum Transaction, 1559 or Legacy, and returns raw signed transaction bytes. `txn` is binary encoding of the transaction.
+ * @param txn Transaction to sign (RLP encoded)
+ * @param chainId Id of the chain to sign for (hex encoded, with 0x prefix)
+ * @param signingKey Hex encoded string of the ECDSA private key (without 0x prefix)
+ */
+export function encodeSignEthTransactionInput(txn: Hex, chainId: string, signingKey: string): Hex {
+  return encodeAbiParameters(suaveLibAbi[34].inputs, [txn, chainId, signingKey])
+}
+
+/**
+ * Decodes the output of the signEthTransaction precompile.
+ * @returns signedTxn Signed transaction encoded in RLP
+ */
+export function decodeSignEthTransactionOutput(data: Hex): Hex {
+  return decodeAbiParameters(suaveLibAbi[34].outputs, data)[0] as Hex
+}
+
+/**
+ * Encodes the input of the signEthTypedTransaction precompile. Builds a transaction of any type from its fields, signs it for the chain of the transaction and returns the raw signed transaction bytes and its hash.
+ * @param txn Transaction to sign
+ * @param signingKey Hex encoded string of the ECDSA private key (without 0x prefix)
+ */
+export function encodeSignEthTypedTransactionInput(txn: EthTransaction, signingKey: string): Hex {
+  return encodeAbiParameters(suaveLibAbi[35].inputs, [txn, signingKey])
+}
+
+/**
+ * Decodes the output of the signEthTypedTransaction precompile.
+ * @returns signedTxn Signed transaction in its binary encoding
+ * @returns txHash Hash of the signed transaction
+ */
+export function decodeSignEthTypedTransactionOutput(data: Hex): [Hex, Hex] {
+  return decodeAbiParameters(suaveLibAbi[35].outputs, data) as unknown as [Hex, Hex]
+}
+
+/**
+ * Encodes the input of the signMessage precompile. Signs a message and returns the signature.
+ * @param digest Message to sign
+ * @param crypto Type of the private key to generate
+ * @param signingKey Hex encoded string of the ECDSA private key
+ */
+export function encodeSignMessageInput(digest: Hex, crypto: CryptoSignature, signingKey: string): Hex {
+  return encodeAbiParameters(suaveLibAbi[36].inputs, [digest, crypto, signingKey])
+}
+
+/**
+ * Decodes the output of the signMessage precompile.
+ * @returns signature Signature of the message with the private key
+ */
+export function decodeSignMessageOutput(data: Hex): Hex {
+  return decodeAbiParameters(suaveLibAbi[36].outputs, data)[0] as Hex
+}
+
+/**
+ * Encodes the input of the simulateBundle precompile. Performs a simulation of the bundle on top of the latest block and returns its effective gas price. Reverts if the bundle cannot be included.
+ * @param bundleData Bundle encoded in JSON
+ */
+export function encodeSimulateBundleInput(bundleData: Hex): Hex {
+  return encodeAbiParameters(suaveLibAbi[37].inputs, [bundleData])
+}
+
+/**
+ * Decodes the output of the simulateBundle precompile.
+ * @returns effectiveGasPrice Effective Gas Price of the resultant block
+ */
+export function decodeSimulateBundleOutput(data: Hex): bigint {
+  return decodeAbiParameters(suaveLibAbi[37].outputs, data)[0] as bigint
+}
+
+/**
+ * Encodes the input of the simulateBundleWithArgs precompile. Simulates a bundle on top of a block built with the given arguments. Honors the block range and the reverting hashes of the bundle.
+ * @param blockArgs Arguments of the block to simulate the bundle in
+ * @param bundleData Bundle encoded in JSON
+ */
+export function encodeSimulateBundleWithArgsInput(blockArgs: BuildBlockArgs, bundleData: Hex): Hex {
+  return encodeAbiParameters(suaveLibAbi[38].inputs, [blockArgs, bundleData])
+}
+
+/**
+ * Decodes the output of the simulateBundleWithArgs precompile.
+ * @returns simulationResult Result of the simulation
+ */
+export function decodeSimulateBundleWithArgsOutput(data: Hex): SimulateBundleResult {
+  return decodeAbiParameters(suaveLibAbi[38].outputs, data)[0] as SimulateBundleResult
+}
+
+/**
+ * Encodes the input of the simulateTransaction precompile. Simulates a transaction on a remote builder session
+ * @param sessionid ID of the remote builder session
+ * @param txn Txn to simulate encoded in RLP
+ */
+export function encodeSimulateTransactionInput(sessionid: string, txn: Hex): Hex {
+  return encodeAbiParameters(suaveLibAbi[39].inputs, [sessionid, txn])
+}
+
+/**
+ * Decodes the output of the simulateTransaction precompile.
+ * @returns simulationResult Result of the simulation
+ */
+export function decodeSimulateTransactionOutput(data: Hex): SimulateTransactionResult {
+  return decodeAbiParameters(suaveLibAbi[39].outputs, data)[0] as SimulateTransactionResult
+}
+
+/**
+ * Encodes the input of the submitBundleJsonRPC precompile. Submits bytes as JSONRPC message to the specified URL with the specified method. As this call is intended for bundles, it also signs the params and adds `X-Flashbots-Signature` header, as usual with bundles. Regular eth bundles don't need any processing to be sent.
+ * @param url URL to send the request to
+ * @param method JSONRPC method to call
+ * @param params JSONRPC input params encoded in RLP
+ */
+export function encodeSubmitBundleJsonRPCInput(url: string, method: string, params: Hex): Hex {
+  return encodeAbiParameters(suaveLibAbi[40].inputs, [url, method, params])
+}
+
+/**
+ * Decodes the output of the submitBundleJsonRPC precompile.
+ * @returns errorMessage Error message if any
+ */
+export function decodeSubmitBundleJsonRPCOutput(data: Hex): Hex {
+  return data
+}
+
+/**
+ * Encodes the input of the submitEthBlockToRelay precompile. Submits a given builderBid to a mev-boost relay.
+ * @param relayUrl URL of the relay to submit to
+ * @param builderBid Block bid to submit encoded in JSON
+ */
+export function encodeSubmitEthBlockToRelayInput(relayUrl: string, builderBid: Hex): Hex {
+  return encodeAbiParameters(suaveLibAbi[41].inputs, [relayUrl, builderBid])
+}
+
+/**
+ * Decodes the output of the submitEthBlockToRelay precompile.
+ * @returns blockBid Error message if any
+ */
+export function decodeSubmitEthBlockToRelayOutput(data: Hex): Hex {
+  return data
+}
+
+/**
+ * Encodes the input of the submitEthBlockToRelays precompile. Submits a given builderBid to multiple mev-boost relays concurrently, retrying transient failures until the deadline.
+ * @param relayUrls URLs (or service names) of the relays to submit to
+ * @param builderBid Block bid to submit encoded in JSON
+ * @param options Options of the submission
+ */
+export function encodeSubmitEthBlockToRelaysInput(relayUrls: string[], builderBid: Hex, options: RelaySubmissionOptions): Hex {
+  return encodeAbiParameters(suaveLibAbi[42].inputs, [relayUrls, builderBid, options])
+}
+
+/**
+ * Decodes the output of the submitEthBlockToRelays precompile.
+ * @returns results Result of the submission to each relay, in the order of the relays
+ */
+export function decodeSubmitEthBlockToRelaysOutput(data: Hex): RelaySubmissionResult[] {
+  return decodeAbiParameters(suaveLibAbi[42].outputs, data)[0] as RelaySubmissionResult[]
+}
+
+/** Typed error a precompile reverts with. */
+export type SuaveError =
+  | { errorName: 'ContextValueNotFound'; args: ContextValueNotFoundError }
+  | { errorName: 'DomainNotAllowed'; args: DomainNotAllowedError }
+  | { errorName: 'HttpRequestFailed'; args: HttpRequestFailedError }
+
+/** Decodes a typed error of the precompiles, or returns undefined if the data does not carry one. */
+export function decodeSuaveError(data: Hex): SuaveError | undefined {
+  switch (data.slice(0, 10).toLowerCase()) {
+    case '0xb09312f3': {
+      const [key] = decodeAbiParameters(suaveLibAbi[1].inputs, `0x${data.slice(10)}`)
+      return { errorName: 'ContextValueNotFound', args: { key } }
+    }
+    case '0xd82e9c0b': {
+      const [domain] = decodeAbiParameters(suaveLibAbi[2].inputs, `0x${data.slice(10)}`)
+      return { errorName: 'DomainNotAllowed', args: { domain } }
+    }
+    case '0xe289b1f9': {
+      const [status, body] = decodeAbiParameters(suaveLibAbi[3].inputs, `0x${data.slice(10)}`)
+      return { errorName: 'HttpRequestFailed', args: { status, body } }
+    }
+  }
+  return undefined
+}
+
+/**
+ * Decodes the PeekerReverted(address, bytes) revert data of a precompile into
+ * the address of the precompile and either its typed error or its error message.
+ * Returns undefined if the data is not a PeekerReverted error.
+ */
+export function decodePrecompileRevert(data: Hex): { precompile: Address; error: SuaveError | string } | undefined {
+  if (data.slice(0, 10).toLowerCase() !== '0x75fff467') {
+    return undefined
+  }
+  const [precompile, reason] = decodeAbiParameters(suaveLibAbi[0].inputs, `0x${data.slice(10)}`)
+  return { precompile, error: decodeSuaveError(reason) ?? hexToString(reason) }
+}
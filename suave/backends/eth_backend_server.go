@@ -2,11 +2,14 @@ package backends
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/beacon/dencun"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	suave "github.com/ethereum/go-ethereum/suave/core"
 )
 
@@ -14,7 +17,14 @@ import (
 type EthBackend interface {
 	BuildEthBlock(ctx context.Context, buildArgs *types.BuildBlockArgs, txs types.Transactions) (*dencun.ExecutionPayloadEnvelope, error)
 	BuildEthBlockFromBundles(ctx context.Context, buildArgs *types.BuildBlockArgs, bundles []types.SBundle) (*dencun.ExecutionPayloadEnvelope, error)
+	SimulateBundle(ctx context.Context, buildArgs *types.BuildBlockArgs, bundle *types.SBundle) (*types.SimulateBundleResult, error)
 	Call(ctx context.Context, contractAddr common.Address, input []byte) ([]byte, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	BalanceAt(ctx context.Context, account common.Address) (*big.Int, error)
+	CodeAt(ctx context.Context, account common.Address) ([]byte, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash) (common.Hash, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 }
 
 var _ EthBackend = &EthBackendServer{}
@@ -25,7 +35,13 @@ type EthBackendServerBackend interface {
 	CurrentHeader() *types.Header
 	BuildBlockFromTxs(ctx context.Context, buildArgs *suave.BuildBlockArgs, txs types.Transactions) (*types.Block, *big.Int, error)
 	BuildBlockFromBundles(ctx context.Context, buildArgs *suave.BuildBlockArgs, bundles []types.SBundle) (*types.Block, *big.Int, error)
+	SimulateBundle(ctx context.Context, buildArgs *suave.BuildBlockArgs, bundle *types.SBundle) (*types.SimulateBundleResult, error)
 	Call(ctx context.Context, contractAddr common.Address, input []byte) ([]byte, error)
+	HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error)
+	StateAndHeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*state.StateDB, *types.Header, error)
+	GetTransaction(ctx context.Context, txHash common.Hash) (*types.Transaction, common.Hash, uint64, uint64, error)
+	GetReceipts(ctx context.Context, blockHash common.Hash) (types.Receipts, error)
+	GetPoolNonce(ctx context.Context, addr common.Address) (uint64, error)
 }
 
 type EthBackendServer struct {
@@ -36,19 +52,25 @@ func NewEthBackendServer(b EthBackendServerBackend) *EthBackendServer {
 	return &EthBackendServer{b}
 }
 
+// defaultBuildArgs returns the arguments to build a block on top of the current head
+// when the caller does not provide any.
+func (e *EthBackendServer) defaultBuildArgs() *types.BuildBlockArgs {
+	head := e.b.CurrentHeader()
+	return &types.BuildBlockArgs{
+		Parent:       head.Hash(),
+		Timestamp:    head.Time + uint64(12),
+		FeeRecipient: common.Address{0x42},
+		GasLimit:     30000000,
+		Random:       head.Root,
+		Withdrawals:  nil,
+		Extra:        []byte(""),
+		FillPending:  false,
+	}
+}
+
 func (e *EthBackendServer) BuildEthBlock(ctx context.Context, buildArgs *types.BuildBlockArgs, txs types.Transactions) (*dencun.ExecutionPayloadEnvelope, error) {
 	if buildArgs == nil {
-		head := e.b.CurrentHeader()
-		buildArgs = &types.BuildBlockArgs{
-			Parent:       head.Hash(),
-			Timestamp:    head.Time + uint64(12),
-			FeeRecipient: common.Address{0x42},
-			GasLimit:     30000000,
-			Random:       head.Root,
-			Withdrawals:  nil,
-			Extra:        []byte(""),
-			FillPending:  false,
-		}
+		buildArgs = e.defaultBuildArgs()
 	}
 
 	block, profit, err := e.b.BuildBlockFromTxs(ctx, buildArgs, txs)
@@ -56,22 +78,12 @@ func (e *EthBackendServer) BuildEthBlock(ctx context.Context, buildArgs *types.B
 		return nil, err
 	}
 
-	return dencun.BlockToExecutableData(block, profit, nil), nil
+	return dencun.BlockToExecutableData(block, profit, blobSidecars(block, txs)), nil
 }
 
 func (e *EthBackendServer) BuildEthBlockFromBundles(ctx context.Context, buildArgs *types.BuildBlockArgs, bundles []types.SBundle) (*dencun.ExecutionPayloadEnvelope, error) {
 	if buildArgs == nil {
-		head := e.b.CurrentHeader()
-		buildArgs = &types.BuildBlockArgs{
-			Parent:       head.Hash(),
-			Timestamp:    head.Time + uint64(12),
-			FeeRecipient: common.Address{0x42},
-			GasLimit:     30000000,
-			Random:       head.Root,
-			Withdrawals:  nil,
-			Extra:        []byte(""),
-			FillPending:  false,
-		}
+		buildArgs = e.defaultBuildArgs()
 	}
 
 	block, profit, err := e.b.BuildBlockFromBundles(ctx, buildArgs, bundles)
@@ -79,9 +91,119 @@ func (e *EthBackendServer) BuildEthBlockFromBundles(ctx context.Context, buildAr
 		return nil, err
 	}
 
-	return dencun.BlockToExecutableData(block, profit, nil), nil
+	var txs types.Transactions
+	for _, bundle := range bundles {
+		txs = append(txs, bundle.Txs...)
+	}
+	return dencun.BlockToExecutableData(block, profit, blobSidecars(block, txs)), nil
+}
+
+// blobSidecars returns the sidecars of the blob transactions of the block, in
+// the order of the block. The blocks do not carry the blobs, they are taken
+// from the transactions the block was built from.
+func blobSidecars(block *types.Block, txs types.Transactions) []*dencun.BlobTxSidecar {
+	sidecarsByHash := make(map[common.Hash]*types.BlobTxSidecar)
+	for _, tx := range txs {
+		if sidecar := tx.BlobTxSidecar(); sidecar != nil {
+			sidecarsByHash[tx.Hash()] = sidecar
+		}
+	}
+
+	var sidecars []*dencun.BlobTxSidecar
+	for _, tx := range block.Transactions() {
+		if sidecar, ok := sidecarsByHash[tx.Hash()]; ok {
+			sidecars = append(sidecars, sidecar)
+		}
+	}
+	return sidecars
+}
+
+func (e *EthBackendServer) SimulateBundle(ctx context.Context, buildArgs *types.BuildBlockArgs, bundle *types.SBundle) (*types.SimulateBundleResult, error) {
+	if buildArgs == nil {
+		buildArgs = e.defaultBuildArgs()
+	}
+
+	return e.b.SimulateBundle(ctx, buildArgs, bundle)
 }
 
 func (e *EthBackendServer) Call(ctx context.Context, contractAddr common.Address, input []byte) ([]byte, error) {
 	return e.b.Call(ctx, contractAddr, input)
 }
+
+// latestState returns the state of the latest block.
+func (e *EthBackendServer) latestState(ctx context.Context) (*state.StateDB, error) {
+	statedb, _, err := e.b.StateAndHeaderByNumber(ctx, rpc.LatestBlockNumber)
+	return statedb, err
+}
+
+// PendingNonceAt returns the nonce of the account after its transactions in the pool.
+func (e *EthBackendServer) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return e.b.GetPoolNonce(ctx, account)
+}
+
+func (e *EthBackendServer) BalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
+	statedb, err := e.latestState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return statedb.GetBalance(account), nil
+}
+
+func (e *EthBackendServer) CodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	statedb, err := e.latestState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return statedb.GetCode(account), nil
+}
+
+func (e *EthBackendServer) StorageAt(ctx context.Context, account common.Address, key common.Hash) (common.Hash, error) {
+	statedb, err := e.latestState(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return statedb.GetState(account, key), nil
+}
+
+// HeaderByNumber returns the header of the block with the given number, or
+// the latest header if the number is nil.
+func (e *EthBackendServer) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	blockNumber := rpc.LatestBlockNumber
+	if number != nil {
+		if !number.IsInt64() || number.Sign() < 0 {
+			return nil, fmt.Errorf("invalid block number %s", number)
+		}
+		blockNumber = rpc.BlockNumber(number.Int64())
+	}
+	header, err := e.b.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, fmt.Errorf("block %s not found", number)
+	}
+	return header, nil
+}
+
+func (e *EthBackendServer) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	tx, blockHash, _, index, err := e.b.GetTransaction(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("transaction %s not found", txHash)
+	}
+	receipts, err := e.b.GetReceipts(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(receipts)) <= index {
+		return nil, fmt.Errorf("receipt of transaction %s not found", txHash)
+	}
+	receipt := receipts[index]
+	if receipt.Logs == nil {
+		// the logs are required by the json encoding of the receipts
+		receipt.Logs = []*types.Log{}
+	}
+	return receipt, nil
+}
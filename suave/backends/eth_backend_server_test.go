@@ -6,6 +6,9 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/stretchr/testify/require"
@@ -28,8 +31,77 @@ func TestEthBackend_Compatibility(t *testing.T) {
 	_, err = clt.BuildEthBlockFromBundles(context.Background(), &types.BuildBlockArgs{}, nil)
 	require.NoError(t, err)
 
+	res, err := clt.SimulateBundle(context.Background(), nil, &types.SBundle{})
+	require.NoError(t, err)
+	require.True(t, res.Success)
+	require.Equal(t, big.NewInt(11000), res.CoinbaseProfit)
+
 	_, err = clt.Call(context.Background(), common.Address{}, nil)
 	require.NoError(t, err)
+
+	nonce, err := clt.PendingNonceAt(context.Background(), common.Address{0x1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), nonce)
+
+	balance, err := clt.BalanceAt(context.Background(), common.Address{0x1})
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), balance)
+
+	code, err := clt.CodeAt(context.Background(), common.Address{0x1})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x1}, code)
+
+	value, err := clt.StorageAt(context.Background(), common.Address{0x1}, common.Hash{0x2})
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{0x3}, value)
+
+	header, err := clt.HeaderByNumber(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), header.Number)
+
+	header, err = clt.HeaderByNumber(context.Background(), big.NewInt(5))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5), header.Number)
+
+	receipt, err := clt.TransactionReceipt(context.Background(), mockTx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, mockTx.Hash(), receipt.TxHash)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	// the client reconnects after an error, query the server directly
+	server := NewEthBackendServer(&mockBackend{})
+
+	_, err = server.HeaderByNumber(context.Background(), big.NewInt(20))
+	require.Error(t, err)
+
+	_, err = server.TransactionReceipt(context.Background(), common.Hash{0x1})
+	require.Error(t, err)
+}
+
+func TestEthBackend_BlobSidecars(t *testing.T) {
+	sidecar1 := &types.BlobTxSidecar{Commitments: []kzg4844.Commitment{{0x1}}}
+	sidecar2 := &types.BlobTxSidecar{Commitments: []kzg4844.Commitment{{0x2}}}
+
+	newBlobTx := func(nonce uint64, sidecar *types.BlobTxSidecar) *types.Transaction {
+		txn, err := types.NewTx(&types.BlobTx{Nonce: nonce, BlobHashes: sidecar.BlobHashes()}).WithBlobTxSidecar(sidecar)
+		require.NoError(t, err)
+		return txn
+	}
+	blobTx1 := newBlobTx(0, sidecar1)
+	blobTx2 := newBlobTx(1, sidecar2)
+	transfer := types.NewTransaction(2, common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil)
+
+	// the block holds the transactions without their blobs, in another order
+	block := types.NewBlock(&types.Header{}, types.Transactions{
+		blobTx2.WithoutBlobTxSidecar(),
+		transfer,
+		blobTx1.WithoutBlobTxSidecar(),
+	}, nil, nil, trie.NewStackTrie(nil))
+
+	sidecars := blobSidecars(block, types.Transactions{blobTx1, transfer, blobTx2})
+	require.Equal(t, []*types.BlobTxSidecar{sidecar2, sidecar1}, sidecars)
+
+	require.Empty(t, blobSidecars(block, types.Transactions{transfer}))
 }
 
 // mockBackend is a backend for the EthBackendServer that returns mock data
@@ -53,6 +125,53 @@ func (n *mockBackend) BuildBlockFromBundles(ctx context.Context, buildArgs *suav
 	return block, big.NewInt(11000), nil
 }
 
+func (n *mockBackend) SimulateBundle(ctx context.Context, buildArgs *suave.BuildBlockArgs, bundle *types.SBundle) (*types.SimulateBundleResult, error) {
+	return &types.SimulateBundleResult{Success: true, GasUsed: 1000, CoinbaseProfit: big.NewInt(11000), EffectiveGasPrice: 11}, nil
+}
+
 func (n *mockBackend) Call(ctx context.Context, contractAddr common.Address, input []byte) ([]byte, error) {
 	return []byte{0x1}, nil
 }
+
+var mockTx = types.NewTransaction(0, common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil)
+
+func (n *mockBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
+	if number == rpc.LatestBlockNumber {
+		number = 10
+	}
+	if number > 10 {
+		return nil, nil
+	}
+	return &types.Header{Number: big.NewInt(number.Int64()), Difficulty: big.NewInt(0)}, nil
+}
+
+func (n *mockBackend) StateAndHeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*state.StateDB, *types.Header, error) {
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	account := common.Address{0x1}
+	statedb.SetNonce(account, 2)
+	statedb.SetBalance(account, big.NewInt(100))
+	statedb.SetCode(account, []byte{0x1})
+	statedb.SetState(account, common.Hash{0x2}, common.Hash{0x3})
+
+	header, err := n.HeaderByNumber(ctx, number)
+	return statedb, header, err
+}
+
+func (n *mockBackend) GetTransaction(ctx context.Context, txHash common.Hash) (*types.Transaction, common.Hash, uint64, uint64, error) {
+	if txHash != mockTx.Hash() {
+		return nil, common.Hash{}, 0, 0, nil
+	}
+	return mockTx, common.Hash{0x1}, 10, 0, nil
+}
+
+// GetPoolNonce counts a pending transaction on top of the nonce of the state.
+func (n *mockBackend) GetPoolNonce(ctx context.Context, addr common.Address) (uint64, error) {
+	return 3, nil
+}
+
+func (n *mockBackend) GetReceipts(ctx context.Context, blockHash common.Hash) (types.Receipts, error) {
+	return types.Receipts{{TxHash: mockTx.Hash(), Status: types.ReceiptStatusSuccessful, BlockHash: blockHash}}, nil
+}
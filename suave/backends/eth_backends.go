@@ -37,6 +37,16 @@ func (e *EthMock) BuildEthBlockFromBundles(ctx context.Context, args *suave.Buil
 	return dencun.BlockToExecutableData(block, big.NewInt(11000), nil), nil
 }
 
+func (e *EthMock) SimulateBundle(ctx context.Context, args *suave.BuildBlockArgs, bundle *types.SBundle) (*types.SimulateBundleResult, error) {
+	return &types.SimulateBundleResult{
+		Success:           true,
+		GasUsed:           1000,
+		CoinbaseProfit:    big.NewInt(11000),
+		EffectiveGasPrice: 11,
+		Logs:              []*types.SimulatedLog{},
+	}, nil
+}
+
 func (e *EthMock) Call(ctx context.Context, contractAddr common.Address, input []byte) ([]byte, error) {
 	return nil, nil
 }
@@ -45,6 +55,30 @@ func (e *EthMock) ChainID(ctx context.Context) (*big.Int, error) {
 	return nil, nil
 }
 
+func (e *EthMock) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return 0, nil
+}
+
+func (e *EthMock) BalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+func (e *EthMock) CodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return nil, nil
+}
+
+func (e *EthMock) StorageAt(ctx context.Context, account common.Address, key common.Hash) (common.Hash, error) {
+	return common.Hash{}, nil
+}
+
+func (e *EthMock) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(0), Difficulty: big.NewInt(0)}, nil
+}
+
+func (e *EthMock) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return &types.Receipt{TxHash: txHash, Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{}}, nil
+}
+
 type RemoteEthBackend struct {
 	endpoint string
 	client   *rpc.Client
@@ -101,6 +135,13 @@ func (e *RemoteEthBackend) BuildEthBlockFromBundles(ctx context.Context, args *s
 	return &result, err
 }
 
+func (e *RemoteEthBackend) SimulateBundle(ctx context.Context, args *suave.BuildBlockArgs, bundle *types.SBundle) (*types.SimulateBundleResult, error) {
+	var result types.SimulateBundleResult
+	err := e.CallContext(ctx, &result, "suavex_simulateBundle", args, bundle)
+
+	return &result, err
+}
+
 func (e *RemoteEthBackend) Call(ctx context.Context, contractAddr common.Address, input []byte) ([]byte, error) {
 	var result []byte
 	err := e.CallContext(ctx, &result, "suavex_call", contractAddr, input)
@@ -115,3 +156,48 @@ func (e *RemoteEthBackend) ChainID(ctx context.Context) (*big.Int, error) {
 	}
 	return chainID.ToInt(), nil
 }
+
+func (e *RemoteEthBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	var result uint64
+	err := e.CallContext(ctx, &result, "suavex_pendingNonceAt", account)
+
+	return result, err
+}
+
+func (e *RemoteEthBackend) BalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
+	var result big.Int
+	if err := e.CallContext(ctx, &result, "suavex_balanceAt", account); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (e *RemoteEthBackend) CodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	var result []byte
+	err := e.CallContext(ctx, &result, "suavex_codeAt", account)
+
+	return result, err
+}
+
+func (e *RemoteEthBackend) StorageAt(ctx context.Context, account common.Address, key common.Hash) (common.Hash, error) {
+	var result common.Hash
+	err := e.CallContext(ctx, &result, "suavex_storageAt", account, key)
+
+	return result, err
+}
+
+func (e *RemoteEthBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	var result types.Header
+	if err := e.CallContext(ctx, &result, "suavex_headerByNumber", number); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (e *RemoteEthBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	var result types.Receipt
+	if err := e.CallContext(ctx, &result, "suavex_transactionReceipt", txHash); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
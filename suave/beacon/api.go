@@ -0,0 +1,187 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/suave/internal/apiclient"
+)
+
+const (
+	// SecondsPerSlot is the slot duration of the mock API, the beacon nodes
+	// report the one of their network.
+	SecondsPerSlot = 12
+	SlotsPerEpoch  = 32
+)
+
+// Head is the head block of the beacon chain.
+type Head struct {
+	Slot uint64
+	Root common.Hash
+	// ExecutionBlockHash is the hash of the execution payload of the block.
+	ExecutionBlockHash common.Hash
+}
+
+// ProposerDuty is the validator which proposes the block of a slot.
+type ProposerDuty struct {
+	Slot           uint64
+	ValidatorIndex uint64
+	Pubkey         []byte
+}
+
+// API is the subset of the beacon node API followed by the kettle.
+type API interface {
+	// GenesisTime returns the unix timestamp of the beacon chain genesis.
+	GenesisTime(ctx context.Context) (uint64, error)
+	// SecondsPerSlot returns the slot duration of the beacon chain spec.
+	SecondsPerSlot(ctx context.Context) (uint64, error)
+	// Head returns the current head block.
+	Head(ctx context.Context) (*Head, error)
+	// ProposerDuties returns the proposers of the slots of the epoch.
+	ProposerDuties(ctx context.Context, epoch uint64) ([]*ProposerDuty, error)
+	// Randao returns the randao mix of the head state, used as prev randao by the next block.
+	Randao(ctx context.Context) (common.Hash, error)
+	// ExpectedWithdrawals returns the withdrawals of the block proposed at the slot on top of the head.
+	ExpectedWithdrawals(ctx context.Context, slot uint64) ([]*types.Withdrawal, error)
+}
+
+// Client is an API implementation using the REST API of a beacon node.
+type Client struct {
+	api *apiclient.Client
+}
+
+// NewClient returns a client of the beacon node at the url, using the default client if nil.
+func NewClient(client *http.Client, url string) *Client {
+	return &Client{api: apiclient.New(client, url, "beacon node")}
+}
+
+func (c *Client) GenesisTime(ctx context.Context) (uint64, error) {
+	var resp struct {
+		Data struct {
+			GenesisTime apiclient.Uint64 `json:"genesis_time"`
+		} `json:"data"`
+	}
+	if err := c.api.Get(ctx, "/eth/v1/beacon/genesis", nil, &resp); err != nil {
+		return 0, err
+	}
+	return uint64(resp.Data.GenesisTime), nil
+}
+
+func (c *Client) SecondsPerSlot(ctx context.Context) (uint64, error) {
+	var resp struct {
+		Data struct {
+			SecondsPerSlot apiclient.Uint64 `json:"SECONDS_PER_SLOT"`
+		} `json:"data"`
+	}
+	if err := c.api.Get(ctx, "/eth/v1/config/spec", nil, &resp); err != nil {
+		return 0, err
+	}
+	if resp.Data.SecondsPerSlot == 0 {
+		return 0, errors.New("beacon spec has no SECONDS_PER_SLOT")
+	}
+	return uint64(resp.Data.SecondsPerSlot), nil
+}
+
+func (c *Client) Head(ctx context.Context) (*Head, error) {
+	var header struct {
+		Data struct {
+			Root   common.Hash `json:"root"`
+			Header struct {
+				Message struct {
+					Slot apiclient.Uint64 `json:"slot"`
+				} `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+	if err := c.api.Get(ctx, "/eth/v1/beacon/headers/head", nil, &header); err != nil {
+		return nil, err
+	}
+
+	var block struct {
+		Data struct {
+			Message struct {
+				Body struct {
+					ExecutionPayload struct {
+						BlockHash common.Hash `json:"block_hash"`
+					} `json:"execution_payload"`
+				} `json:"body"`
+			} `json:"message"`
+		} `json:"data"`
+	}
+	if err := c.api.Get(ctx, "/eth/v2/beacon/blocks/"+header.Data.Root.Hex(), nil, &block); err != nil {
+		return nil, err
+	}
+
+	return &Head{
+		Slot:               uint64(header.Data.Header.Message.Slot),
+		Root:               header.Data.Root,
+		ExecutionBlockHash: block.Data.Message.Body.ExecutionPayload.BlockHash,
+	}, nil
+}
+
+func (c *Client) ProposerDuties(ctx context.Context, epoch uint64) ([]*ProposerDuty, error) {
+	var resp struct {
+		Data []struct {
+			Pubkey         hexutil.Bytes    `json:"pubkey"`
+			ValidatorIndex apiclient.Uint64 `json:"validator_index"`
+			Slot           apiclient.Uint64 `json:"slot"`
+		} `json:"data"`
+	}
+	if err := c.api.Get(ctx, "/eth/v1/validator/duties/proposer/"+strconv.FormatUint(epoch, 10), nil, &resp); err != nil {
+		return nil, err
+	}
+
+	duties := make([]*ProposerDuty, len(resp.Data))
+	for i, duty := range resp.Data {
+		duties[i] = &ProposerDuty{
+			Slot:           uint64(duty.Slot),
+			ValidatorIndex: uint64(duty.ValidatorIndex),
+			Pubkey:         duty.Pubkey,
+		}
+	}
+	return duties, nil
+}
+
+func (c *Client) Randao(ctx context.Context) (common.Hash, error) {
+	var resp struct {
+		Data struct {
+			Randao common.Hash `json:"randao"`
+		} `json:"data"`
+	}
+	if err := c.api.Get(ctx, "/eth/v1/beacon/states/head/randao", nil, &resp); err != nil {
+		return common.Hash{}, err
+	}
+	return resp.Data.Randao, nil
+}
+
+func (c *Client) ExpectedWithdrawals(ctx context.Context, slot uint64) ([]*types.Withdrawal, error) {
+	var resp struct {
+		Data []struct {
+			Index          apiclient.Uint64 `json:"index"`
+			ValidatorIndex apiclient.Uint64 `json:"validator_index"`
+			Address        common.Address   `json:"address"`
+			Amount         apiclient.Uint64 `json:"amount"`
+		} `json:"data"`
+	}
+	query := url.Values{"proposal_slot": []string{strconv.FormatUint(slot, 10)}}
+	if err := c.api.Get(ctx, "/eth/v1/builder/states/head/expected_withdrawals", query, &resp); err != nil {
+		return nil, err
+	}
+
+	withdrawals := make([]*types.Withdrawal, len(resp.Data))
+	for i, w := range resp.Data {
+		withdrawals[i] = &types.Withdrawal{
+			Index:     uint64(w.Index),
+			Validator: uint64(w.ValidatorIndex),
+			Address:   w.Address,
+			Amount:    uint64(w.Amount),
+		}
+	}
+	return withdrawals, nil
+}
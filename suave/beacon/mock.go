@@ -0,0 +1,84 @@
+package beacon
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MockAPI is a local API whose head follows the wall clock. All the data is
+// derived from the slot numbers, which makes it usable for local development
+// without a beacon node.
+type MockAPI struct {
+	Genesis uint64
+	// Now returns the current time, time.Now if nil.
+	Now func() time.Time
+}
+
+// NewMockAPI returns a mock API whose genesis is now.
+func NewMockAPI() *MockAPI {
+	return &MockAPI{Genesis: uint64(time.Now().Unix())}
+}
+
+func (m *MockAPI) GenesisTime(ctx context.Context) (uint64, error) {
+	return m.Genesis, nil
+}
+
+func (m *MockAPI) SecondsPerSlot(ctx context.Context) (uint64, error) {
+	return SecondsPerSlot, nil
+}
+
+func (m *MockAPI) Head(ctx context.Context) (*Head, error) {
+	slot := m.headSlot()
+	return &Head{
+		Slot:               slot,
+		Root:               mockHash("root", slot),
+		ExecutionBlockHash: mockHash("block", slot),
+	}, nil
+}
+
+func (m *MockAPI) ProposerDuties(ctx context.Context, epoch uint64) ([]*ProposerDuty, error) {
+	duties := make([]*ProposerDuty, SlotsPerEpoch)
+	for i := range duties {
+		slot := epoch*SlotsPerEpoch + uint64(i)
+		duties[i] = &ProposerDuty{
+			Slot:           slot,
+			ValidatorIndex: slot % 1000,
+			Pubkey:         append(mockHash("pubkey", slot%1000).Bytes(), make([]byte, 16)...),
+		}
+	}
+	return duties, nil
+}
+
+func (m *MockAPI) Randao(ctx context.Context) (common.Hash, error) {
+	return mockHash("randao", m.headSlot()), nil
+}
+
+func (m *MockAPI) ExpectedWithdrawals(ctx context.Context, slot uint64) ([]*types.Withdrawal, error) {
+	validator := slot % 1000
+	return []*types.Withdrawal{{
+		Index:     slot,
+		Validator: validator,
+		Address:   common.BytesToAddress(mockHash("withdrawal", validator).Bytes()),
+		Amount:    1,
+	}}, nil
+}
+
+func (m *MockAPI) headSlot() uint64 {
+	now := time.Now()
+	if m.Now != nil {
+		now = m.Now()
+	}
+	if now.Unix() < int64(m.Genesis) {
+		return 0
+	}
+	return (uint64(now.Unix()) - m.Genesis) / SecondsPerSlot
+}
+
+func mockHash(kind string, num uint64) common.Hash {
+	return crypto.Keccak256Hash([]byte(kind), binary.BigEndian.AppendUint64(nil, num))
+}
@@ -0,0 +1,260 @@
+package beacon
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/flashbots/go-boost-utils/ssz"
+	"gopkg.in/yaml.v3"
+)
+
+// Fork is a scheduled fork of the beacon chain.
+type Fork struct {
+	Name    string
+	Epoch   uint64
+	Version phase0.Version
+}
+
+// Network describes the beacon chain paired with an execution chain. It
+// provides the signing domains used to sign messages for the builder and
+// relay APIs of that chain.
+type Network struct {
+	Name                  string
+	ChainID               uint64
+	GenesisForkVersion    phase0.Version
+	GenesisValidatorsRoot phase0.Root
+	// Forks is the fork schedule sorted by epoch.
+	Forks []Fork
+}
+
+// ForkVersion returns the fork version active at the given epoch.
+func (n *Network) ForkVersion(epoch uint64) phase0.Version {
+	version := n.GenesisForkVersion
+	for _, fork := range n.Forks {
+		if fork.Epoch > epoch {
+			break
+		}
+		version = fork.Version
+	}
+	return version
+}
+
+// BuilderDomain returns the domain used to sign the builder API messages,
+// like the bid traces submitted to the relays. As per the builder spec, it
+// does not depend on the fork nor on the genesis validators root.
+func (n *Network) BuilderDomain() phase0.Domain {
+	return ssz.ComputeDomain(ssz.DomainTypeAppBuilder, n.GenesisForkVersion, phase0.Root{})
+}
+
+// Domain returns the domain of the given type for the fork active at the epoch.
+func (n *Network) Domain(domainType phase0.DomainType, epoch uint64) phase0.Domain {
+	return ssz.ComputeDomain(domainType, n.ForkVersion(epoch), n.GenesisValidatorsRoot)
+}
+
+var (
+	Mainnet = &Network{
+		Name:                  "mainnet",
+		ChainID:               1,
+		GenesisForkVersion:    phase0.Version{0x00, 0x00, 0x00, 0x00},
+		GenesisValidatorsRoot: mustRoot("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"),
+		Forks: []Fork{
+			{Name: "altair", Epoch: 74240, Version: phase0.Version{0x01, 0x00, 0x00, 0x00}},
+			{Name: "bellatrix", Epoch: 144896, Version: phase0.Version{0x02, 0x00, 0x00, 0x00}},
+			{Name: "capella", Epoch: 194048, Version: phase0.Version{0x03, 0x00, 0x00, 0x00}},
+			{Name: "deneb", Epoch: 269568, Version: phase0.Version{0x04, 0x00, 0x00, 0x00}},
+		},
+	}
+
+	Sepolia = &Network{
+		Name:                  "sepolia",
+		ChainID:               11155111,
+		GenesisForkVersion:    phase0.Version{0x90, 0x00, 0x00, 0x69},
+		GenesisValidatorsRoot: mustRoot("0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078"),
+		Forks: []Fork{
+			{Name: "altair", Epoch: 50, Version: phase0.Version{0x90, 0x00, 0x00, 0x70}},
+			{Name: "bellatrix", Epoch: 100, Version: phase0.Version{0x90, 0x00, 0x00, 0x71}},
+			{Name: "capella", Epoch: 56832, Version: phase0.Version{0x90, 0x00, 0x00, 0x72}},
+			{Name: "deneb", Epoch: 132608, Version: phase0.Version{0x90, 0x00, 0x00, 0x73}},
+		},
+	}
+
+	Holesky = &Network{
+		Name:                  "holesky",
+		ChainID:               17000,
+		GenesisForkVersion:    phase0.Version{0x01, 0x01, 0x70, 0x00},
+		GenesisValidatorsRoot: mustRoot("0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1"),
+		Forks: []Fork{
+			{Name: "altair", Epoch: 0, Version: phase0.Version{0x02, 0x01, 0x70, 0x00}},
+			{Name: "bellatrix", Epoch: 0, Version: phase0.Version{0x03, 0x01, 0x70, 0x00}},
+			{Name: "capella", Epoch: 256, Version: phase0.Version{0x04, 0x01, 0x70, 0x00}},
+			{Name: "deneb", Epoch: 29696, Version: phase0.Version{0x05, 0x01, 0x70, 0x00}},
+		},
+	}
+
+	// Devnet is used by the local test chains, it signs like Holesky.
+	Devnet = &Network{
+		Name:               "devnet",
+		ChainID:            1337,
+		GenesisForkVersion: phase0.Version{0x01, 0x01, 0x70, 0x00},
+	}
+)
+
+// Registry maps execution chain ids to their beacon networks.
+type Registry struct {
+	networks map[uint64]*Network
+}
+
+// NewRegistry returns a registry with the given networks.
+func NewRegistry(networks ...*Network) *Registry {
+	r := &Registry{networks: make(map[uint64]*Network)}
+	for _, network := range networks {
+		r.Register(network)
+	}
+	return r
+}
+
+// DefaultRegistry returns a registry with the public networks and the local devnet.
+func DefaultRegistry() *Registry {
+	return NewRegistry(Mainnet, Sepolia, Holesky, Devnet)
+}
+
+// Register adds the network to the registry, replacing any network with the same chain id.
+func (r *Registry) Register(network *Network) {
+	r.networks[network.ChainID] = network
+}
+
+// Network returns the network of the given chain id.
+func (r *Registry) Network(chainID uint64) (*Network, error) {
+	network, ok := r.networks[chainID]
+	if !ok {
+		return nil, fmt.Errorf("no beacon network configured for chain id %d", chainID)
+	}
+	return network, nil
+}
+
+// LoadSpecFile reads a network from a beacon chain spec YAML file.
+func LoadSpecFile(path string) (*Network, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	network, err := LoadSpec(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid beacon spec %s: %w", path, err)
+	}
+	return network, nil
+}
+
+// LoadSpec reads a network from the config of a beacon chain spec, as published
+// with the consensus clients (config.yaml). The genesis validators root is not
+// part of the spec and is read from the optional GENESIS_VALIDATORS_ROOT key.
+// Forks scheduled for the far future epoch are left out.
+func LoadSpec(data []byte) (*Network, error) {
+	var spec map[string]yaml.Node
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	value := func(key string) (string, bool) {
+		node, ok := spec[key]
+		return node.Value, ok
+	}
+
+	network := &Network{}
+	network.Name, _ = value("CONFIG_NAME")
+
+	chainID, ok := value("DEPOSIT_CHAIN_ID")
+	if !ok {
+		return nil, fmt.Errorf("missing DEPOSIT_CHAIN_ID")
+	}
+	var err error
+	if network.ChainID, err = strconv.ParseUint(chainID, 0, 64); err != nil {
+		return nil, fmt.Errorf("invalid DEPOSIT_CHAIN_ID: %w", err)
+	}
+
+	genesisForkVersion, ok := value("GENESIS_FORK_VERSION")
+	if !ok {
+		return nil, fmt.Errorf("missing GENESIS_FORK_VERSION")
+	}
+	if network.GenesisForkVersion, err = parseVersion(genesisForkVersion); err != nil {
+		return nil, fmt.Errorf("invalid GENESIS_FORK_VERSION: %w", err)
+	}
+
+	if root, ok := value("GENESIS_VALIDATORS_ROOT"); ok {
+		if network.GenesisValidatorsRoot, err = parseRoot(root); err != nil {
+			return nil, fmt.Errorf("invalid GENESIS_VALIDATORS_ROOT: %w", err)
+		}
+	}
+
+	for key, node := range spec {
+		if !strings.HasSuffix(key, "_FORK_VERSION") || key == "GENESIS_FORK_VERSION" {
+			continue
+		}
+		name := strings.TrimSuffix(key, "_FORK_VERSION")
+		epochKey := name + "_FORK_EPOCH"
+		epochStr, ok := value(epochKey)
+		if !ok {
+			return nil, fmt.Errorf("missing %s", epochKey)
+		}
+		epoch, err := strconv.ParseUint(epochStr, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", epochKey, err)
+		}
+		if epoch == math.MaxUint64 {
+			continue
+		}
+		version, err := parseVersion(node.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", key, err)
+		}
+		network.Forks = append(network.Forks, Fork{Name: strings.ToLower(name), Epoch: epoch, Version: version})
+	}
+	sort.SliceStable(network.Forks, func(i, j int) bool {
+		if network.Forks[i].Epoch != network.Forks[j].Epoch {
+			return network.Forks[i].Epoch < network.Forks[j].Epoch
+		}
+		// forks activated at the same epoch are ordered by version
+		return bytes.Compare(network.Forks[i].Version[:], network.Forks[j].Version[:]) < 0
+	})
+	return network, nil
+}
+
+func parseVersion(str string) (phase0.Version, error) {
+	var version phase0.Version
+	buf, err := hexutil.Decode(str)
+	if err != nil {
+		return version, err
+	}
+	if len(buf) != len(version) {
+		return version, fmt.Errorf("expected %d bytes, got %d", len(version), len(buf))
+	}
+	copy(version[:], buf)
+	return version, nil
+}
+
+func parseRoot(str string) (phase0.Root, error) {
+	var root phase0.Root
+	buf, err := hexutil.Decode(str)
+	if err != nil {
+		return root, err
+	}
+	if len(buf) != len(root) {
+		return root, fmt.Errorf("expected %d bytes, got %d", len(root), len(buf))
+	}
+	copy(root[:], buf)
+	return root, nil
+}
+
+func mustRoot(str string) phase0.Root {
+	root, err := parseRoot(str)
+	if err != nil {
+		panic(err)
+	}
+	return root
+}
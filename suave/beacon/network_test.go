@@ -0,0 +1,81 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/flashbots/go-boost-utils/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+var sepoliaSpec = `
+PRESET_BASE: 'mainnet'
+CONFIG_NAME: 'sepolia'
+DEPOSIT_CHAIN_ID: 11155111
+GENESIS_FORK_VERSION: 0x90000069
+GENESIS_VALIDATORS_ROOT: 0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078
+ALTAIR_FORK_VERSION: 0x90000070
+ALTAIR_FORK_EPOCH: 50
+BELLATRIX_FORK_VERSION: 0x90000071
+BELLATRIX_FORK_EPOCH: 100
+CAPELLA_FORK_VERSION: 0x90000072
+CAPELLA_FORK_EPOCH: 56832
+DENEB_FORK_VERSION: 0x90000073
+DENEB_FORK_EPOCH: 132608
+ELECTRA_FORK_VERSION: 0x90000074
+ELECTRA_FORK_EPOCH: 18446744073709551615
+SECONDS_PER_SLOT: 12
+`
+
+func TestLoadSpec(t *testing.T) {
+	network, err := LoadSpec([]byte(sepoliaSpec))
+	require.NoError(t, err)
+	require.Equal(t, Sepolia, network)
+
+	_, err = LoadSpec([]byte("CONFIG_NAME: 'devnet'\nGENESIS_FORK_VERSION: 0x10000000"))
+	require.Error(t, err)
+
+	_, err = LoadSpec([]byte("DEPOSIT_CHAIN_ID: 1\nGENESIS_FORK_VERSION: 0x1000"))
+	require.Error(t, err)
+
+	_, err = LoadSpec([]byte("DEPOSIT_CHAIN_ID: 1\nGENESIS_FORK_VERSION: 0x10000000\nALTAIR_FORK_VERSION: 0x10000001"))
+	require.Error(t, err)
+}
+
+func TestNetwork_ForkVersion(t *testing.T) {
+	require.Equal(t, phase0.Version{0x90, 0x00, 0x00, 0x69}, Sepolia.ForkVersion(0))
+	require.Equal(t, phase0.Version{0x90, 0x00, 0x00, 0x70}, Sepolia.ForkVersion(50))
+	require.Equal(t, phase0.Version{0x90, 0x00, 0x00, 0x72}, Sepolia.ForkVersion(132607))
+	require.Equal(t, phase0.Version{0x90, 0x00, 0x00, 0x73}, Sepolia.ForkVersion(132608))
+
+	// forks activated at genesis
+	require.Equal(t, phase0.Version{0x03, 0x01, 0x70, 0x00}, Holesky.ForkVersion(0))
+	require.Equal(t, Devnet.GenesisForkVersion, Devnet.ForkVersion(1000))
+}
+
+func TestNetwork_Domain(t *testing.T) {
+	require.Equal(t, phase0.Domain(ssz.DomainBuilder), Mainnet.BuilderDomain())
+	require.NotEqual(t, Mainnet.BuilderDomain(), Holesky.BuilderDomain())
+	require.Equal(t, Holesky.BuilderDomain(), Devnet.BuilderDomain())
+
+	expected := ssz.ComputeDomain(ssz.DomainTypeBeaconProposer, phase0.Version{0x04, 0x00, 0x00, 0x00}, Mainnet.GenesisValidatorsRoot)
+	require.Equal(t, phase0.Domain(expected), Mainnet.Domain(ssz.DomainTypeBeaconProposer, 269568))
+}
+
+func TestRegistry(t *testing.T) {
+	registry := DefaultRegistry()
+
+	network, err := registry.Network(17000)
+	require.NoError(t, err)
+	require.Equal(t, Holesky, network)
+
+	_, err = registry.Network(7014190335)
+	require.Error(t, err)
+
+	devnet := &Network{Name: "private", ChainID: 7014190335, GenesisForkVersion: phase0.Version{0x10}}
+	registry.Register(devnet)
+
+	network, err = registry.Network(7014190335)
+	require.NoError(t, err)
+	require.Equal(t, devnet, network)
+}
@@ -0,0 +1,280 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var errNotStarted = errors.New("beacon tracker not started")
+
+const (
+	// MockEndpoint is the endpoint of the local mock API.
+	MockEndpoint = "mock"
+
+	pollInterval   = time.Second
+	requestTimeout = 5 * time.Second
+)
+
+// Context is the beacon chain data required to build the block of a slot.
+type Context struct {
+	Slot           uint64
+	Timestamp      uint64
+	ProposerIndex  uint64
+	ProposerPubkey []byte
+	Random         common.Hash
+	Withdrawals    []*types.Withdrawal
+	// BeaconRoot is the root of the parent beacon block.
+	BeaconRoot common.Hash
+	// ParentHash is the hash of the parent execution block.
+	ParentHash common.Hash
+}
+
+// ContextProvider returns the beacon context of the upcoming slots.
+type ContextProvider interface {
+	Context(ctx context.Context, slot uint64) (*Context, error)
+}
+
+// Tracker follows the head of the beacon chain and the proposer duties of the
+// current and next epochs, and prepares the context of the next slot.
+type Tracker struct {
+	api      API
+	interval time.Duration
+
+	lock           sync.RWMutex
+	genesisTime    uint64
+	secondsPerSlot uint64
+	head           *Head
+	duties         map[uint64]*ProposerDuty // proposers by slot
+	next           *Context                 // context of the slot after the head
+
+	closeCh chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewTracker returns a tracker polling the API with the given interval.
+func NewTracker(api API, interval time.Duration) *Tracker {
+	return &Tracker{
+		api:      api,
+		interval: interval,
+		duties:   make(map[uint64]*ProposerDuty),
+		closeCh:  make(chan struct{}),
+	}
+}
+
+// NewEndpointTracker returns a tracker following the beacon node API at the
+// endpoint, or a mock API if the endpoint is MockEndpoint.
+func NewEndpointTracker(endpoint string) *Tracker {
+	if endpoint == MockEndpoint {
+		return NewTracker(NewMockAPI(), pollInterval)
+	}
+	return NewTracker(NewClient(&http.Client{Timeout: requestTimeout}, endpoint), pollInterval)
+}
+
+// Start fetches the current head and starts following the chain. If the beacon
+// node cannot be reached, the tracker keeps retrying in the background.
+func (t *Tracker) Start() error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	if err := t.Update(ctx); err != nil {
+		log.Warn("Could not follow the beacon chain, retrying", "err", err)
+	}
+
+	t.wg.Add(1)
+	go t.loop()
+	return nil
+}
+
+// Stop stops following the chain.
+func (t *Tracker) Stop() error {
+	close(t.closeCh)
+	t.wg.Wait()
+	return nil
+}
+
+func (t *Tracker) loop() {
+	defer t.wg.Done()
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), t.interval)
+			if err := t.Update(ctx); err != nil {
+				log.Warn("Could not update beacon head", "err", err)
+			}
+			cancel()
+		case <-t.closeCh:
+			return
+		}
+	}
+}
+
+// Update fetches the head and, if it changed, refreshes the duties and the
+// context of the next slot. The genesis is fetched first if not known yet.
+func (t *Tracker) Update(ctx context.Context) error {
+	if err := t.fetchGenesis(ctx); err != nil {
+		return err
+	}
+	head, err := t.api.Head(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get beacon head: %w", err)
+	}
+
+	t.lock.RLock()
+	known := t.head
+	t.lock.RUnlock()
+	if known != nil && known.Root == head.Root {
+		return nil
+	}
+
+	// fetch the duties of the current and the next epoch
+	current := (head.Slot + 1) / SlotsPerEpoch
+	for _, epoch := range []uint64{current, current + 1} {
+		if err := t.fetchDuties(ctx, epoch); err != nil {
+			return err
+		}
+	}
+
+	t.lock.Lock()
+	t.head = head
+	t.next = nil
+	// forget the duties of the past slots
+	for slot := range t.duties {
+		if slot <= head.Slot {
+			delete(t.duties, slot)
+		}
+	}
+	t.lock.Unlock()
+
+	next, err := t.buildContext(ctx, head, head.Slot+1)
+	if err != nil {
+		return err
+	}
+
+	t.lock.Lock()
+	if t.head == head {
+		t.next = next
+	}
+	t.lock.Unlock()
+
+	log.Debug("Updated beacon head", "slot", head.Slot, "root", head.Root)
+	return nil
+}
+
+// Context returns the context of the slot after the current head. The other
+// slots are rejected, since the randao of the head is only the one of the next
+// block.
+func (t *Tracker) Context(ctx context.Context, slot uint64) (*Context, error) {
+	t.lock.RLock()
+	head, next := t.head, t.next
+	t.lock.RUnlock()
+
+	if head == nil {
+		return nil, errNotStarted
+	}
+	if slot != head.Slot+1 {
+		return nil, fmt.Errorf("slot %d is not the one after the beacon head %d", slot, head.Slot)
+	}
+	if next != nil {
+		return next, nil
+	}
+	return t.buildContext(ctx, head, slot)
+}
+
+func (t *Tracker) buildContext(ctx context.Context, head *Head, slot uint64) (*Context, error) {
+	duty, err := t.duty(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	random, err := t.api.Randao(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get randao: %w", err)
+	}
+	withdrawals, err := t.api.ExpectedWithdrawals(ctx, slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get expected withdrawals: %w", err)
+	}
+
+	t.lock.RLock()
+	genesisTime, secondsPerSlot := t.genesisTime, t.secondsPerSlot
+	t.lock.RUnlock()
+
+	return &Context{
+		Slot:           slot,
+		Timestamp:      genesisTime + slot*secondsPerSlot,
+		ProposerIndex:  duty.ValidatorIndex,
+		ProposerPubkey: duty.Pubkey,
+		Random:         random,
+		Withdrawals:    withdrawals,
+		BeaconRoot:     head.Root,
+		ParentHash:     head.ExecutionBlockHash,
+	}, nil
+}
+
+func (t *Tracker) fetchGenesis(ctx context.Context) error {
+	t.lock.RLock()
+	known := t.genesisTime != 0
+	t.lock.RUnlock()
+	if known {
+		return nil
+	}
+
+	genesisTime, err := t.api.GenesisTime(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get beacon genesis: %w", err)
+	}
+	secondsPerSlot, err := t.api.SecondsPerSlot(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get beacon spec: %w", err)
+	}
+	t.lock.Lock()
+	t.genesisTime, t.secondsPerSlot = genesisTime, secondsPerSlot
+	t.lock.Unlock()
+	return nil
+}
+
+func (t *Tracker) duty(ctx context.Context, slot uint64) (*ProposerDuty, error) {
+	t.lock.RLock()
+	duty, ok := t.duties[slot]
+	t.lock.RUnlock()
+	if ok {
+		return duty, nil
+	}
+
+	if err := t.fetchDuties(ctx, slot/SlotsPerEpoch); err != nil {
+		return nil, err
+	}
+
+	t.lock.RLock()
+	duty, ok = t.duties[slot]
+	t.lock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no proposer for slot %d", slot)
+	}
+	return duty, nil
+}
+
+func (t *Tracker) fetchDuties(ctx context.Context, epoch uint64) error {
+	duties, err := t.api.ProposerDuties(ctx, epoch)
+	if err != nil {
+		return fmt.Errorf("could not get proposer duties of epoch %d: %w", epoch, err)
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, duty := range duties {
+		t.duties[duty.Slot] = duty
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker(t *testing.T) {
+	now := time.Unix(1000+100*SecondsPerSlot, 0)
+	api := &MockAPI{Genesis: 1000, Now: func() time.Time { return now }}
+
+	tracker := NewTracker(api, time.Hour)
+	_, err := tracker.Context(context.Background(), 101)
+	require.ErrorIs(t, err, errNotStarted)
+
+	require.NoError(t, tracker.Start())
+	defer tracker.Stop()
+
+	beaconCtx, err := tracker.Context(context.Background(), 101)
+	require.NoError(t, err)
+	require.Equal(t, &Context{
+		Slot:           101,
+		Timestamp:      1000 + 101*SecondsPerSlot,
+		ProposerIndex:  101,
+		ProposerPubkey: append(mockHash("pubkey", 101).Bytes(), make([]byte, 16)...),
+		Random:         mockHash("randao", 100),
+		Withdrawals:    []*types.Withdrawal{{Index: 101, Validator: 101, Address: common.BytesToAddress(mockHash("withdrawal", 101).Bytes()), Amount: 1}},
+		BeaconRoot:     mockHash("root", 100),
+		ParentHash:     mockHash("block", 100),
+	}, beaconCtx)
+
+	// past slots and slots after the next one are rejected,
+	// the randao of the head is only the one of the next slot
+	for _, slot := range []uint64{100, 102, 200} {
+		_, err = tracker.Context(context.Background(), slot)
+		require.Error(t, err)
+	}
+
+	// the next slot follows the head
+	now = now.Add(SecondsPerSlot * time.Second)
+	require.NoError(t, tracker.Update(context.Background()))
+
+	beaconCtx, err = tracker.Context(context.Background(), 102)
+	require.NoError(t, err)
+	require.Equal(t, mockHash("root", 101), beaconCtx.BeaconRoot)
+	require.Equal(t, mockHash("randao", 101), beaconCtx.Random)
+}
+
+// unreachableAPI fails until it is made reachable.
+type unreachableAPI struct {
+	*MockAPI
+	reachable atomic.Bool
+}
+
+func (u *unreachableAPI) GenesisTime(ctx context.Context) (uint64, error) {
+	if !u.reachable.Load() {
+		return 0, errors.New("connection refused")
+	}
+	return u.MockAPI.GenesisTime(ctx)
+}
+
+func TestTracker_StartRetries(t *testing.T) {
+	now := time.Unix(1000+100*SecondsPerSlot, 0)
+	api := &unreachableAPI{MockAPI: &MockAPI{Genesis: 1000, Now: func() time.Time { return now }}}
+
+	// the tracker starts without the beacon node and follows it once reachable
+	tracker := NewTracker(api, 10*time.Millisecond)
+	require.NoError(t, tracker.Start())
+	defer tracker.Stop()
+
+	_, err := tracker.Context(context.Background(), 101)
+	require.ErrorIs(t, err, errNotStarted)
+
+	api.reachable.Store(true)
+	require.Eventually(t, func() bool {
+		_, err := tracker.Context(context.Background(), 101)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestClient(t *testing.T) {
+	responses := map[string]string{
+		"/eth/v1/beacon/genesis":      `{"data":{"genesis_time":"1606824023"}}`,
+		"/eth/v1/config/spec":         `{"data":{"SECONDS_PER_SLOT":"6","SLOTS_PER_EPOCH":"32"}}`,
+		"/eth/v1/beacon/headers/head": `{"data":{"root":"0x0000000000000000000000000000000000000000000000000000000000000001","header":{"message":{"slot":"64"}}}}`,
+		"/eth/v2/beacon/blocks/0x0000000000000000000000000000000000000000000000000000000000000001": `{"data":{"message":{"body":{"execution_payload":{"block_hash":"0x0000000000000000000000000000000000000000000000000000000000000002"}}}}}`,
+		"/eth/v1/validator/duties/proposer/2":                                                      `{"data":[{"pubkey":"0xa1b2","validator_index":"7","slot":"65"}]}`,
+		"/eth/v1/validator/duties/proposer/3":                                                      `{"data":[]}`,
+		"/eth/v1/beacon/states/head/randao":                                                        `{"data":{"randao":"0x0000000000000000000000000000000000000000000000000000000000000003"}}`,
+		"/eth/v1/builder/states/head/expected_withdrawals":                                         `{"data":[{"index":"1","validator_index":"7","address":"0x0000000000000000000000000000000000000004","amount":"32"}]}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Path == "/eth/v1/builder/states/head/expected_withdrawals" {
+			require.Equal(t, "65", r.URL.Query().Get("proposal_slot"))
+		}
+		w.Write([]byte(resp))
+	}))
+	defer srv.Close()
+
+	tracker := NewEndpointTracker(srv.URL)
+	require.NoError(t, tracker.Start())
+	defer tracker.Stop()
+
+	beaconCtx, err := tracker.Context(context.Background(), 65)
+	require.NoError(t, err)
+	require.Equal(t, &Context{
+		Slot:           65,
+		Timestamp:      1606824023 + 65*6,
+		ProposerIndex:  7,
+		ProposerPubkey: []byte{0xa1, 0xb2},
+		Random:         common.Hash{31: 0x3},
+		Withdrawals:    []*types.Withdrawal{{Index: 1, Validator: 7, Address: common.Address{19: 0x4}, Amount: 32}},
+		BeaconRoot:     common.Hash{31: 0x1},
+		ParentHash:     common.Hash{31: 0x2},
+	}, beaconCtx)
+
+	// the slots after the next one are rejected
+	_, err = tracker.Context(context.Background(), 96)
+	require.Error(t, err)
+}
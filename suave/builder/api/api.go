@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/core/types"
 )
@@ -9,4 +10,16 @@ import (
 type API interface {
 	NewSession(ctx context.Context) (string, error)
 	AddTransaction(ctx context.Context, sessionId string, tx *types.Transaction) (*types.SimulateTransactionResult, error)
+	SimulateBatch(ctx context.Context, sessionId string, candidates []types.Transactions) ([]*SimulateBatchResult, error)
+}
+
+// SimulateBatchResult is the result of simulating a single candidate (a transaction
+// or a bundle of transactions) on top of the state of a builder session.
+type SimulateBatchResult struct {
+	Success        bool
+	Error          string
+	GasUsed        uint64
+	CoinbaseProfit *big.Int
+	Logs           []*types.SimulatedLog
+	AccessList     types.AccessList
 }
@@ -40,3 +40,9 @@ func (a *APIClient) AddTransaction(ctx context.Context, sessionId string, tx *ty
 	err := a.rpc.CallContext(ctx, &receipt, "suavex_addTransaction", sessionId, tx)
 	return receipt, err
 }
+
+func (a *APIClient) SimulateBatch(ctx context.Context, sessionId string, candidates []types.Transactions) ([]*SimulateBatchResult, error) {
+	var results []*SimulateBatchResult
+	err := a.rpc.CallContext(ctx, &results, "suavex_simulateBatch", sessionId, candidates)
+	return results, err
+}
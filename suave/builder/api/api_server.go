@@ -10,6 +10,7 @@ import (
 type SessionManager interface {
 	NewSession(context.Context) (string, error)
 	AddTransaction(sessionId string, tx *types.Transaction) (*types.SimulateTransactionResult, error)
+	SimulateBatch(sessionId string, candidates []types.Transactions) ([]*SimulateBatchResult, error)
 }
 
 func NewServer(s SessionManager) *Server {
@@ -31,6 +32,10 @@ func (s *Server) AddTransaction(ctx context.Context, sessionId string, tx *types
 	return s.sessionMngr.AddTransaction(sessionId, tx)
 }
 
+func (s *Server) SimulateBatch(ctx context.Context, sessionId string, candidates []types.Transactions) ([]*SimulateBatchResult, error) {
+	return s.sessionMngr.SimulateBatch(sessionId, candidates)
+}
+
 type MockServer struct {
 }
 
@@ -41,3 +46,11 @@ func (s *MockServer) NewSession(ctx context.Context) (string, error) {
 func (s *MockServer) AddTransaction(ctx context.Context, sessionId string, tx *types.Transaction) (*types.SimulateTransactionResult, error) {
 	return &types.SimulateTransactionResult{}, nil
 }
+
+func (s *MockServer) SimulateBatch(ctx context.Context, sessionId string, candidates []types.Transactions) ([]*SimulateBatchResult, error) {
+	results := make([]*SimulateBatchResult, len(candidates))
+	for i := range candidates {
+		results[i] = &SimulateBatchResult{}
+	}
+	return results, nil
+}
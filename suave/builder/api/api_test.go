@@ -26,6 +26,10 @@ func TestAPI(t *testing.T) {
 	txn := types.NewTransaction(0, common.Address{}, big.NewInt(1), 1, big.NewInt(1), []byte{})
 	_, err = c.AddTransaction(context.Background(), "1", txn)
 	require.NoError(t, err)
+
+	res1, err := c.SimulateBatch(context.Background(), "1", []types.Transactions{{txn}, {txn, txn}})
+	require.NoError(t, err)
+	require.Len(t, res1, 2)
 }
 
 type nullSessionManager struct{}
@@ -37,3 +41,11 @@ func (nullSessionManager) NewSession(ctx context.Context) (string, error) {
 func (nullSessionManager) AddTransaction(sessionId string, tx *types.Transaction) (*types.SimulateTransactionResult, error) {
 	return &types.SimulateTransactionResult{Logs: []*types.SimulatedLog{}}, nil
 }
+
+func (nullSessionManager) SimulateBatch(sessionId string, candidates []types.Transactions) ([]*SimulateBatchResult, error) {
+	results := make([]*SimulateBatchResult, len(candidates))
+	for i := range candidates {
+		results[i] = &SimulateBatchResult{Logs: []*types.SimulatedLog{}}
+	}
+	return results, nil
+}
@@ -2,15 +2,22 @@ package builder
 
 import (
 	"context"
+	"errors"
+	"math/big"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/suave/builder/api"
 )
 
+var errBlobGasLimitReached = errors.New("blob gas limit reached")
+
 type builder struct {
 	config     *builderConfig
 	txns       []*types.Transaction
@@ -20,6 +27,13 @@ type builder struct {
 	gasUsed    *uint64
 	ctx        context.Context
 	cancelFunc context.CancelFunc
+
+	// blobGasUsed is the data gas used by the blobs of the session
+	blobGasUsed uint64
+
+	// lock serializes the mutations of the session state
+	// with the snapshots taken by SimulateBatch
+	lock sync.Mutex
 }
 
 type builderConfig struct {
@@ -27,6 +41,10 @@ type builderConfig struct {
 	header   *types.Header
 	config   *params.ChainConfig
 	context  core.ChainContext
+
+	// maxSimulationWorkers bounds the number of candidates
+	// simulated in parallel by SimulateBatch
+	maxSimulationWorkers int
 }
 
 func newBuilder(config *builderConfig) *builder {
@@ -44,14 +62,32 @@ func newBuilder(config *builderConfig) *builder {
 	}
 }
 
+// dummyAuthor receives the fees of the transactions applied by the builder, so
+// that only the direct transfers to the coinbase of the block are reported.
+var dummyAuthor = common.Address{}
+
 func (b *builder) AddTransaction(txn *types.Transaction) (*types.SimulateTransactionResult, error) {
-	dummyAuthor := common.Address{}
+	coinbase := b.config.header.Coinbase
+	tracer := newSimulationTracer()
 
 	vmConfig := vm.Config{
 		NoBaseFee: true,
+		Tracer:    tracer,
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if err := b.checkBlobs(txn); err != nil {
+		return &types.SimulateTransactionResult{
+			Success:         false,
+			Error:           err.Error(),
+			CoinbasePayment: big.NewInt(0),
+		}, nil
 	}
 
 	snap := b.state.Snapshot()
+	coinbasePre := b.state.GetBalance(coinbase)
 
 	b.state.SetTxContext(txn.Hash(), len(b.txns))
 	receipt, err := core.ApplyTransaction(b.config.config, b.config.context, &dummyAuthor, b.gasPool, b.state, b.config.header, txn, b.gasUsed, vmConfig)
@@ -59,18 +95,27 @@ func (b *builder) AddTransaction(txn *types.Transaction) (*types.SimulateTransac
 		b.state.RevertToSnapshot(snap)
 
 		result := &types.SimulateTransactionResult{
-			Success: false,
-			Error:   err.Error(),
+			Success:         false,
+			Error:           err.Error(),
+			CoinbasePayment: big.NewInt(0),
 		}
 		return result, nil
 	}
 
 	b.txns = append(b.txns, txn)
 	b.receipts = append(b.receipts, receipt)
+	b.blobGasUsed += txn.BlobGas()
 
 	result := &types.SimulateTransactionResult{
-		Success: true,
-		Logs:    []*types.SimulatedLog{},
+		Egp:             effectiveGasPrice(txn, b.config.header.BaseFee).Uint64(),
+		Success:         true,
+		Logs:            []*types.SimulatedLog{},
+		GasUsed:         receipt.GasUsed,
+		CoinbasePayment: new(big.Int).Sub(b.state.GetBalance(coinbase), coinbasePre),
+		TouchedSlots:    tracer.touchedSlots(),
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		result.RevertReason = tracer.revertReason()
 	}
 	for _, log := range receipt.Logs {
 		result.Logs = append(result.Logs, &types.SimulatedLog{
@@ -83,6 +128,121 @@ func (b *builder) AddTransaction(txn *types.Transaction) (*types.SimulateTransac
 	return result, nil
 }
 
+// checkBlobs checks that a blob transaction carries valid blobs which fit in
+// the blob gas left in the block.
+func (b *builder) checkBlobs(txn *types.Transaction) error {
+	if txn.Type() != types.BlobTxType {
+		return nil
+	}
+	sidecar := txn.BlobTxSidecar()
+	if sidecar == nil {
+		return types.ErrMissingBlobTxSidecar
+	}
+	if b.blobGasUsed+txn.BlobGas() > params.MaxBlobGasPerBlock {
+		return errBlobGasLimitReached
+	}
+	return sidecar.Verify(txn.BlobHashes())
+}
+
+// SimulateBatch simulates each candidate in parallel on top of a copy of the
+// current session state. The candidates are independent from each other and
+// the session state is not modified.
+func (b *builder) SimulateBatch(candidates []types.Transactions) []*api.SimulateBatchResult {
+	results := make([]*api.SimulateBatchResult, len(candidates))
+	if len(candidates) == 0 {
+		return results
+	}
+
+	workers := b.config.maxSimulationWorkers
+	if workers <= 0 || workers > len(candidates) {
+		workers = len(candidates)
+	}
+
+	// every candidate starts from a copy of the same snapshot
+	// of the session state, taken once while holding the lock
+	b.lock.Lock()
+	baseState := b.state.Copy()
+	baseGasPool := *b.gasPool
+	txIndex := len(b.txns)
+	b.lock.Unlock()
+
+	type job struct {
+		indx    int
+		state   *state.StateDB
+		gasPool core.GasPool
+	}
+
+	jobCh := make(chan *job)
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobCh {
+				results[j.indx] = b.simulateCandidate(j.state, &j.gasPool, txIndex, candidates[j.indx])
+			}
+		}()
+	}
+
+	for indx := range candidates {
+		j := &job{
+			indx:    indx,
+			state:   baseState.Copy(),
+			gasPool: baseGasPool,
+		}
+
+		select {
+		case jobCh <- j:
+		case <-b.ctx.Done():
+			results[indx] = &api.SimulateBatchResult{Error: b.ctx.Err().Error()}
+		}
+	}
+	close(jobCh)
+	wg.Wait()
+
+	return results
+}
+
+func (b *builder) simulateCandidate(statedb *state.StateDB, gasPool *core.GasPool, txIndex int, txns types.Transactions) *api.SimulateBatchResult {
+	coinbase := b.config.header.Coinbase
+	profitPre := statedb.GetBalance(coinbase)
+
+	tracer := logger.NewAccessListTracer(nil, common.Address{}, common.Address{}, nil)
+	vmConfig := vm.Config{
+		NoBaseFee: true,
+		Tracer:    tracer,
+	}
+
+	var gasUsed uint64
+	result := &api.SimulateBatchResult{
+		Logs: []*types.SimulatedLog{},
+	}
+
+	for indx, txn := range txns {
+		statedb.SetTxContext(txn.Hash(), txIndex+indx)
+		receipt, err := core.ApplyTransaction(b.config.config, b.config.context, &dummyAuthor, gasPool, statedb, b.config.header, txn, &gasUsed, vmConfig)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		for _, log := range receipt.Logs {
+			result.Logs = append(result.Logs, &types.SimulatedLog{
+				Addr:   log.Address,
+				Topics: log.Topics,
+				Data:   log.Data,
+			})
+		}
+	}
+
+	result.Success = true
+	result.GasUsed = gasUsed
+	result.CoinbaseProfit = new(big.Int).Sub(statedb.GetBalance(coinbase), profitPre)
+	result.AccessList = tracer.AccessList()
+
+	return result
+}
+
 func (b *builder) Terminate() {
 	b.cancelFunc()
 }
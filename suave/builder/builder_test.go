@@ -1,12 +1,16 @@
 package builder
 
 import (
+	"encoding/hex"
 	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 )
 
@@ -16,8 +20,12 @@ func TestBuilder_AddTxn_Simple(t *testing.T) {
 	mock := newMockBuilder(t)
 	txn := mock.state.newTransfer(t, to, big.NewInt(1))
 
-	_, err := mock.builder.AddTransaction(txn)
+	res, err := mock.builder.AddTransaction(txn)
 	require.NoError(t, err)
+	require.True(t, res.Success)
+	require.Equal(t, uint64(21000), res.GasUsed)
+	require.Equal(t, uint64(1), res.Egp)
+	require.Equal(t, big.NewInt(21000), res.CoinbasePayment)
 
 	mock.expect(t, expectedResult{
 		txns: []*types.Transaction{
@@ -29,6 +37,144 @@ func TestBuilder_AddTxn_Simple(t *testing.T) {
 	})
 }
 
+func TestBuilder_AddTxn_CoinbasePayment(t *testing.T) {
+	coinbase := common.Address{0x02, 0x20, 0xcd}
+
+	mock := newMockBuilder(t)
+	mock.builder.config.header.Coinbase = coinbase
+
+	// only the direct payments are reported, the fees are not paid to the coinbase
+	txn := mock.state.newTransfer(t, coinbase, big.NewInt(100))
+
+	res, err := mock.builder.AddTransaction(txn)
+	require.NoError(t, err)
+	require.True(t, res.Success)
+	require.Equal(t, big.NewInt(100), res.CoinbasePayment)
+}
+
+func TestBuilder_AddTxn_Revert(t *testing.T) {
+	revertData, err := hex.DecodeString("08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000003" +
+		"6261640000000000000000000000000000000000000000000000000000000000")
+	require.NoError(t, err)
+
+	// init code that writes the slot 0 and reverts with 'Error("bad")'
+	initCode := []byte{
+		0x60, 0x2a, 0x60, 0x00, 0x55, // SSTORE(0, 42)
+		0x60, 0x64, 0x60, 0x11, 0x60, 0x00, 0x39, // CODECOPY(0, 17, 100)
+		0x60, 0x64, 0x60, 0x00, 0xfd, // REVERT(0, 100)
+	}
+	initCode = append(initCode, revertData...)
+
+	mock := newMockBuilder(t)
+	txn := mock.state.newTxn(t, types.NewContractCreation(mock.state.getNonce(), big.NewInt(0), 1000000, big.NewInt(1), initCode))
+
+	res, err := mock.builder.AddTransaction(txn)
+	require.NoError(t, err)
+	require.True(t, res.Success)
+	require.Equal(t, "bad", res.RevertReason)
+
+	require.Len(t, res.TouchedSlots, 1)
+	require.Equal(t, crypto.CreateAddress(mock.state.premineKeyAdd, 0), res.TouchedSlots[0].Addr)
+	require.Equal(t, []common.Hash{{}}, res.TouchedSlots[0].Slots)
+}
+
+func TestBuilder_SimulateBatch(t *testing.T) {
+	to := common.Address{0x01, 0x10, 0xab}
+
+	mock := newMockBuilder(t)
+	txn1 := mock.state.newTransfer(t, to, big.NewInt(1))
+	txn2 := mock.state.newTransfer(t, to, big.NewInt(2))
+
+	results := mock.builder.SimulateBatch([]types.Transactions{
+		{txn1},
+		{txn1, txn2},
+		{txn2}, // nonce too high
+	})
+	require.Len(t, results, 3)
+
+	require.True(t, results[0].Success)
+	require.Equal(t, uint64(21000), results[0].GasUsed)
+
+	require.True(t, results[1].Success)
+	require.Equal(t, uint64(42000), results[1].GasUsed)
+	require.Equal(t, big.NewInt(42000), results[1].CoinbaseProfit)
+
+	require.False(t, results[2].Success)
+	require.Contains(t, results[2].Error, "nonce too high")
+
+	// the session state is not modified by the simulation
+	mock.expect(t, expectedResult{
+		txns: []*types.Transaction{},
+		balances: map[common.Address]*big.Int{
+			to: big.NewInt(0),
+		},
+	})
+}
+
+func TestBuilder_SimulateBatch_CoinbaseProfit(t *testing.T) {
+	coinbase := common.Address{0x02, 0x20, 0xcd}
+
+	mock := newMockBuilder(t)
+	mock.builder.config.header.Coinbase = coinbase
+
+	// the profit is counted as the coinbase payment of AddTransaction, without the fees
+	txn := mock.state.newTransfer(t, coinbase, big.NewInt(100))
+
+	results := mock.builder.SimulateBatch([]types.Transactions{{txn}})
+	require.Len(t, results, 1)
+	require.True(t, results[0].Success)
+	require.Equal(t, big.NewInt(100), results[0].CoinbaseProfit)
+
+	res, err := mock.builder.AddTransaction(txn)
+	require.NoError(t, err)
+	require.Equal(t, results[0].CoinbaseProfit, res.CoinbasePayment)
+}
+
+func TestBuilder_AddTxn_Blobs(t *testing.T) {
+	var blob kzg4844.Blob
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	require.NoError(t, err)
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	require.NoError(t, err)
+
+	newBlobTx := func(blobs int, proof kzg4844.Proof) *types.Transaction {
+		sidecar := &types.BlobTxSidecar{}
+		for i := 0; i < blobs; i++ {
+			sidecar.Blobs = append(sidecar.Blobs, blob)
+			sidecar.Commitments = append(sidecar.Commitments, commitment)
+			sidecar.Proofs = append(sidecar.Proofs, proof)
+		}
+		txn, err := types.NewTx(&types.BlobTx{BlobHashes: sidecar.BlobHashes()}).WithBlobTxSidecar(sidecar)
+		require.NoError(t, err)
+		return txn
+	}
+
+	cases := []struct {
+		name string
+		txn  *types.Transaction
+		err  string
+	}{
+		{"MissingSidecar", newBlobTx(1, proof).WithoutBlobTxSidecar(), types.ErrMissingBlobTxSidecar.Error()},
+		{"BlobGasLimit", newBlobTx(int(params.MaxBlobGasPerBlock/params.BlobTxDataGasPerBlob)+1, proof), errBlobGasLimitReached.Error()},
+		{"InvalidProof", newBlobTx(1, kzg4844.Proof{0x1}), "invalid proof"},
+	}
+
+	for _, c := range cases {
+		mock := newMockBuilder(t)
+
+		res, err := mock.builder.AddTransaction(c.txn)
+		require.NoError(t, err, c.name)
+		require.False(t, res.Success, c.name)
+		require.Contains(t, res.Error, c.err, c.name)
+
+		mock.expect(t, expectedResult{
+			txns: []*types.Transaction{},
+		})
+	}
+}
+
 func newMockBuilder(t *testing.T) *mockBuilder {
 	// create a dummy header at 0
 	header := &types.Header{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"runtime"
 	"sync"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/suave/builder/api"
 	"github.com/google/uuid"
 )
 
@@ -39,6 +41,7 @@ type Config struct {
 	GasCeil               uint64
 	SessionIdleTimeout    time.Duration
 	MaxConcurrentSessions int
+	MaxSimulationWorkers  int
 }
 
 type SessionManager struct {
@@ -65,6 +68,9 @@ func NewSessionManager(blockchain blockchain, config *Config) *SessionManager {
 	if config.MaxConcurrentSessions <= 0 {
 		config.MaxConcurrentSessions = 16 // chosen arbitrarily
 	}
+	if config.MaxSimulationWorkers <= 0 {
+		config.MaxSimulationWorkers = runtime.NumCPU()
+	}
 
 	sem := make(chan struct{}, config.MaxConcurrentSessions)
 	for len(sem) < cap(sem) {
@@ -136,6 +142,8 @@ func (s *SessionManager) NewSession(ctx context.Context) (string, error) {
 		header:   header,
 		config:   s.blockchain.Config(),
 		context:  s.blockchain,
+
+		maxSimulationWorkers: s.config.MaxSimulationWorkers,
 	}
 
 	id := uuid.New().String()[:7]
@@ -184,6 +192,14 @@ func (s *SessionManager) AddTransaction(sessionId string, tx *types.Transaction)
 	return builder.AddTransaction(tx)
 }
 
+func (s *SessionManager) SimulateBatch(sessionId string, candidates []types.Transactions) ([]*api.SimulateBatchResult, error) {
+	builder, err := s.getSession(sessionId)
+	if err != nil {
+		return nil, err
+	}
+	return builder.SimulateBatch(candidates), nil
+}
+
 func (s *SessionManager) listenForChainHeadEvents() {
 	for {
 		select {
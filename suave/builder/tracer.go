@@ -0,0 +1,73 @@
+package builder
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+)
+
+// simulationTracer collects the storage slots accessed by a transaction
+// and the return data of the top level call.
+type simulationTracer struct {
+	*logger.AccessListTracer
+
+	output []byte
+}
+
+var _ vm.EVMLogger = (*simulationTracer)(nil)
+
+func newSimulationTracer() *simulationTracer {
+	return &simulationTracer{
+		AccessListTracer: logger.NewAccessListTracer(nil, common.Address{}, common.Address{}, nil),
+	}
+}
+
+func (s *simulationTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	s.output = common.CopyBytes(output)
+}
+
+// revertReason decodes the revert reason of the transaction. If the output
+// is not an abi encoded 'Error(string)' it is returned as hex.
+func (s *simulationTracer) revertReason() string {
+	if len(s.output) == 0 {
+		return ""
+	}
+	if reason, err := abi.UnpackRevert(s.output); err == nil {
+		return reason
+	}
+	return hexutil.Encode(s.output)
+}
+
+// touchedSlots returns the storage slots accessed by the transaction
+// grouped by contract address.
+func (s *simulationTracer) touchedSlots() []*types.SimulatedStorageAccess {
+	touched := []*types.SimulatedStorageAccess{}
+	for _, entry := range s.AccessList() {
+		if len(entry.StorageKeys) == 0 {
+			continue
+		}
+		touched = append(touched, &types.SimulatedStorageAccess{
+			Addr:  entry.Address,
+			Slots: entry.StorageKeys,
+		})
+	}
+	return touched
+}
+
+// effectiveGasPrice returns the price per gas paid by the transaction
+// given the base fee of the block.
+func effectiveGasPrice(txn *types.Transaction, baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return txn.GasPrice()
+	}
+	price := new(big.Int).Add(txn.GasTipCap(), baseFee)
+	if price.Cmp(txn.GasFeeCap()) > 0 {
+		return txn.GasFeeCap()
+	}
+	return price
+}
@@ -12,6 +12,16 @@ type Config struct {
 	EthBlockSigningKeyHex         string
 	ExternalWhitelist             []string
 	AliasRegistry                 map[string]string
+	BeaconSpecs                   []string // beacon spec files of the networks to sign relay bids for
+	BeaconEndpoint                string   // beacon node followed for the slot data, "mock" for a local mock
+	BundleRecordTypes             []string // additional record types to build blocks from, <version>=<encoding>:<key>[,<ids key>]
+	Plugins                       []string // spec files of the precompiles served by plugins
+	BuilderSimulationWorkers      int      // workers simulating the batches of a builder session, the CPU count if 0
+	MevShareRefundPercent         int      // refund percent of the filled mev-share bundles whose user bundle has no refund
+	MevShareInclusionRange        uint64   // blocks targeted by the filled mev-share bundles whose user bundle has no max block
 }
 
-var DefaultConfig = Config{}
+var DefaultConfig = Config{
+	MevShareRefundPercent:  10,
+	MevShareInclusionRange: 25,
+}
@@ -1,15 +1,44 @@
 package suave
 
 import (
+	"bytes"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	ethgoAbi "github.com/umbracle/ethgo/abi"
 )
 
+// ExecResultMagic separates the compute result of a confidential request from
+// the ABI encoded ExecResult with the logs emitted while computing it.
+var ExecResultMagic = []byte{0x54, 0x35, 0x43}
+
 type ExecResult struct {
 	Logs []*types.Log
 }
 
+// SplitComputeResult splits the confidential compute result of a SUAVE transaction
+// into the result returned by the request and the ExecResult with its logs.
+func SplitComputeResult(data []byte) ([]byte, *ExecResult) {
+	// The ABI encoded ExecResult spans a multiple of 32 bytes, which pins the
+	// offsets the magic bytes can be found at.
+	for i := (len(data) - len(ExecResultMagic)) % 32; i >= 0 && i+len(ExecResultMagic) <= len(data); i += 32 {
+		if !bytes.Equal(data[i:i+len(ExecResultMagic)], ExecResultMagic) {
+			continue
+		}
+		// Logs are only appended when there are any, and their encoding has to be
+		// canonical to tell them apart from result data with the magic bytes.
+		logsData := data[i+len(ExecResultMagic):]
+		res := new(ExecResult)
+		if err := res.DecodeABI(logsData); err != nil || len(res.Logs) == 0 {
+			continue
+		}
+		if encoded, err := res.EncodeABI(); err == nil && bytes.Equal(encoded, logsData) {
+			return data[:i], res
+		}
+	}
+	return data, &ExecResult{}
+}
+
 // Equal compares two ExecResult structs and returns true if they are equal.
 // We need a special equal function because `types.Log` is a struct with metadata information
 // that is not included (not necessary) during `EncodeABI`.
@@ -1,6 +1,7 @@
 package suave
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -34,3 +35,48 @@ func TestExecResult_ABIEncoding(t *testing.T) {
 		}
 	}
 }
+
+func TestSplitComputeResult(t *testing.T) {
+	execResult := &ExecResult{
+		Logs: []*types.Log{
+			{
+				Address: common.Address{0x1},
+				Topics:  []common.Hash{{0x2}},
+				Data:    []byte{0x3},
+			},
+		},
+	}
+	logsData, err := execResult.EncodeABI()
+	if err != nil {
+		t.Fatalf("Error encoding ABI: %v", err)
+	}
+
+	// callback data, raw return data and results carrying the magic bytes themselves
+	results := [][]byte{
+		nil,
+		{0x1, 0x2, 0x3, 0x4},
+		append([]byte{0x1, 0x2, 0x3, 0x4}, make([]byte, 32)...),
+		make([]byte, 64),
+		append(append([]byte{0x1}, ExecResultMagic...), make([]byte, 32)...),
+	}
+	for _, result := range results {
+		// without logs the whole data is the result
+		res, decoded := SplitComputeResult(result)
+		if !bytes.Equal(res, result) {
+			t.Errorf("Result mismatch without logs: %x != %x", res, result)
+		}
+		if len(decoded.Logs) != 0 {
+			t.Errorf("Unexpected logs without logs: %v", decoded.Logs)
+		}
+
+		// with logs the result is split from them
+		data := append(append(append([]byte{}, result...), ExecResultMagic...), logsData...)
+		res, decoded = SplitComputeResult(data)
+		if !bytes.Equal(res, result) {
+			t.Errorf("Result mismatch with logs: %x != %x", res, result)
+		}
+		if !execResult.Equal(decoded) {
+			t.Errorf("Decoded logs are not equal to original: %v != %v", execResult, decoded)
+		}
+	}
+}
@@ -65,8 +65,15 @@ type ConfidentialStoreBackend interface {
 type ConfidentialEthBackend interface {
 	BuildEthBlock(ctx context.Context, args *BuildBlockArgs, txs types.Transactions) (*dencun.ExecutionPayloadEnvelope, error)
 	BuildEthBlockFromBundles(ctx context.Context, args *BuildBlockArgs, bundles []types.SBundle) (*dencun.ExecutionPayloadEnvelope, error)
+	SimulateBundle(ctx context.Context, args *BuildBlockArgs, bundle *types.SBundle) (*types.SimulateBundleResult, error)
 	Call(ctx context.Context, contractAddr common.Address, input []byte) ([]byte, error)
 	ChainID(ctx context.Context) (*big.Int, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	BalanceAt(ctx context.Context, account common.Address) (*big.Int, error)
+	CodeAt(ctx context.Context, account common.Address) ([]byte, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash) (common.Hash, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 
 	builder.API
 }
@@ -38,6 +38,36 @@ func (l *LocalConfidentialStore) Reset() error {
 	return nil
 }
 
+// Snapshot returns a copy of the store, which can be restored later on.
+func (l *LocalConfidentialStore) Snapshot() *LocalConfidentialStore {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	snapshot := NewLocalConfidentialStore()
+	for id, record := range l.records {
+		snapshot.records[id] = record
+	}
+	for key, value := range l.dataMap {
+		snapshot.dataMap[key] = value
+	}
+	for key, ids := range l.index {
+		snapshot.index[key] = append([]suave.DataId{}, ids...)
+	}
+	return snapshot
+}
+
+// Restore replaces the content of the store with the one of a snapshot.
+func (l *LocalConfidentialStore) Restore(snapshot *LocalConfidentialStore) {
+	restored := snapshot.Snapshot()
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.records = restored.records
+	l.dataMap = restored.dataMap
+	l.index = restored.index
+}
+
 func (l *LocalConfidentialStore) Stop() error {
 	return nil
 }
@@ -2,9 +2,45 @@ package cstore
 
 import (
 	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	suave "github.com/ethereum/go-ethereum/suave/core"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLocal_StoreSuite(t *testing.T) {
 	store := NewLocalConfidentialStore()
 	testBackendStore(t, store)
 }
+
+func TestLocal_SnapshotRestore(t *testing.T) {
+	store := NewLocalConfidentialStore()
+
+	record := suave.DataRecord{Id: suave.DataId{0x1}, Version: "a"}
+	require.NoError(t, store.InitRecord(record))
+	_, err := store.Store(record, common.Address{}, "key", []byte{0x1})
+	require.NoError(t, err)
+
+	snapshot := store.Snapshot()
+
+	// the changes after the snapshot are reverted
+	other := suave.DataRecord{Id: suave.DataId{0x2}, Version: "a"}
+	require.NoError(t, store.InitRecord(other))
+	_, err = store.Store(record, common.Address{}, "key", []byte{0x2})
+	require.NoError(t, err)
+
+	store.Restore(snapshot)
+
+	value, err := store.Retrieve(record, common.Address{}, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x1}, value)
+
+	_, err = store.FetchRecordByID(other.Id)
+	require.Error(t, err)
+	require.Len(t, store.FetchRecordsByProtocolAndBlock(0, "a"), 1)
+
+	// the snapshot can be restored again
+	require.NoError(t, store.InitRecord(other))
+	store.Restore(snapshot)
+	require.Len(t, store.FetchRecordsByProtocolAndBlock(0, "a"), 1)
+}
@@ -48,19 +48,22 @@ func (b *PebbleStoreBackend) start() error {
 		return fmt.Errorf("could not open pebble database at %s: %w", b.dbPath, err)
 	}
 
-	go func() {
-		<-ctx.Done()
-		db.Close()
-	}()
-
 	b.db = db
 
 	return nil
 }
 
+// Stop closes the database, so that it can be reopened right away.
 func (b *PebbleStoreBackend) Stop() error {
-	b.cancel()
-	return nil
+	if b.cancel != nil {
+		b.cancel()
+	}
+	if b.db == nil {
+		return nil
+	}
+	db := b.db
+	b.db = nil
+	return db.Close()
 }
 
 // InitRecord prepares a data record for storage.
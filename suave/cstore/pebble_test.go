@@ -9,3 +9,25 @@ func TestPebbleStore(t *testing.T) {
 	store, _ := NewPebbleStoreBackend(tmpDir)
 	testBackendStore(t, store)
 }
+
+func TestPebbleStoreStop(t *testing.T) {
+	// a backend which could not open its database stops without error
+	store, err := NewPebbleStoreBackend("/dev/null/pebble")
+	if err == nil {
+		t.Fatal("expected an error opening the database")
+	}
+	if err := store.Stop(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	store, err = NewPebbleStoreBackend(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := store.Stop(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := store.Stop(); err != nil {
+		t.Fatalf("unexpected error stopping twice: %v", err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -642,7 +643,7 @@ func TestMevShareBundleSenderContract(t *testing.T) {
 	clt := fr.NewSDKClient()
 
 	bundleSentToBuilder := &struct {
-		Params []types.RPCMevShareBundle
+		Params []types.MevShareBundle
 	}{}
 	serveHttp := func(t *testing.T, w http.ResponseWriter, r *http.Request) {
 		bodyBytes, err := io.ReadAll(r.Body)
@@ -712,22 +713,14 @@ func TestMevShareBundleSenderContract(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, uint64(1), receipt.Status)
 
-		retrievedBlockNumber, err := hexutil.DecodeUint64(bundleSentToBuilder.Params[0].Inclusion.Block)
-		require.NoError(t, err)
-		require.Equal(t, targetBlock, retrievedBlockNumber)
-
-		encodedUserTxBytes, err := userTx.MarshalBinary()
-		require.NoError(t, err)
+		require.Equal(t, targetBlock, uint64(bundleSentToBuilder.Params[0].Inclusion.Block))
 
-		encodedmatchTxBytes, err := matchTx.MarshalBinary()
-		require.NoError(t, err)
-
-		retrievedTxs := []string{}
+		retrievedTxs := []common.Hash{}
 		for _, be := range bundleSentToBuilder.Params[0].Body {
-			retrievedTxs = append(retrievedTxs, be.Tx)
+			retrievedTxs = append(retrievedTxs, be.Tx.Hash())
 		}
 
-		expectedTxs := []string{hexutil.Encode(encodedUserTxBytes), hexutil.Encode(encodedmatchTxBytes)}
+		expectedTxs := []common.Hash{userTx.Hash(), matchTx.Hash()}
 		require.Equal(t, expectedTxs, retrievedTxs)
 	}
 }
@@ -1099,6 +1092,185 @@ func TestE2E_EmitLogs_Basic(t *testing.T) {
 	}
 }
 
+func TestE2E_SDK_ComputeResult(t *testing.T) {
+	fr := newFramework(t, WithKettleAddress())
+	defer fr.Close()
+
+	clt := fr.NewSDKClient()
+
+	contractAddr := common.Address{0x3}
+	sourceContract := sdk.GetContract(contractAddr, exampleCallSourceContract.Abi, clt)
+
+	expected, err := exampleCallSourceContract.Abi.Pack("emitLogCallback", big.NewInt(10))
+	require.NoError(t, err)
+
+	// a call runs the confidential request without sending it
+	vals, err := sourceContract.Call("emitLog", []interface{}{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, expected, vals[0])
+
+	res, err := sourceContract.SendTransaction("emitLog", []interface{}{}, nil)
+	require.NoError(t, err)
+
+	fr.suethSrv.ProgressChain()
+
+	// the compute result is split from the logs emitted while computing it
+	computeResult, err := res.ComputeResult()
+	require.NoError(t, err)
+	require.Equal(t, expected, computeResult)
+
+	logs, err := res.Logs()
+	require.NoError(t, err)
+	require.Len(t, logs, 5)
+
+	for i, log := range logs {
+		require.Equal(t, contractAddr, log.Address)
+		require.Len(t, log.Topics, i)
+		require.Equal(t, uint(i), log.Index)
+	}
+}
+
+func TestE2E_TraceConfidentialCall(t *testing.T) {
+	fr := newFramework(t, WithKettleAddress())
+	defer fr.Close()
+
+	rpc := fr.suethSrv.RPCNode()
+
+	{
+		// the compute result is split from the logs emitted while computing it
+		calldata, err := exampleCallSourceContract.Abi.Pack("emitLog")
+		require.NoError(t, err)
+		expected, err := exampleCallSourceContract.Abi.Pack("emitLogCallback", big.NewInt(10))
+		require.NoError(t, err)
+
+		contractAddr := common.Address{0x3}
+		var trace ethapi.ConfidentialCallTrace
+		requireNoRpcError(t, rpc.Call(&trace, "eth_traceConfidentialCall", setTxArgsDefaults(ethapi.TransactionArgs{
+			To:   &contractAddr,
+			Data: (*hexutil.Bytes)(&calldata),
+		})))
+
+		require.Empty(t, trace.Error)
+		require.Equal(t, expected, []byte(trace.ComputeResult))
+		require.Len(t, trace.Logs, 5)
+		require.Empty(t, trace.Records)
+	}
+
+	{
+		// the records created are listed, but not stored
+		targetBlock := uint64(16103213)
+		allowedPeekers := []common.Address{{0x41, 0x42, 0x43}, newBundleBidAddress}
+
+		bundleBytes, err := json.Marshal(&types.SBundle{Txs: types.Transactions{types.NewTx(&types.LegacyTx{})}})
+		require.NoError(t, err)
+		confidentialDataBytes, err := BundleContract.Abi.Methods["fetchConfidentialBundleData"].Outputs.Pack(bundleBytes)
+		require.NoError(t, err)
+		calldata, err := BundleContract.Abi.Pack("newBundle", targetBlock, allowedPeekers, []common.Address{})
+		require.NoError(t, err)
+
+		var trace ethapi.ConfidentialCallTrace
+		requireNoRpcError(t, rpc.Call(&trace, "eth_traceConfidentialCall", setTxArgsDefaults(ethapi.TransactionArgs{
+			To:                 &newBundleBidAddress,
+			Data:               (*hexutil.Bytes)(&calldata),
+			ConfidentialInputs: (*hexutil.Bytes)(&confidentialDataBytes),
+		})))
+
+		require.Empty(t, trace.Error)
+		require.Len(t, trace.Records, 1)
+		require.Equal(t, targetBlock, uint64(trace.Records[0].DecryptionCondition))
+		require.Equal(t, allowedPeekers, trace.Records[0].AllowedPeekers)
+		require.Contains(t, trace.Records[0].Keys, "default:v0:ethBundles")
+
+		var names []string
+		for _, call := range trace.Precompiles {
+			require.Equal(t, newBundleBidAddress, call.Caller)
+			names = append(names, call.Name)
+		}
+		require.Contains(t, names, "confidentialInputs")
+		require.Contains(t, names, "newDataRecord")
+		require.Contains(t, names, "confidentialStore")
+
+		var id types.DataId
+		copy(id[:], trace.Records[0].Id)
+		_, err = fr.ConfidentialEngine().FetchRecordByID(id)
+		require.Error(t, err)
+	}
+
+	{
+		// a failed request returns its trace with the error
+		calldata, err := BundleContract.Abi.Pack("newBundle", uint64(1), []common.Address{}, []common.Address{})
+		require.NoError(t, err)
+
+		var trace ethapi.ConfidentialCallTrace
+		requireNoRpcError(t, rpc.Call(&trace, "eth_traceConfidentialCall", setTxArgsDefaults(ethapi.TransactionArgs{
+			To:   &newBundleBidAddress,
+			Data: (*hexutil.Bytes)(&calldata),
+		})))
+		require.NotEmpty(t, trace.Error)
+	}
+}
+
+func TestE2E_SDK_ConcurrentRequests(t *testing.T) {
+	fr := newFramework(t, WithKettleAddress())
+	defer fr.Close()
+
+	clt := fr.NewSDKClient()
+
+	contractAddr := common.Address{0x3}
+	sourceContract := sdk.GetContract(contractAddr, exampleCallSourceContract.Abi, clt)
+
+	// concurrent requests from the same key do not collide on their nonces
+	var (
+		wg      sync.WaitGroup
+		results = make([]*sdk.TransactionResult, 10)
+		errs    = make([]error, len(results))
+	)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = sourceContract.SendTransaction("emitLog", []interface{}{}, nil)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	// the receipts are waited for on the new heads of the node
+	receipts := make(chan *types.Receipt, len(results))
+	for _, res := range results {
+		go func(res *sdk.TransactionResult) {
+			receipt, err := res.Wait()
+			if err != nil {
+				t.Error(err)
+			}
+			receipts <- receipt
+		}(res)
+	}
+
+	fr.suethSrv.ProgressChain()
+
+	for range results {
+		receipt := <-receipts
+		require.NotNil(t, receipt)
+		require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	}
+
+	// a failed request does not leave a nonce gap behind
+	_, err := sourceContract.SendTransaction("callTarget", []interface{}{contractAddr, big.NewInt(102)}, nil)
+	require.Error(t, err)
+
+	res, err := sourceContract.SendTransaction("emitLog", []interface{}{}, nil)
+	require.NoError(t, err)
+
+	fr.suethSrv.ProgressChain()
+
+	receipt, err := res.Wait()
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+}
+
 func TestE2E_EstimateGas(t *testing.T) {
 	t.Parallel()
 
@@ -1494,7 +1666,9 @@ var defaultFrameworkConfig = frameworkConfig{
 	kettleAddress:     false,
 	redisStoreBackend: false,
 	suaveConfig: suave.Config{
-		ExternalWhitelist: []string{"*"},
+		ExternalWhitelist:      []string{"*"},
+		MevShareRefundPercent:  suave.DefaultConfig.MevShareRefundPercent,
+		MevShareInclusionRange: suave.DefaultConfig.MevShareInclusionRange,
 	},
 }
 
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+	"gopkg.in/yaml.v3"
+)
+
+// --- typescript and python bindings generation ---
+
+//go:embed templates/suave.ts.tpl
+var typeScriptTemplate string
+
+//go:embed templates/suave.py.tpl
+var pythonTemplate string
+
+func generateBindings(templateText string, dd desc, out string) error {
+	str, err := renderBindings(templateText, dd)
+	if err != nil {
+		return err
+	}
+	return outputFile(out, str)
+}
+
+// renderBindings applies a bindings template to the description. Unlike
+// applyTemplate, the output is not html escaped since it is not Go nor
+// Solidity code.
+func renderBindings(templateText string, dd desc) (string, error) {
+	raw, err := yaml.Marshal(dd)
+	if err != nil {
+		return "", err
+	}
+	hash := crypto.Keccak256(raw)
+
+	abiFields := buildABI(dd)
+	abiRaw, err := marshalABI(abiFields)
+	if err != nil {
+		return "", err
+	}
+	abiJSON, err := json.MarshalIndent(abiFields, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	suaveABI, err := abi.JSON(bytes.NewReader(abiRaw))
+	if err != nil {
+		return "", err
+	}
+
+	types := newSpecTypes(dd)
+	funcMap := template.FuncMap{
+		"hash": func() string {
+			return hex.EncodeToString(hash)
+		},
+		"title":          strings.Title,
+		"snake":          toSnakeName,
+		"encodeAddrName": toAddressName,
+		"tsType":         types.typeScript,
+		"pyType":         types.python,
+		"abiType":        types.abiType,
+		"pyToAbi":        types.pythonToABI,
+		"pyFromAbi":      types.pythonFromABI,
+		"abiJSON": func() string {
+			return string(abiJSON)
+		},
+		"abiIndex": func(name string) (int, error) {
+			for i, f := range abiFields {
+				if f.Name == name {
+					return i, nil
+				}
+			}
+			return 0, fmt.Errorf("%s not found in the abi", name)
+		},
+		"selector": func(name string) (string, error) {
+			abiErr, ok := suaveABI.Errors[name]
+			if !ok {
+				return "", fmt.Errorf("error %s not found in the abi", name)
+			}
+			return hex.EncodeToString(abiErr.ID[:4]), nil
+		},
+	}
+
+	t, err := template.New("template").Funcs(funcMap).Parse(templateText)
+	if err != nil {
+		return "", err
+	}
+	var out bytes.Buffer
+	if err := t.Execute(&out, dd); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// specTypes resolves the types of the spec to their bindings in other languages.
+type specTypes struct {
+	aliases map[string]string
+	enums   map[string]struct{}
+	structs map[string]structsDef
+}
+
+func newSpecTypes(dd desc) *specTypes {
+	s := &specTypes{
+		aliases: make(map[string]string),
+		enums:   make(map[string]struct{}),
+		structs: make(map[string]structsDef),
+	}
+	for _, t := range dd.Types {
+		s.aliases[t.Name] = t.Typ
+	}
+	for _, e := range dd.Enums {
+		s.enums[e.Name] = struct{}{}
+	}
+	for _, st := range dd.Structs {
+		s.structs[st.Name] = st
+	}
+	return s
+}
+
+// typeScript returns the viem type of an abi type. The integers follow the
+// types of abitype: a number up to 48 bits and a bigint above.
+func (s *specTypes) typeScript(typ string) string {
+	if strings.HasSuffix(typ, "[]") {
+		return s.typeScript(strings.TrimSuffix(typ, "[]")) + "[]"
+	}
+	t, err := abi.NewType(typ, "", nil)
+	if err != nil {
+		// custom type, struct or enum with a binding of the same name
+		return typ
+	}
+	switch t.T {
+	case abi.AddressTy:
+		return "Address"
+	case abi.BoolTy:
+		return "boolean"
+	case abi.StringTy:
+		return "string"
+	case abi.BytesTy, abi.FixedBytesTy:
+		return "Hex"
+	case abi.IntTy, abi.UintTy:
+		if t.Size <= 48 {
+			return "number"
+		}
+		return "bigint"
+	}
+	panic(fmt.Sprintf("typescript type not done for type: %s", typ))
+}
+
+// python returns the python type of an abi type, as encoded and decoded by
+// eth_abi.
+func (s *specTypes) python(typ string) string {
+	if strings.HasSuffix(typ, "[]") {
+		return "List[" + s.python(strings.TrimSuffix(typ, "[]")) + "]"
+	}
+	t, err := abi.NewType(typ, "", nil)
+	if err != nil {
+		// custom type, struct or enum with a binding of the same name
+		return typ
+	}
+	switch t.T {
+	case abi.AddressTy, abi.StringTy:
+		return "str"
+	case abi.BoolTy:
+		return "bool"
+	case abi.BytesTy, abi.FixedBytesTy:
+		return "bytes"
+	case abi.IntTy, abi.UintTy:
+		return "int"
+	}
+	panic(fmt.Sprintf("python type not done for type: %s", typ))
+}
+
+// abiType returns the canonical abi type of a type, with the structs encoded
+// as tuples.
+func (s *specTypes) abiType(typ string) string {
+	if strings.HasSuffix(typ, "[]") {
+		return s.abiType(strings.TrimSuffix(typ, "[]")) + "[]"
+	}
+	if alias, ok := s.aliases[typ]; ok {
+		return alias
+	}
+	if _, ok := s.enums[typ]; ok {
+		return "uint8"
+	}
+	if st, ok := s.structs[typ]; ok {
+		fields := make([]string, len(st.Fields))
+		for i, f := range st.Fields {
+			fields[i] = s.abiType(f.Typ)
+		}
+		return "(" + strings.Join(fields, ",") + ")"
+	}
+	return typ
+}
+
+// pythonToABI returns the python expression converting a value of the type to
+// the value encoded by eth_abi.
+func (s *specTypes) pythonToABI(expr string, typ string) string {
+	if strings.HasSuffix(typ, "[]") {
+		elem := strings.TrimSuffix(typ, "[]")
+		if conv := s.pythonToABI("v", elem); conv != "v" {
+			return fmt.Sprintf("[%s for v in %s]", conv, expr)
+		}
+		return expr
+	}
+	if _, ok := s.enums[typ]; ok {
+		return fmt.Sprintf("int(%s)", expr)
+	}
+	if _, ok := s.structs[typ]; ok {
+		return fmt.Sprintf("%s.to_abi()", expr)
+	}
+	return expr
+}
+
+// pythonFromABI returns the python expression converting a value decoded by
+// eth_abi to a value of the type.
+func (s *specTypes) pythonFromABI(expr string, typ string) string {
+	if strings.HasSuffix(typ, "[]") {
+		// eth_abi decodes the arrays as tuples
+		if conv := s.pythonFromABI("v", strings.TrimSuffix(typ, "[]")); conv != "v" {
+			return fmt.Sprintf("[%s for v in %s]", conv, expr)
+		}
+		return fmt.Sprintf("list(%s)", expr)
+	}
+	if _, ok := s.enums[typ]; ok {
+		return fmt.Sprintf("%s(%s)", typ, expr)
+	}
+	if _, ok := s.structs[typ]; ok {
+		return fmt.Sprintf("%s.from_abi(%s)", typ, expr)
+	}
+	return expr
+}
+
+// toSnakeName converts a camel case name to snake case, keeping the acronyms
+// together (i.e. doHTTPRequest to do_http_request).
+func toSnakeName(input string) string {
+	var result strings.Builder
+
+	runes := []rune(input)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if !unicode.IsUpper(prev) || nextLower {
+				result.WriteString("_")
+			}
+		}
+		result.WriteRune(unicode.ToLower(r))
+	}
+	return result.String()
+}
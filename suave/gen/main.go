@@ -7,7 +7,6 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	goformat "go/format"
 	"html/template"
 	"os"
 	"os/exec"
@@ -18,6 +17,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/tools/imports"
 	"gopkg.in/yaml.v3"
 )
 
@@ -60,6 +60,9 @@ func applyTemplate(templateText string, input desc, out string) error {
 		"styp": func(param interface{}) string {
 			return encodeTypeName(param.(string), true, false)
 		},
+		"verb": func(param interface{}) string {
+			return encodeTypeVerb(param.(string))
+		},
 	}
 
 	t, err := template.New("template").Funcs(funcMap).Parse(templateText)
@@ -110,40 +113,10 @@ func main() {
 	flag.BoolVar(&writeFlag, "write", false, "write the output to the file")
 	flag.Parse()
 
-	data, err := os.ReadFile("./suave/gen/suave_spec.yaml")
+	ff, err := readSpec("./suave/gen/suave_spec.yaml")
 	if err != nil {
 		panic(err)
 	}
-	var ff desc
-	if err := yaml.Unmarshal(data, &ff); err != nil {
-		panic(err)
-	}
-
-	// sort the structs by name
-	sort.Slice(ff.Structs, func(i, j int) bool {
-		return ff.Structs[i].Name < ff.Structs[j].Name
-	})
-
-	// sort the methods by name
-	sort.Slice(ff.Functions, func(i, j int) bool {
-		return ff.Functions[i].Name < ff.Functions[j].Name
-	})
-
-	funcsByName := make(map[string]struct{})
-	funcsByAddr := make(map[string]struct{})
-	for _, f := range ff.Functions {
-		// validate that there are no two functions with the same name
-		if _, ok := funcsByName[f.Name]; ok {
-			panic(fmt.Sprintf("duplicate function name: %s", f.Name))
-		}
-		funcsByName[f.Name] = struct{}{}
-
-		// validate that there are no two functions with the same address
-		if _, ok := funcsByAddr[f.Address]; ok {
-			panic(fmt.Sprintf("duplicate function address: %s", f.Address))
-		}
-		funcsByAddr[f.Address] = struct{}{}
-	}
 
 	args := flag.Args()
 	if len(args) != 0 && args[0] == "docs" {
@@ -173,6 +146,106 @@ func main() {
 	if err := generateABI("./suave/artifacts/SuaveLib.json", ff); err != nil {
 		panic(err)
 	}
+
+	if err := generateBindings(typeScriptTemplate, ff, "./suave/artifacts/suave.ts"); err != nil {
+		panic(err)
+	}
+
+	if err := generateBindings(pythonTemplate, ff, "./suave/artifacts/suave.py"); err != nil {
+		panic(err)
+	}
+}
+
+// readSpec reads and prepares the description of the precompiles.
+func readSpec(path string) (desc, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return desc{}, err
+	}
+	var ff desc
+	if err := yaml.Unmarshal(data, &ff); err != nil {
+		return desc{}, err
+	}
+	if err := prepareSpec(&ff); err != nil {
+		return desc{}, err
+	}
+	return ff, nil
+}
+
+// prepareSpec resolves the names of the versioned functions, sorts the
+// description and validates it.
+func prepareSpec(ff *desc) error {
+	// a new version of a function ships with its own name and address so
+	// that the previous versions remain available
+	baseNames := make(map[string]struct{})
+	for _, f := range ff.Functions {
+		if f.Version <= 1 {
+			baseNames[f.Name] = struct{}{}
+		}
+	}
+	for i, f := range ff.Functions {
+		if f.Version <= 1 {
+			continue
+		}
+		if _, ok := baseNames[f.Name]; !ok {
+			return fmt.Errorf("function %s version %d has no previous version", f.Name, f.Version)
+		}
+		ff.Functions[i].Name = fmt.Sprintf("%sV%d", f.Name, f.Version)
+	}
+
+	// sort the structs by name
+	sort.Slice(ff.Structs, func(i, j int) bool {
+		return ff.Structs[i].Name < ff.Structs[j].Name
+	})
+
+	// sort the errors by name
+	sort.Slice(ff.Errors, func(i, j int) bool {
+		return ff.Errors[i].Name < ff.Errors[j].Name
+	})
+
+	// sort the methods by name
+	sort.Slice(ff.Functions, func(i, j int) bool {
+		return ff.Functions[i].Name < ff.Functions[j].Name
+	})
+
+	errorsByName := map[string]struct{}{
+		"PeekerReverted": {},
+	}
+	for _, s := range ff.Structs {
+		errorsByName[s.Name] = struct{}{}
+	}
+	for _, e := range ff.Errors {
+		// validate that the errors do not clash with the other declarations
+		// of the library
+		if _, ok := errorsByName[e.Name]; ok {
+			return fmt.Errorf("duplicate error name: %s", e.Name)
+		}
+		errorsByName[e.Name] = struct{}{}
+	}
+
+	funcsByName := make(map[string]struct{})
+	funcsByAddr := make(map[string]struct{})
+	for _, f := range ff.Functions {
+		// validate that there are no two functions with the same name
+		if _, ok := funcsByName[f.Name]; ok {
+			return fmt.Errorf("duplicate function name: %s", f.Name)
+		}
+		funcsByName[f.Name] = struct{}{}
+
+		// validate that there are no two functions with the same address
+		if _, ok := funcsByAddr[f.Address]; ok {
+			return fmt.Errorf("duplicate function address: %s", f.Address)
+		}
+		funcsByAddr[f.Address] = struct{}{}
+
+		// validate that the function only reverts with declared errors
+		for _, name := range f.Errors {
+			if _, ok := errorsByName[name]; !ok || name == "PeekerReverted" {
+				return fmt.Errorf("function %s reverts with unknown error: %s", f.Name, name)
+			}
+		}
+	}
+	return nil
 }
 
 func encodeTypeToGolang(str string, insideTypes bool, slicePointers bool) string {
@@ -232,7 +305,12 @@ var structsTemplate = `// Code generated by suave/gen. DO NOT EDIT.
 // Hash: {{hash}}
 package types
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 {{range .Types}}
 type {{.Name}} {{typ3 .Typ}}
@@ -246,6 +324,19 @@ type {{.Name}} struct {
 }
 {{end}}
 
+// Errors
+{{range .Errors}}
+// {{.Name}}Error is the typed error {{.Name}} of the precompiles.
+type {{.Name}}Error struct {
+	{{range .Fields}}{{title .Name}} {{typ3 .Typ}}
+	{{end}}
+}
+
+func (e {{.Name}}Error) Error() string {
+	return fmt.Sprintf("{{.Name}}({{range $index, $item := .Fields}}{{if $index}}, {{end}}{{.Name}}: {{verb .Typ}}{{end}})", {{range .Fields}}e.{{title .Name}}, {{end}})
+}
+{{end}}
+
 {{range .Enums}}
 type {{.Name}} uint8
 
@@ -264,16 +355,17 @@ var adapterTemplate = `// Code generated by suave/gen. DO NOT EDIT.
 package vm
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/suave/artifacts"
 	"github.com/mitchellh/mapstructure"
-	"math/big"
 )
 
-var _ = new(big.Int)
-
 var (
 	errFailedToUnpackInput = fmt.Errorf("failed to decode input")
 	errFailedToDecodeField = fmt.Errorf("failed to decode field")
@@ -281,7 +373,8 @@ var (
 )
 
 type SuaveRuntime interface {
-	{{range .Functions}}
+	{{range .Functions}}{{if .Deprecated}}
+	// Deprecated: {{.Deprecated}}{{end}}
 	{{.Name}}({{range .Input}}{{.Name}} {{typ2 .Typ}}, {{end}}) ({{range .Output.Fields}}{{typ2 .Typ}}, {{end}}error){{end}}
 }
 
@@ -307,6 +400,52 @@ func (b *SuaveRuntimeAdapter) run(addr common.Address, input []byte) ([]byte, er
 	}
 }
 
+// packSuaveError encodes a typed error of the precompiles as its ABI revert data.
+func packSuaveError(err error) ([]byte, bool) {
+	{{- range .Errors}}
+	if e := (types.{{.Name}}Error{}); errors.As(err, &e) {
+		return packSuaveErrorArgs("{{.Name}}", {{range .Fields}}e.{{title .Name}}, {{end}})
+	}
+	{{- end}}
+	return nil, false
+}
+
+func packSuaveErrorArgs(name string, args ...interface{}) ([]byte, bool) {
+	abiErr := artifacts.SuaveAbi.Errors[name]
+	data, err := abiErr.Inputs.Pack(args...)
+	if err != nil {
+		return nil, false
+	}
+	return append(abiErr.ID[:4:4], data...), true
+}
+
+// UnpackSuaveError decodes the revert data of a precompile into its typed error,
+// or returns nil if it does not carry one.
+func UnpackSuaveError(data []byte) error {
+	if len(data) < 4 {
+		return nil
+	}
+	{{- range .Errors}}
+	if abiErr := artifacts.SuaveAbi.Errors["{{.Name}}"]; bytes.Equal(data[:4], abiErr.ID[:4]) {
+		unpacked, err := abiErr.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil
+		}
+		var e types.{{.Name}}Error
+		{{- range $index, $item := .Fields}}{{ if isComplex .Typ }}
+		if err = mapstructure.Decode(unpacked[{{$index}}], &e.{{title .Name}}); err != nil {
+			return nil
+		}
+		{{- else if eq .Typ "bytes32"}}
+		e.{{title .Name}} = common.Hash(unpacked[{{$index}}].([32]byte)){{else}}
+		e.{{title .Name}} = unpacked[{{$index}}].({{typ2 .Typ}}){{end}}
+		{{- end}}
+		return e
+	}
+	{{- end}}
+	return nil
+}
+
 {{range .Functions}}
 func (b *SuaveRuntimeAdapter) {{.Name}}(input []byte) (res []byte, err error) {
 	var (
@@ -332,7 +471,7 @@ func (b *SuaveRuntimeAdapter) {{.Name}}(input []byte) (res []byte, err error) {
 		err = errFailedToDecodeField
 		return
 	}
-	{{else}}{{.Name}} = unpacked[{{$index}}].({{typ2 .Typ}}){{end}}
+	{{else if eq .Typ "bytes32"}}{{.Name}} = common.Hash(unpacked[{{$index}}].([32]byte)){{else}}{{.Name}} = unpacked[{{$index}}].({{typ2 .Typ}}){{end}}
 	{{end}}
 
 	var (
@@ -393,6 +532,14 @@ pragma solidity ^0.8.8;
 library Suave {
     error PeekerReverted(address, bytes);
 
+{{range .Errors}}
+/// @notice {{.Description}}
+{{- range .Fields}}
+/// @param {{.Name}} {{.Description}}
+{{- end}}
+error {{.Name}}({{range .Fields}}{{.Typ}} {{.Name}}, {{end}});
+{{end}}
+
 {{range .Enums}}
 	enum {{.Name}} {
 		{{ range $index, $element := .Values}}
@@ -449,6 +596,12 @@ function isConfidential() internal returns (bool b) {
 {{- range .Output.Fields}}
 /// @return {{.Name}} {{.Description}}
 {{- end}}
+{{- range .Errors}}
+/// @custom:error {{.}} wrapped in PeekerReverted
+{{- end}}
+{{- if .Deprecated}}
+/// @custom:deprecated {{.Deprecated}}
+{{- end}}
 function {{.Name}}({{range .Input}}{{styp .Typ}} {{.Name}}, {{end}}) internal returns ({{range .Output.Fields}}{{styp .Typ}}, {{end}}) {
 	{{if .IsConfidential}}require(isConfidential());{{end}}
 	(bool success, bytes memory data) = {{encodeAddrName .Name}}.call(abi.encode({{range .Input}}{{.Name}}, {{end}}));
@@ -474,6 +627,22 @@ type functionDef struct {
 	Output         output
 	IsConfidential bool `yaml:"isConfidential"`
 	Description    string
+
+	// Version of the function, a version above 1 is exposed as NameV<Version>
+	// at its own address alongside the previous versions.
+	Version int `yaml:",omitempty"`
+
+	// Deprecated describes what to use instead of a deprecated function.
+	Deprecated string `yaml:",omitempty"`
+
+	// Errors are the typed errors the function reverts with.
+	Errors []string `yaml:",omitempty"`
+}
+
+type errorDef struct {
+	Name        string
+	Description string
+	Fields      []field
 }
 
 type output struct {
@@ -508,6 +677,7 @@ type desc struct {
 	Types     []typ
 	Enums     []enumDef
 	Structs   []structsDef
+	Errors    []errorDef `yaml:",omitempty"`
 	Functions []functionDef
 }
 
@@ -526,8 +696,9 @@ func toAddressName(input string) string {
 	return result.String()
 }
 
+// formatGo formats the generated code, dropping the imports it does not use.
 func formatGo(code string) (string, error) {
-	srcFormatted, err := goformat.Source([]byte(code))
+	srcFormatted, err := imports.Process("", []byte(code), nil)
 	if err != nil {
 		return "", err
 	}
@@ -580,6 +751,35 @@ type arguments struct {
 }
 
 func generateABI(out string, dd desc) error {
+	raw, err := marshalABI(buildABI(dd))
+	if err != nil {
+		return err
+	}
+	if err := outputFile(out, string(raw)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// marshalABI encodes the ABI and validates that abi.ABI decodes it.
+func marshalABI(abiEncode []*abiField) ([]byte, error) {
+	// marshal the object
+	raw, err := json.Marshal(abiEncode)
+	if err != nil {
+		return nil, err
+	}
+
+	// try to decode the output with abi.ABI to validate
+	// that the result is correct
+	if _, err := abi.JSON(bytes.NewReader(raw)); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// buildABI returns the ABI of the Suave library: the PeekerReverted error,
+// the typed errors and the precompiles.
+func buildABI(dd desc) []*abiField {
 	abiEncode := []*abiField{}
 
 	var encodeType func(name, typ string) arguments
@@ -650,6 +850,18 @@ func generateABI(out string, dd desc) error {
 	}
 	abiEncode = append(abiEncode, peekerReverted)
 
+	for _, e := range dd.Errors {
+		field := &abiField{
+			Name:   e.Name,
+			Type:   "error",
+			Inputs: []arguments{},
+		}
+		for _, i := range e.Fields {
+			field.Inputs = append(field.Inputs, encodeType(i.Name, i.Typ))
+		}
+		abiEncode = append(abiEncode, field)
+	}
+
 	for _, f := range dd.Functions {
 		field := &abiField{
 			Name:   f.Name,
@@ -666,23 +878,7 @@ func generateABI(out string, dd desc) error {
 
 		abiEncode = append(abiEncode, field)
 	}
-
-	// marshal the object
-	raw, err := json.Marshal(abiEncode)
-	if err != nil {
-		return err
-	}
-
-	// try to decode the output with abi.ABI to validate
-	// that the result is correct
-	if _, err := abi.JSON(bytes.NewReader(raw)); err != nil {
-		return err
-	}
-
-	if err := outputFile(out, string(raw)); err != nil {
-		return err
-	}
-	return nil
+	return abiEncode
 }
 
 func outputFile(out string, str string) error {
@@ -702,6 +898,21 @@ func outputFile(out string, str string) error {
 	return nil
 }
 
+// encodeTypeVerb returns the formatting verb printing a value of the type in
+// the message of a typed error.
+func encodeTypeVerb(typName string) string {
+	switch typName {
+	case "string":
+		return "%q"
+	case "bytes":
+		return "0x%x"
+	}
+	if strings.HasPrefix(typName, "bytes") || typName == "DataId" {
+		return "%x"
+	}
+	return "%v"
+}
+
 func encodeTypeName(typName string, addMemory bool, addLink bool) string {
 	var isMemoryType bool
 
@@ -2,6 +2,8 @@ package main
 
 import (
 	"encoding/hex"
+	"flag"
+	"os"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -61,3 +63,145 @@ func TestDecodeABI_PeekerReverted(t *testing.T) {
 	require.Equal(t, addr.String(), "0x0000000000000000000000000000000042100000")
 	require.Equal(t, string(reason), "bad")
 }
+
+func TestPrepareSpec_Versions(t *testing.T) {
+	ff := &desc{
+		Functions: []functionDef{
+			{Name: "foo", Address: "0x1", Deprecated: "Use fooV2"},
+			{Name: "foo", Address: "0x2", Version: 2},
+		},
+	}
+	require.NoError(t, prepareSpec(ff))
+	require.Equal(t, "foo", ff.Functions[0].Name)
+	require.Equal(t, "fooV2", ff.Functions[1].Name)
+	require.Equal(t, "FOO_V2", toAddressName(ff.Functions[1].Name))
+
+	// a new version needs a previous one
+	ff = &desc{
+		Functions: []functionDef{
+			{Name: "foo", Address: "0x2", Version: 2},
+		},
+	}
+	require.Error(t, prepareSpec(ff))
+
+	// each version has its own address
+	ff = &desc{
+		Functions: []functionDef{
+			{Name: "foo", Address: "0x1"},
+			{Name: "foo", Address: "0x1", Version: 2},
+		},
+	}
+	require.Error(t, prepareSpec(ff))
+}
+
+func TestPrepareSpec_Errors(t *testing.T) {
+	cases := []struct {
+		errors []errorDef
+		refs   []string
+		valid  bool
+	}{
+		{[]errorDef{{Name: "Failed"}}, []string{"Failed"}, true},
+		{[]errorDef{{Name: "Failed"}}, []string{"Other"}, false},
+		{[]errorDef{{Name: "Failed"}, {Name: "Failed"}}, nil, false},
+		{[]errorDef{{Name: "PeekerReverted"}}, nil, false},
+		{[]errorDef{{Name: "DataRecord"}}, nil, false},
+		{nil, []string{"PeekerReverted"}, false},
+	}
+
+	for _, c := range cases {
+		ff := &desc{
+			Structs:   []structsDef{{Name: "DataRecord"}},
+			Errors:    c.errors,
+			Functions: []functionDef{{Name: "foo", Address: "0x1", Errors: c.refs}},
+		}
+		if c.valid {
+			require.NoError(t, prepareSpec(ff))
+		} else {
+			require.Error(t, prepareSpec(ff))
+		}
+	}
+}
+
+var updateGolden = flag.Bool("update", false, "update the golden files of the bindings")
+
+func TestGenerateBindings_Golden(t *testing.T) {
+	ff, err := readSpec("testdata/spec.yaml")
+	require.NoError(t, err)
+
+	cases := []struct {
+		template string
+		golden   string
+	}{
+		{typeScriptTemplate, "testdata/suave.ts.golden"},
+		{pythonTemplate, "testdata/suave.py.golden"},
+	}
+	for _, c := range cases {
+		out, err := renderBindings(c.template, ff)
+		require.NoError(t, err)
+
+		if *updateGolden {
+			require.NoError(t, os.WriteFile(c.golden, []byte(out), 0644))
+		}
+		expected, err := os.ReadFile(c.golden)
+		require.NoError(t, err)
+		require.Equal(t, string(expected), out, "bindings do not match %s, run the tests with -update", c.golden)
+	}
+}
+
+func TestGenerateBindings_PythonErrors(t *testing.T) {
+	ff, err := readSpec("testdata/spec.yaml")
+	require.NoError(t, err)
+	errs := ff.Errors
+
+	// a spec without errors has no typed error to revert with
+	ff.Errors = nil
+	out, err := renderBindings(pythonTemplate, ff)
+	require.NoError(t, err)
+	require.Contains(t, out, "SuaveError = NoReturn\n")
+	require.NotContains(t, out, "Union[]")
+
+	other := errs[0]
+	other.Name = "Other"
+	ff.Errors = []errorDef{errs[0], other}
+	out, err = renderBindings(pythonTemplate, ff)
+	require.NoError(t, err)
+	require.Contains(t, out, "SuaveError = Union[ValueNotFoundError, OtherError]\n")
+}
+
+func TestGenerateBindings_UpToDate(t *testing.T) {
+	ff, err := readSpec("suave_spec.yaml")
+	require.NoError(t, err)
+
+	cases := []struct {
+		template string
+		artifact string
+	}{
+		{typeScriptTemplate, "../artifacts/suave.ts"},
+		{pythonTemplate, "../artifacts/suave.py"},
+	}
+	for _, c := range cases {
+		out, err := renderBindings(c.template, ff)
+		require.NoError(t, err)
+
+		expected, err := os.ReadFile(c.artifact)
+		require.NoError(t, err)
+		require.Equal(t, string(expected), out, "%s is out of date, run go run ./suave/gen -write", c.artifact)
+	}
+}
+
+func TestToSnakeName(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected string
+	}{
+		{"ethcall", "ethcall"},
+		{"fetchDataRecords", "fetch_data_records"},
+		{"doHTTPRequest", "do_http_request"},
+		{"doHTTPRequest2", "do_http_request2"},
+		{"buildEthBlockV2", "build_eth_block_v2"},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.expected, toSnakeName(c.name))
+	}
+}
@@ -15,6 +15,7 @@ custom_edit_url: "https://github.com/flashbots/suave-specs/edit/main/specs/rigil
 - [Available Precompiles](#available-precompiles)
   - [`IsConfidential`](#isconfidential){{range .Functions}}
   - [`{{.Name}}`](#{{.Name}}){{end}}
+- [Errors](#errors)
 - [Precompiles Governance](#precompiles-governance)
 
 <!-- /TOC -->
@@ -51,6 +52,10 @@ function isConfidential() internal view returns (bool b)
 
 Address: `{{.Address}}`
 
+{{ if .Deprecated -}}
+**Deprecated**: {{.Deprecated}}
+
+{{ end -}}
 {{.Description}}
 
 ```solidity
@@ -70,6 +75,32 @@ Outputs:
 - `{{.Name}}` ({{.Typ}}): {{.Description}}
 {{- end}}
 {{- end }}
+
+{{ if ne (len .Errors) 0 -}}
+Errors:
+{{range .Errors}}
+- [`{{.}}`](#{{.}})
+{{- end}}
+{{- end }}
+{{end}}
+
+## Errors
+
+Precompiles revert with `PeekerReverted(address, bytes)`. The bytes are either the message of the error or the ABI encoding of one of the following typed errors, which contracts can decode with `abi.decode` after checking its selector.
+{{range .Errors}}
+### `{{.Name}}`
+
+{{.Description}}
+
+```solidity
+error {{.Name}}({{range .Fields}}{{.Typ}} {{.Name}}, {{end}})
+```
+{{ if ne (len .Fields) 0 }}
+Fields:
+{{range .Fields}}
+- `{{.Name}}` ({{.Typ}}): {{.Description}}
+{{- end}}
+{{- end }}
 {{end}}
 
 ## Precompiles Governance
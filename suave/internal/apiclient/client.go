@@ -0,0 +1,81 @@
+// Package apiclient implements the requests to the JSON REST APIs of the
+// consensus layer, such as the beacon node and the mev-boost relay APIs.
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Client sends GET requests to an API and decodes its JSON responses.
+type Client struct {
+	client *http.Client
+	url    string
+	name   string // name of the API in the errors
+}
+
+// New returns a client of the API at the url, using the default client if nil.
+func New(client *http.Client, url string, name string) *Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Client{client: client, url: strings.TrimSuffix(url, "/"), name: name}
+}
+
+// Get requests the path with the query and decodes the JSON response into result.
+func (c *Client) Get(ctx context.Context, path string, query url.Values, result interface{}) error {
+	endpoint := c.url + path
+	if len(query) != 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("%s error on %s: %d: %s", c.name, path, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("could not decode %s response on %s: %w", c.name, path, err)
+	}
+	return nil
+}
+
+// Uint64 is an uint64 encoded as a decimal string, as used by the consensus
+// layer APIs. Empty strings decode to zero.
+type Uint64 uint64
+
+func (u *Uint64) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	if str == "" {
+		*u = 0
+		return nil
+	}
+	val, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return err
+	}
+	*u = Uint64(val)
+	return nil
+}
@@ -0,0 +1,351 @@
+// Package plugins runs precompiles out of process. The operator of a kettle
+// registers the plugins in its config, and the MEVM forwards the calls to their
+// addresses over JSON-RPC, either on a unix socket or over http.
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/suave/artifacts"
+)
+
+const (
+	// DefaultTimeout bounds the calls of the plugins without a timeout.
+	DefaultTimeout = 5 * time.Second
+
+	// DefaultGas is the gas of a call to a plugin without a gas cost.
+	DefaultGas = 1000
+
+	// runMethod is the JSON-RPC method served by the plugins.
+	runMethod = "precompile_run"
+)
+
+// isConfidentialAddr is the address of the builtin isConfidential precompile,
+// which is not part of the generated precompiles.
+var isConfidentialAddr = common.HexToAddress("0x42010000")
+
+// Spec is the JSON config of a plugin.
+type Spec struct {
+	// Name of the precompile, its ABI must define a function of this name.
+	Name string `json:"name"`
+	// Address the precompile is called at.
+	Address common.Address `json:"address"`
+	// Endpoint of the plugin, the path of a unix socket or an http(s) URL.
+	Endpoint string `json:"endpoint"`
+	// Timeout of a call, as a duration string (i.e. "500ms"). Defaults to DefaultTimeout.
+	Timeout string `json:"timeout,omitempty"`
+	// Gas of a call. Defaults to DefaultGas.
+	Gas uint64 `json:"gas,omitempty"`
+	// ABI of the precompile.
+	ABI json.RawMessage `json:"abi"`
+	// Records are the names of the bytes16 inputs which are data ids of the
+	// confidential store. The precompile and its caller must be allowed to peek
+	// into these records, as for the builtin precompiles.
+	Records []string `json:"records,omitempty"`
+	// Keys are the confidential store keys of the records forwarded to the plugin.
+	Keys []string `json:"keys,omitempty"`
+}
+
+// Plugin is a precompile served by an external process.
+type Plugin struct {
+	Name    string
+	Address common.Address
+	Timeout time.Duration
+	Gas     uint64
+	// Method is the function of the precompile in its ABI.
+	Method abi.Method
+	// Records are the indexes of the inputs which are data ids.
+	Records []int
+	// Keys are the confidential store keys forwarded to the plugin.
+	Keys []string
+
+	endpoint string
+
+	lock   sync.Mutex
+	client *rpc.Client
+}
+
+// Record is a record of the confidential store the call refers to.
+type Record struct {
+	ID                  hexutil.Bytes            `json:"id"`
+	DecryptionCondition hexutil.Uint64           `json:"decryptionCondition"`
+	AllowedPeekers      []common.Address         `json:"allowedPeekers"`
+	AllowedStores       []common.Address         `json:"allowedStores"`
+	Version             string                   `json:"version"`
+	Values              map[string]hexutil.Bytes `json:"values,omitempty"`
+}
+
+// Request is a call of the precompile, as sent to the plugin.
+type Request struct {
+	// Address of the precompile.
+	Address common.Address `json:"address"`
+	// Input is the ABI encoded input of the precompile, with the selector of its function.
+	Input hexutil.Bytes `json:"input"`
+	// Caller is the closest contract calling the precompile.
+	Caller common.Address `json:"caller"`
+	// Kettle is the address of the kettle executing the request.
+	Kettle common.Address `json:"kettle"`
+	// Records are the records of the data ids in the input, with the values of the configured keys.
+	Records []*Record `json:"records,omitempty"`
+}
+
+// RevertError is the error of a plugin which reverts with data. A plugin
+// returns it to revert with the ABI encoding of a custom error.
+type RevertError struct {
+	Reason string
+	Data   []byte
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return "plugin reverted"
+	}
+	return e.Reason
+}
+
+// ErrorCode returns the JSON-RPC error code of the revert.
+func (e *RevertError) ErrorCode() int {
+	return 3
+}
+
+// ErrorData returns the hex encoded revert data.
+func (e *RevertError) ErrorData() interface{} {
+	return hexutil.Encode(e.Data)
+}
+
+// LoadSpecFile reads a plugin from a JSON spec file.
+func LoadSpecFile(path string) (*Plugin, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	plugin, err := LoadSpec(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plugin spec %s: %w", path, err)
+	}
+	return plugin, nil
+}
+
+// LoadSpec reads a plugin from its JSON spec.
+func LoadSpec(data []byte) (*Plugin, error) {
+	var spec Spec
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&spec); err != nil {
+		return nil, err
+	}
+	return New(&spec)
+}
+
+// New returns the plugin of a spec. The plugin is dialed on its first call.
+func New(spec *Spec) (*Plugin, error) {
+	if spec.Name == "" {
+		return nil, errors.New("missing name")
+	}
+	if spec.Address == (common.Address{}) {
+		return nil, errors.New("missing address")
+	}
+	if spec.Endpoint == "" {
+		return nil, errors.New("missing endpoint")
+	}
+
+	plugin := &Plugin{
+		Name:     spec.Name,
+		Address:  spec.Address,
+		Timeout:  DefaultTimeout,
+		Gas:      DefaultGas,
+		Keys:     spec.Keys,
+		endpoint: spec.Endpoint,
+	}
+	if spec.Timeout != "" {
+		timeout, err := time.ParseDuration(spec.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout: %w", err)
+		}
+		if timeout <= 0 {
+			return nil, fmt.Errorf("invalid timeout: %s", spec.Timeout)
+		}
+		plugin.Timeout = timeout
+	}
+	if spec.Gas != 0 {
+		plugin.Gas = spec.Gas
+	}
+
+	if len(spec.ABI) == 0 {
+		return nil, errors.New("missing abi")
+	}
+	parsed, err := abi.JSON(bytes.NewReader(spec.ABI))
+	if err != nil {
+		return nil, fmt.Errorf("invalid abi: %w", err)
+	}
+	method, ok := parsed.Methods[spec.Name]
+	if !ok {
+		return nil, fmt.Errorf("abi has no function %s", spec.Name)
+	}
+	plugin.Method = method
+
+	for _, name := range spec.Records {
+		index := -1
+		for i, input := range method.Inputs {
+			if input.Name == name {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			return nil, fmt.Errorf("record %s is not an input of %s", name, spec.Name)
+		}
+		if typ := method.Inputs[index].Type; typ.T != abi.FixedBytesTy || typ.Size != 16 {
+			return nil, fmt.Errorf("record %s is a %s, expected bytes16", name, typ.String())
+		}
+		plugin.Records = append(plugin.Records, index)
+	}
+	if len(spec.Keys) != 0 && len(spec.Records) == 0 {
+		return nil, errors.New("keys set without records")
+	}
+	return plugin, nil
+}
+
+func (p *Plugin) dial(ctx context.Context) (*rpc.Client, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.client != nil {
+		return p.client, nil
+	}
+	var (
+		client *rpc.Client
+		err    error
+	)
+	if strings.HasPrefix(p.endpoint, "http://") || strings.HasPrefix(p.endpoint, "https://") {
+		client, err = rpc.DialHTTP(p.endpoint)
+	} else {
+		client, err = rpc.DialIPC(ctx, p.endpoint)
+	}
+	if err != nil {
+		return nil, err
+	}
+	p.client = client
+	return client, nil
+}
+
+// Call forwards a call of the precompile to the plugin and returns its output.
+// The call is bounded by the timeout of the plugin. The output is checked
+// against the outputs of the function in the ABI.
+func (p *Plugin) Call(ctx context.Context, req *Request) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout)
+	defer cancel()
+
+	client, err := p.dial(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to plugin %s: %w", p.Name, err)
+	}
+
+	var out hexutil.Bytes
+	if err := client.CallContext(ctx, &out, runMethod, req); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, fmt.Errorf("plugin %s timed out after %s", p.Name, p.Timeout)
+		}
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) {
+			if data, ok := dataErr.ErrorData().(string); ok {
+				if raw, decodeErr := hexutil.Decode(data); decodeErr == nil {
+					return nil, &RevertError{Reason: err.Error(), Data: raw}
+				}
+			}
+		}
+		return nil, fmt.Errorf("plugin %s failed: %w", p.Name, err)
+	}
+
+	if _, err := p.Method.Outputs.Unpack(out); err != nil {
+		return nil, fmt.Errorf("invalid output of plugin %s: %w", p.Name, err)
+	}
+	return out, nil
+}
+
+// Close closes the connection to the plugin.
+func (p *Plugin) Close() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.client != nil {
+		p.client.Close()
+		p.client = nil
+	}
+}
+
+// Registry maps the addresses of the precompiles to their plugins.
+type Registry struct {
+	lock    sync.RWMutex
+	plugins map[common.Address]*Plugin
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{plugins: make(map[common.Address]*Plugin)}
+}
+
+// Register adds a plugin to the registry. The address of the plugin cannot
+// be the one of a builtin precompile nor of another plugin.
+func (r *Registry) Register(plugin *Plugin) error {
+	if plugin.Address == isConfidentialAddr || artifacts.PrecompileAddressToName(plugin.Address) != "" {
+		return fmt.Errorf("plugin %s: address %s is a builtin precompile", plugin.Name, plugin.Address)
+	}
+	if plugin.Address.Big().Cmp(common.Big256) < 0 {
+		return fmt.Errorf("plugin %s: address %s is reserved for the ethereum precompiles", plugin.Name, plugin.Address)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if other, ok := r.plugins[plugin.Address]; ok {
+		return fmt.Errorf("plugin %s: address %s is used by plugin %s", plugin.Name, plugin.Address, other.Name)
+	}
+	r.plugins[plugin.Address] = plugin
+	return nil
+}
+
+// Get returns the plugin at an address, or nil if there is none.
+func (r *Registry) Get(addr common.Address) *Plugin {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.plugins[addr]
+}
+
+// Plugins returns the registered plugins.
+func (r *Registry) Plugins() []*Plugin {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	plugins := make([]*Plugin, 0, len(r.plugins))
+	for _, plugin := range r.plugins {
+		plugins = append(plugins, plugin)
+	}
+	return plugins
+}
+
+// Start implements node.Lifecycle, the plugins are dialed on their first call.
+func (r *Registry) Start() error {
+	return nil
+}
+
+// Stop implements node.Lifecycle and closes the connections to the plugins.
+func (r *Registry) Stop() error {
+	for _, plugin := range r.Plugins() {
+		plugin.Close()
+	}
+	return nil
+}
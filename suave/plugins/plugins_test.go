@@ -0,0 +1,155 @@
+package plugins
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var echoABI = `[{"type":"function","name":"echo","inputs":[{"name":"id","type":"bytes16"},{"name":"data","type":"bytes"}],"outputs":[{"name":"","type":"bytes"}]}]`
+
+func newEchoSpec(endpoint string) *Spec {
+	return &Spec{
+		Name:     "echo",
+		Address:  common.HexToAddress("0x90000001"),
+		Endpoint: endpoint,
+		ABI:      json.RawMessage(echoABI),
+	}
+}
+
+func TestLoadSpec(t *testing.T) {
+	plugin, err := LoadSpec([]byte(`{
+		"name": "echo",
+		"address": "0x0000000000000000000000000000000090000001",
+		"endpoint": "/tmp/echo.ipc",
+		"timeout": "250ms",
+		"abi": ` + echoABI + `,
+		"records": ["id"],
+		"keys": ["key"]
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, "echo", plugin.Name)
+	require.Equal(t, 250*time.Millisecond, plugin.Timeout)
+	require.Equal(t, uint64(DefaultGas), plugin.Gas)
+	require.Equal(t, []int{0}, plugin.Records)
+	require.Equal(t, []string{"key"}, plugin.Keys)
+
+	cases := []func(spec *Spec){
+		func(spec *Spec) { spec.Name = "other" },
+		func(spec *Spec) { spec.Address = common.Address{} },
+		func(spec *Spec) { spec.Endpoint = "" },
+		func(spec *Spec) { spec.Timeout = "-1s" },
+		func(spec *Spec) { spec.ABI = nil },
+		func(spec *Spec) { spec.Records = []string{"data"} },
+		func(spec *Spec) { spec.Records = []string{"missing"} },
+		func(spec *Spec) { spec.Keys = []string{"key"} },
+	}
+	for i, mutate := range cases {
+		spec := newEchoSpec("/tmp/echo.ipc")
+		mutate(spec)
+		_, err := New(spec)
+		require.Error(t, err, fmt.Sprintf("case %d", i))
+	}
+
+	_, err = LoadSpec([]byte(`{"name": "echo", "unknown": true}`))
+	require.Error(t, err)
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+
+	plugin, err := New(newEchoSpec("/tmp/echo.ipc"))
+	require.NoError(t, err)
+	require.NoError(t, r.Register(plugin))
+	require.Equal(t, plugin, r.Get(plugin.Address))
+	require.Nil(t, r.Get(common.HexToAddress("0x90000002")))
+
+	// the address is used by another plugin
+	require.Error(t, r.Register(plugin))
+
+	for _, addr := range []string{"0x42010000", "0x42100000", "0x01"} {
+		spec := newEchoSpec("/tmp/echo.ipc")
+		spec.Address = common.HexToAddress(addr)
+		other, err := New(spec)
+		require.NoError(t, err)
+		require.Error(t, r.Register(other), addr)
+	}
+}
+
+func TestPlugin_Call(t *testing.T) {
+	endpoint := filepath.Join(t.TempDir(), "echo.ipc")
+
+	server, err := Serve(endpoint, HandlerFunc(func(ctx context.Context, req *Request) ([]byte, error) {
+		args, err := abi.Arguments{{Type: mustType("bytes16")}, {Type: mustType("bytes")}}.Unpack(req.Input[4:])
+		if err != nil {
+			return nil, err
+		}
+		data := args[1].([]byte)
+		switch string(data) {
+		case "revert":
+			return nil, &RevertError{Reason: "reverted", Data: []byte{0x01, 0x02}}
+		case "fail":
+			return nil, fmt.Errorf("failed")
+		case "invalid":
+			return []byte{0x01}, nil
+		case "slow":
+			time.Sleep(200 * time.Millisecond)
+		}
+		return abi.Arguments{{Type: mustType("bytes")}}.Pack(append([]byte(req.Caller.Hex()[:4]), data...))
+	}))
+	require.NoError(t, err)
+	defer server.Close()
+
+	spec := newEchoSpec(endpoint)
+	spec.Timeout = "100ms"
+	plugin, err := New(spec)
+	require.NoError(t, err)
+	defer plugin.Close()
+
+	call := func(data string) ([]byte, error) {
+		input, err := plugin.Method.Inputs.Pack([16]byte{}, []byte(data))
+		require.NoError(t, err)
+		return plugin.Call(context.Background(), &Request{
+			Address: plugin.Address,
+			Input:   append(plugin.Method.ID, input...),
+			Caller:  common.HexToAddress("0xabcd"),
+		})
+	}
+
+	out, err := call("hello")
+	require.NoError(t, err)
+	res, err := plugin.Method.Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Equal(t, []byte("0x00hello"), res[0])
+
+	_, err = call("revert")
+	var revertErr *RevertError
+	require.ErrorAs(t, err, &revertErr)
+	require.Equal(t, []byte{0x01, 0x02}, revertErr.Data)
+
+	_, err = call("fail")
+	require.ErrorContains(t, err, "failed")
+	require.False(t, errors.As(err, &revertErr))
+
+	_, err = call("invalid")
+	require.ErrorContains(t, err, "invalid output")
+
+	_, err = call("slow")
+	require.ErrorContains(t, err, "timed out")
+}
+
+func mustType(typ string) abi.Type {
+	t, err := abi.NewType(typ, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
@@ -0,0 +1,56 @@
+package plugins
+
+import (
+	"context"
+	"net"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Handler runs the calls of a precompile in a plugin. It returns the ABI
+// encoded output of the precompile, or a RevertError to revert with data.
+type Handler interface {
+	Run(ctx context.Context, req *Request) ([]byte, error)
+}
+
+// HandlerFunc adapts a function to a Handler.
+type HandlerFunc func(ctx context.Context, req *Request) ([]byte, error)
+
+// Run calls f(ctx, req).
+func (f HandlerFunc) Run(ctx context.Context, req *Request) ([]byte, error) {
+	return f(ctx, req)
+}
+
+// Server serves a handler on a unix socket.
+type Server struct {
+	listener net.Listener
+	server   *rpc.Server
+}
+
+// Serve serves the handler of a plugin on the unix socket at endpoint.
+func Serve(endpoint string, handler Handler) (*Server, error) {
+	listener, server, err := rpc.StartIPCEndpoint(endpoint, []rpc.API{{
+		Namespace: "precompile",
+		Service:   &service{handler: handler},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return &Server{listener: listener, server: server}, nil
+}
+
+// Close stops the server.
+func (s *Server) Close() {
+	s.listener.Close()
+	s.server.Stop()
+}
+
+type service struct {
+	handler Handler
+}
+
+// Run implements the precompile_run method.
+func (s *service) Run(ctx context.Context, req *Request) (hexutil.Bytes, error) {
+	return s.handler.Run(ctx, req)
+}
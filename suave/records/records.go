@@ -0,0 +1,268 @@
+// Package records resolves the bundles stored in the confidential store by the
+// app protocols, so that blocks can be built from the records of any protocol.
+package records
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Encoding is the format of the bundles stored in the confidential store.
+type Encoding string
+
+const (
+	// EncodingJSON is a types.SBundle encoded as JSON.
+	EncodingJSON Encoding = "json"
+	// EncodingABI is the ABI encoding of the tuple
+	// (uint64 blockNumber, uint64 maxBlock, bytes[] txs, bytes32[] revertingHashes).
+	// A zero block number or max block is left unset.
+	EncodingABI Encoding = "abi"
+	// EncodingMevShare is a types.MevShareBundle encoded as JSON. Its nested
+	// bundles are flattened into a single bundle.
+	EncodingMevShare Encoding = "mevshare"
+)
+
+var (
+	bundleAbi = abi.Arguments{{Type: mustNewType("tuple", []abi.ArgumentMarshaling{
+		{Name: "blockNumber", Type: "uint64"},
+		{Name: "maxBlock", Type: "uint64"},
+		{Name: "txs", Type: "bytes[]"},
+		{Name: "revertingHashes", Type: "bytes32[]"},
+	})}}
+
+	dataIDsAbi = abi.Arguments{{Type: mustNewType("bytes16[]", nil)}}
+)
+
+// Type describes where a record type keeps its bundle and how it is encoded.
+type Type struct {
+	// Version is the version of the records, as given to newDataRecord.
+	Version string
+	// Key is the confidential store key of the bundle.
+	Key string
+	// Encoding of the bundle stored under the key.
+	Encoding Encoding
+	// IDsKey, if set, is the key of the ABI encoded ids (bytes16[]) stored in
+	// the record. The bundles of these records are merged into a single bundle,
+	// in order, instead of reading the bundle of the record itself.
+	IDsKey string
+}
+
+// Retriever reads the confidential store.
+type Retriever interface {
+	Retrieve(id types.DataId, key string) ([]byte, error)
+}
+
+// Registry maps record versions to their types.
+type Registry struct {
+	lock  sync.RWMutex
+	types map[string]*Type
+}
+
+// NewRegistry returns a registry with the given types.
+func NewRegistry(types ...*Type) *Registry {
+	r := &Registry{types: make(map[string]*Type)}
+	for _, typ := range types {
+		r.Register(typ)
+	}
+	return r
+}
+
+// DefaultRegistry returns a registry with the record types of the default and
+// mev-share protocols.
+func DefaultRegistry() *Registry {
+	return NewRegistry(
+		&Type{Version: "default:v0:ethBundles", Key: "default:v0:ethBundles", Encoding: EncodingJSON},
+		&Type{Version: "mevshare:v0:unmatchedBundles", Key: "mevshare:v0:ethBundles", Encoding: EncodingJSON},
+		&Type{Version: "mevshare:v0:matchDataRecords", Key: "mevshare:v0:ethBundles", Encoding: EncodingJSON, IDsKey: "mevshare:v0:mergedDataRecords"},
+	)
+}
+
+// Register adds the type to the registry, replacing any type with the same version.
+func (r *Registry) Register(typ *Type) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.types[typ.Version] = typ
+}
+
+// Type returns the type of the records with the given version.
+func (r *Registry) Type(version string) (*Type, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	typ, ok := r.types[version]
+	if !ok {
+		return nil, fmt.Errorf("unknown record version %s", version)
+	}
+	return typ, nil
+}
+
+// Bundle returns the bundle of the record.
+func (r *Registry) Bundle(store Retriever, record *types.DataRecord) (*types.SBundle, error) {
+	typ, err := r.Type(record.Version)
+	if err != nil {
+		return nil, err
+	}
+	if typ.IDsKey == "" {
+		return typ.bundle(store, record.Id)
+	}
+
+	idsBytes, err := store.Retrieve(record.Id, typ.IDsKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve record ids data for record %x: %w", record.Id, err)
+	}
+	unpacked, err := dataIDsAbi.Unpack(idsBytes)
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack record ids data for record %x: %w", record.Id, err)
+	}
+	ids := unpacked[0].([][16]byte)
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("record %x has no records to merge", record.Id)
+	}
+
+	var merged *types.SBundle
+	for _, id := range ids {
+		bundle, err := typ.bundle(store, id)
+		if err != nil {
+			return nil, err
+		}
+		if merged == nil {
+			merged = bundle
+		} else {
+			merged.Txs = append(merged.Txs, bundle.Txs...)
+		}
+	}
+	return merged, nil
+}
+
+func (t *Type) bundle(store Retriever, id types.DataId) (*types.SBundle, error) {
+	data, err := store.Retrieve(id, t.Key)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve bundle data for dataID %x: %w", id, err)
+	}
+	bundle, err := Decode(t.Encoding, data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode bundle data for dataID %x: %w", id, err)
+	}
+	return bundle, nil
+}
+
+// Decode decodes a bundle with the given encoding.
+func Decode(encoding Encoding, data []byte) (*types.SBundle, error) {
+	switch encoding {
+	case EncodingJSON:
+		var bundle types.SBundle
+		if err := json.Unmarshal(data, &bundle); err != nil {
+			return nil, err
+		}
+		return &bundle, nil
+	case EncodingABI:
+		return decodeABI(data)
+	case EncodingMevShare:
+		var bundle types.MevShareBundle
+		if err := json.Unmarshal(data, &bundle); err != nil {
+			return nil, err
+		}
+		return bundle.ToSBundle()
+	default:
+		return nil, fmt.Errorf("unknown bundle encoding '%s'", encoding)
+	}
+}
+
+type abiBundle struct {
+	BlockNumber     uint64
+	MaxBlock        uint64
+	Txs             [][]byte
+	RevertingHashes [][32]byte
+}
+
+func decodeABI(data []byte) (*types.SBundle, error) {
+	unpacked, err := bundleAbi.Unpack(data)
+	if err != nil {
+		return nil, err
+	}
+	encoded := abi.ConvertType(unpacked[0], new(abiBundle)).(*abiBundle)
+
+	bundle := &types.SBundle{}
+	if encoded.BlockNumber != 0 {
+		bundle.BlockNumber = new(big.Int).SetUint64(encoded.BlockNumber)
+	}
+	if encoded.MaxBlock != 0 {
+		bundle.MaxBlock = new(big.Int).SetUint64(encoded.MaxBlock)
+	}
+	for i, txBytes := range encoded.Txs {
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(txBytes); err != nil {
+			return nil, fmt.Errorf("could not decode tx %d: %w", i, err)
+		}
+		bundle.Txs = append(bundle.Txs, &tx)
+	}
+	for _, hash := range encoded.RevertingHashes {
+		bundle.RevertingHashes = append(bundle.RevertingHashes, common.Hash(hash))
+	}
+	return bundle, nil
+}
+
+// EncodeABI encodes the bundle with EncodingABI. The refunds are not encoded.
+func EncodeABI(bundle *types.SBundle) ([]byte, error) {
+	encoded := abiBundle{
+		Txs:             [][]byte{},
+		RevertingHashes: [][32]byte{},
+	}
+	if bundle.BlockNumber != nil {
+		encoded.BlockNumber = bundle.BlockNumber.Uint64()
+	}
+	if bundle.MaxBlock != nil {
+		encoded.MaxBlock = bundle.MaxBlock.Uint64()
+	}
+	for _, tx := range bundle.Txs {
+		txBytes, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		encoded.Txs = append(encoded.Txs, txBytes)
+	}
+	for _, hash := range bundle.RevertingHashes {
+		encoded.RevertingHashes = append(encoded.RevertingHashes, hash)
+	}
+	return bundleAbi.Pack(encoded)
+}
+
+// ParseType parses a record type from its command line form
+// <version>=<encoding>:<key>[,<ids key>], for example
+// "myapp:v0:bundles=abi:myapp:v0:bundle".
+func ParseType(spec string) (*Type, error) {
+	version, rest, ok := strings.Cut(spec, "=")
+	if !ok || version == "" {
+		return nil, fmt.Errorf("invalid record type '%s', expected <version>=<encoding>:<key>[,<ids key>]", spec)
+	}
+	encoding, keys, ok := strings.Cut(rest, ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid record type '%s', expected <version>=<encoding>:<key>[,<ids key>]", spec)
+	}
+	key, idsKey, _ := strings.Cut(keys, ",")
+	if key == "" {
+		return nil, fmt.Errorf("invalid record type '%s', empty key", spec)
+	}
+
+	typ := &Type{Version: version, Key: key, Encoding: Encoding(encoding), IDsKey: idsKey}
+	switch typ.Encoding {
+	case EncodingJSON, EncodingABI, EncodingMevShare:
+	default:
+		return nil, fmt.Errorf("invalid record type '%s', unknown encoding '%s'", spec, encoding)
+	}
+	return typ, nil
+}
+
+func mustNewType(typ string, components []abi.ArgumentMarshaling) abi.Type {
+	t, err := abi.NewType(typ, "", components)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
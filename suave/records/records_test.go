@@ -0,0 +1,152 @@
+package records
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStore map[types.DataId]map[string][]byte
+
+func (m mockStore) Retrieve(id types.DataId, key string) ([]byte, error) {
+	val, ok := m[id][key]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", key)
+	}
+	return val, nil
+}
+
+func (m mockStore) store(id types.DataId, key string, val []byte) {
+	if m[id] == nil {
+		m[id] = map[string][]byte{}
+	}
+	m[id][key] = val
+}
+
+func newTestBundle(nonce uint64) *types.SBundle {
+	tx := types.NewTransaction(nonce, common.Address{0x1}, big.NewInt(1), 21000, big.NewInt(1), nil)
+	return &types.SBundle{
+		BlockNumber:     big.NewInt(10),
+		Txs:             types.Transactions{tx},
+		RevertingHashes: []common.Hash{tx.Hash()},
+	}
+}
+
+func TestDecodeABI(t *testing.T) {
+	bundle := newTestBundle(1)
+
+	data, err := EncodeABI(bundle)
+	require.NoError(t, err)
+
+	decoded, err := Decode(EncodingABI, data)
+	require.NoError(t, err)
+	require.Equal(t, bundle.BlockNumber, decoded.BlockNumber)
+	require.Nil(t, decoded.MaxBlock)
+	require.Len(t, decoded.Txs, 1)
+	require.Equal(t, bundle.Txs[0].Hash(), decoded.Txs[0].Hash())
+	require.Equal(t, bundle.RevertingHashes, decoded.RevertingHashes)
+
+	_, err = Decode(EncodingABI, []byte{0x1})
+	require.Error(t, err)
+}
+
+func TestRegistryBundle(t *testing.T) {
+	store := mockStore{}
+
+	userBundle, matchBundle := newTestBundle(1), newTestBundle(2)
+	userBundleBytes, err := json.Marshal(userBundle)
+	require.NoError(t, err)
+	matchBundleBytes, err := json.Marshal(matchBundle)
+	require.NoError(t, err)
+
+	store.store(types.DataId{0x1}, "mevshare:v0:ethBundles", userBundleBytes)
+	store.store(types.DataId{0x2}, "mevshare:v0:ethBundles", matchBundleBytes)
+
+	ids, err := dataIDsAbi.Pack([][16]byte{{0x1}, {0x2}})
+	require.NoError(t, err)
+	store.store(types.DataId{0x3}, "mevshare:v0:mergedDataRecords", ids)
+
+	abiBundleBytes, err := EncodeABI(newTestBundle(3))
+	require.NoError(t, err)
+	store.store(types.DataId{0x4}, "myapp:v0:bundle", abiBundleBytes)
+
+	nestedBundle, err := types.NewMevShareBundle(newTestBundle(4))
+	require.NoError(t, err)
+	shareBundle := &types.MevShareBundle{
+		Version:   types.MevShareBundleVersion,
+		Inclusion: types.MevShareInclusion{Block: 10},
+		Body: []types.MevShareBody{
+			{Bundle: nestedBundle},
+			{Tx: newTestBundle(5).Txs[0]},
+		},
+	}
+	shareBundleBytes, err := json.Marshal(shareBundle)
+	require.NoError(t, err)
+	store.store(types.DataId{0x5}, "mevshare:v1:bundle", shareBundleBytes)
+
+	registry := DefaultRegistry()
+
+	// records of unknown versions are rejected
+	_, err = registry.Bundle(store, &types.DataRecord{Id: types.DataId{0x4}, Version: "myapp:v0:bundles"})
+	require.Error(t, err)
+
+	typ, err := ParseType("myapp:v0:bundles=abi:myapp:v0:bundle")
+	require.NoError(t, err)
+	registry.Register(typ)
+
+	bundle, err := registry.Bundle(store, &types.DataRecord{Id: types.DataId{0x4}, Version: "myapp:v0:bundles"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), bundle.Txs[0].Nonce())
+
+	// nested mev-share bundles are flattened
+	typ, err = ParseType("mevshare:v1:bundles=mevshare:mevshare:v1:bundle")
+	require.NoError(t, err)
+	registry.Register(typ)
+
+	bundle, err = registry.Bundle(store, &types.DataRecord{Id: types.DataId{0x5}, Version: "mevshare:v1:bundles"})
+	require.NoError(t, err)
+	require.Len(t, bundle.Txs, 2)
+	require.Equal(t, uint64(4), bundle.Txs[0].Nonce())
+	require.Equal(t, uint64(5), bundle.Txs[1].Nonce())
+
+	bundle, err = registry.Bundle(store, &types.DataRecord{Id: types.DataId{0x1}, Version: "mevshare:v0:unmatchedBundles"})
+	require.NoError(t, err)
+	require.Len(t, bundle.Txs, 1)
+
+	// the bundles of the matched records are merged
+	bundle, err = registry.Bundle(store, &types.DataRecord{Id: types.DataId{0x3}, Version: "mevshare:v0:matchDataRecords"})
+	require.NoError(t, err)
+	require.Len(t, bundle.Txs, 2)
+	require.Equal(t, userBundle.Txs[0].Hash(), bundle.Txs[0].Hash())
+	require.Equal(t, matchBundle.Txs[0].Hash(), bundle.Txs[1].Hash())
+}
+
+func TestParseType(t *testing.T) {
+	cases := []struct {
+		spec string
+		typ  *Type
+	}{
+		{"a:v0:b=json:a:v0:bundle", &Type{Version: "a:v0:b", Key: "a:v0:bundle", Encoding: EncodingJSON}},
+		{"a:v0:b=abi:a:v0:bundle,a:v0:ids", &Type{Version: "a:v0:b", Key: "a:v0:bundle", Encoding: EncodingABI, IDsKey: "a:v0:ids"}},
+		{"a:v0:b", nil},
+		{"=json:key", nil},
+		{"a=json", nil},
+		{"a=json:", nil},
+		{"a=rlp:key", nil},
+	}
+
+	for _, c := range cases {
+		typ, err := ParseType(c.spec)
+		if c.typ == nil {
+			require.Error(t, err, c.spec)
+		} else {
+			require.NoError(t, err, c.spec)
+			require.Equal(t, c.typ, typ)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package relay
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/suave/internal/apiclient"
+)
+
+const (
+	validatorsPath                = "/relay/v1/builder/validators"
+	builderBlocksReceivedPath     = "/relay/v1/data/bidtraces/builder_blocks_received"
+	proposerPayloadsDeliveredPath = "/relay/v1/data/bidtraces/proposer_payload_delivered"
+)
+
+// ValidatorDuty is a validator registered in the relay which proposes in an upcoming slot.
+type ValidatorDuty struct {
+	Slot           uint64
+	ValidatorIndex uint64
+	Pubkey         []byte
+	FeeRecipient   common.Address
+	GasLimit       uint64
+	Timestamp      uint64
+}
+
+// BidTrace is a bid received or delivered by the relay.
+type BidTrace struct {
+	Slot                 uint64
+	ParentHash           common.Hash
+	BlockHash            common.Hash
+	BuilderPubkey        []byte
+	ProposerPubkey       []byte
+	ProposerFeeRecipient common.Address
+	GasLimit             uint64
+	GasUsed              uint64
+	Value                *big.Int
+	BlockNumber          uint64
+	NumTx                uint64
+	TimestampMs          uint64
+}
+
+// Client reads the data API of a mev-boost relay.
+type Client struct {
+	api *apiclient.Client
+}
+
+// NewClient returns a client of the relay at the given url, using the default client if nil.
+func NewClient(client *http.Client, url string) *Client {
+	return &Client{api: apiclient.New(client, url, "relay")}
+}
+
+// Validators returns the proposer duties of the current and next epoch.
+func (c *Client) Validators(ctx context.Context) ([]*ValidatorDuty, error) {
+	var entries []struct {
+		Slot           apiclient.Uint64 `json:"slot"`
+		ValidatorIndex apiclient.Uint64 `json:"validator_index"`
+		Entry          struct {
+			Message struct {
+				FeeRecipient common.Address   `json:"fee_recipient"`
+				GasLimit     apiclient.Uint64 `json:"gas_limit"`
+				Timestamp    apiclient.Uint64 `json:"timestamp"`
+				Pubkey       hexutil.Bytes    `json:"pubkey"`
+			} `json:"message"`
+		} `json:"entry"`
+	}
+	if err := c.api.Get(ctx, validatorsPath, nil, &entries); err != nil {
+		return nil, err
+	}
+
+	duties := make([]*ValidatorDuty, len(entries))
+	for i, entry := range entries {
+		duties[i] = &ValidatorDuty{
+			Slot:           uint64(entry.Slot),
+			ValidatorIndex: uint64(entry.ValidatorIndex),
+			Pubkey:         entry.Entry.Message.Pubkey,
+			FeeRecipient:   entry.Entry.Message.FeeRecipient,
+			GasLimit:       uint64(entry.Entry.Message.GasLimit),
+			Timestamp:      uint64(entry.Entry.Message.Timestamp),
+		}
+	}
+	return duties, nil
+}
+
+// BuilderBlocksReceived returns the bids received for the slot, sorted by
+// descending value. The first one is the top bid.
+func (c *Client) BuilderBlocksReceived(ctx context.Context, slot uint64) ([]*BidTrace, error) {
+	traces, err := c.bidTraces(ctx, builderBlocksReceivedPath, slot)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(traces, func(i, j int) bool {
+		return traces[i].Value.Cmp(traces[j].Value) > 0
+	})
+	return traces, nil
+}
+
+// ProposerPayloadsDelivered returns the payloads delivered to the proposer of the slot.
+func (c *Client) ProposerPayloadsDelivered(ctx context.Context, slot uint64) ([]*BidTrace, error) {
+	return c.bidTraces(ctx, proposerPayloadsDeliveredPath, slot)
+}
+
+func (c *Client) bidTraces(ctx context.Context, path string, slot uint64) ([]*BidTrace, error) {
+	var entries []struct {
+		Slot                 apiclient.Uint64 `json:"slot"`
+		ParentHash           common.Hash      `json:"parent_hash"`
+		BlockHash            common.Hash      `json:"block_hash"`
+		BuilderPubkey        hexutil.Bytes    `json:"builder_pubkey"`
+		ProposerPubkey       hexutil.Bytes    `json:"proposer_pubkey"`
+		ProposerFeeRecipient common.Address   `json:"proposer_fee_recipient"`
+		GasLimit             apiclient.Uint64 `json:"gas_limit"`
+		GasUsed              apiclient.Uint64 `json:"gas_used"`
+		Value                string           `json:"value"`
+		BlockNumber          apiclient.Uint64 `json:"block_number"`
+		NumTx                apiclient.Uint64 `json:"num_tx"`
+		TimestampMs          apiclient.Uint64 `json:"timestamp_ms"`
+	}
+	query := url.Values{"slot": []string{strconv.FormatUint(slot, 10)}}
+	if err := c.api.Get(ctx, path, query, &entries); err != nil {
+		return nil, err
+	}
+
+	traces := make([]*BidTrace, len(entries))
+	for i, entry := range entries {
+		value, ok := new(big.Int).SetString(entry.Value, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid bid value '%s'", entry.Value)
+		}
+		traces[i] = &BidTrace{
+			Slot:                 uint64(entry.Slot),
+			ParentHash:           entry.ParentHash,
+			BlockHash:            entry.BlockHash,
+			BuilderPubkey:        entry.BuilderPubkey,
+			ProposerPubkey:       entry.ProposerPubkey,
+			ProposerFeeRecipient: entry.ProposerFeeRecipient,
+			GasLimit:             uint64(entry.GasLimit),
+			GasUsed:              uint64(entry.GasUsed),
+			Value:                value,
+			BlockNumber:          uint64(entry.BlockNumber),
+			NumTx:                uint64(entry.NumTx),
+			TimestampMs:          uint64(entry.TimestampMs),
+		}
+	}
+	return traces, nil
+}
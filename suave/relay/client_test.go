@@ -0,0 +1,109 @@
+package relay
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testValidatorsResponse = `[{
+		"slot": "7595842",
+		"validator_index": "104516",
+		"entry": {
+			"message": {
+				"fee_recipient": "0x388c818ca8b9251b393131c08a736a67ccb19297",
+				"gas_limit": "30000000",
+				"timestamp": "1697458323",
+				"pubkey": "0xa1b2"
+			},
+			"signature": "0x00"
+		}
+	}]`
+
+	testBidTracesResponse = `[{
+		"slot": "7595842",
+		"parent_hash": "0x0000000000000000000000000000000000000000000000000000000000000001",
+		"block_hash": "0x0000000000000000000000000000000000000000000000000000000000000002",
+		"builder_pubkey": "0x01",
+		"proposer_pubkey": "0xa1b2",
+		"proposer_fee_recipient": "0x388c818ca8b9251b393131c08a736a67ccb19297",
+		"gas_limit": "30000000",
+		"gas_used": "12000000",
+		"value": "1000",
+		"block_number": "18363000",
+		"num_tx": "150",
+		"timestamp_ms": "1697458331123"
+	}, {
+		"slot": "7595842",
+		"value": "123456789012345678901234567890",
+		"gas_limit": "30000000"
+	}]`
+)
+
+func TestClient(t *testing.T) {
+	var slots []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case validatorsPath:
+			w.Write([]byte(testValidatorsResponse))
+		case builderBlocksReceivedPath, proposerPayloadsDeliveredPath:
+			slots = append(slots, r.URL.Query().Get("slot"))
+			w.Write([]byte(testBidTracesResponse))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	clt := NewClient(nil, srv.URL+"/")
+	feeRecipient := common.HexToAddress("0x388c818ca8b9251b393131c08a736a67ccb19297")
+
+	duties, err := clt.Validators(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []*ValidatorDuty{{
+		Slot:           7595842,
+		ValidatorIndex: 104516,
+		Pubkey:         []byte{0xa1, 0xb2},
+		FeeRecipient:   feeRecipient,
+		GasLimit:       30000000,
+		Timestamp:      1697458323,
+	}}, duties)
+
+	// received bids are sorted by value
+	bids, err := clt.BuilderBlocksReceived(context.Background(), 7595842)
+	require.NoError(t, err)
+	require.Len(t, bids, 2)
+
+	topValue, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.Equal(t, topValue, bids[0].Value)
+	require.Equal(t, uint64(0), bids[0].NumTx)
+	require.Equal(t, &BidTrace{
+		Slot:                 7595842,
+		ParentHash:           common.Hash{31: 0x1},
+		BlockHash:            common.Hash{31: 0x2},
+		BuilderPubkey:        []byte{0x1},
+		ProposerPubkey:       []byte{0xa1, 0xb2},
+		ProposerFeeRecipient: feeRecipient,
+		GasLimit:             30000000,
+		GasUsed:              12000000,
+		Value:                big.NewInt(1000),
+		BlockNumber:          18363000,
+		NumTx:                150,
+		TimestampMs:          1697458331123,
+	}, bids[1])
+
+	payloads, err := clt.ProposerPayloadsDelivered(context.Background(), 7595843)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), payloads[0].Value)
+
+	require.Equal(t, []string{"7595842", "7595843"}, slots)
+
+	_, err = NewClient(nil, srv.URL+"/unknown").Validators(context.Background())
+	require.Error(t, err)
+}
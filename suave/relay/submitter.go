@@ -0,0 +1,217 @@
+package relay
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	builderDeneb "github.com/attestantio/go-builder-client/api/deneb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const submitBlockPath = "/relay/v1/builder/blocks"
+
+// MaxRetries is the maximum number of retries of a submission.
+const MaxRetries = 5
+
+var (
+	// DefaultDeadline is the time given to the submissions without a deadline, one
+	// slot. It is also the latest deadline a submission can have.
+	DefaultDeadline = 12 * time.Second
+
+	// retryBackoff is the wait before the first retry, it doubles with every attempt.
+	retryBackoff = 100 * time.Millisecond
+)
+
+// Options configure the submission of a bid.
+type Options struct {
+	// SSZ encodes the bid with SSZ instead of JSON.
+	SSZ bool
+	// Gzip compresses the request body.
+	Gzip bool
+	// MaxRetries is the number of times a transient failure is retried, at most
+	// the package MaxRetries.
+	MaxRetries int
+	// Deadline after which no more attempts are made, at most DefaultDeadline
+	// from now.
+	Deadline time.Time
+}
+
+// Result is the outcome of the submission to a relay.
+type Result struct {
+	Relay      string
+	Success    bool
+	StatusCode int
+	Attempts   int
+	Error      string
+}
+
+// Submitter submits signed bids to mev-boost relays.
+type Submitter struct {
+	client *http.Client
+}
+
+// NewSubmitter returns a submitter using the given client, or the default client if nil.
+func NewSubmitter(client *http.Client) *Submitter {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Submitter{client: client}
+}
+
+// Submit sends the bid to all the relays concurrently and returns the result of
+// each submission in the order of the relays. Requests failing with a network
+// error, a 429 or a 5xx status are retried with an exponential backoff until
+// the retries or the deadline are exhausted. The retries and the deadline are
+// capped to MaxRetries and one slot from now.
+func (s *Submitter) Submit(ctx context.Context, relays []string, bid *builderDeneb.SubmitBlockRequest, opts Options) ([]*Result, error) {
+	body, header, err := encodeBid(bid, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(DefaultDeadline)
+	if !opts.Deadline.IsZero() && opts.Deadline.Before(deadline) {
+		deadline = opts.Deadline
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	maxRetries := opts.MaxRetries
+	if maxRetries > MaxRetries {
+		maxRetries = MaxRetries
+	}
+
+	results := make([]*Result, len(relays))
+	var wg sync.WaitGroup
+	for i, relay := range relays {
+		wg.Add(1)
+		go func(i int, relay string) {
+			defer wg.Done()
+			results[i] = s.submit(ctx, relay, body, header, maxRetries)
+		}(i, relay)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+func (s *Submitter) submit(ctx context.Context, relay string, body []byte, header http.Header, maxRetries int) *Result {
+	var (
+		result = &Result{Relay: relay}
+		name   = metricsName(relay)
+		start  = time.Now()
+	)
+	defer func() {
+		if !metrics.Enabled {
+			return
+		}
+		metrics.GetOrRegisterTimer(name+"/duration", nil).UpdateSince(start)
+		if result.Success {
+			metrics.GetOrRegisterMeter(name+"/success", nil).Mark(1)
+		} else {
+			metrics.GetOrRegisterMeter(name+"/failure", nil).Mark(1)
+		}
+	}()
+
+	backoff := retryBackoff
+	for {
+		result.Attempts++
+
+		status, retry, err := s.post(ctx, relay, body, header)
+		result.StatusCode = status
+		if err == nil {
+			result.Success = true
+			result.Error = ""
+			return result
+		}
+		result.Error = err.Error()
+
+		if !retry || result.Attempts > maxRetries {
+			return result
+		}
+		log.Debug("Retrying relay submission", "relay", relay, "attempt", result.Attempts, "err", err)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return result
+		}
+		backoff *= 2
+	}
+}
+
+// post sends the request and reports whether a failure is transient.
+func (s *Submitter) post(ctx context.Context, relay string, body []byte, header http.Header) (int, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(relay, "/")+submitBlockPath, bytes.NewReader(body))
+	if err != nil {
+		return 0, false, err
+	}
+	req.Header = header.Clone()
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		// the deadline is over, do not retry
+		return 0, ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return resp.StatusCode, false, nil
+	}
+
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return resp.StatusCode, retry, fmt.Errorf("http error: %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+}
+
+func encodeBid(bid *builderDeneb.SubmitBlockRequest, opts Options) ([]byte, http.Header, error) {
+	header := http.Header{}
+	header.Set("Accept", "application/json")
+
+	var (
+		body []byte
+		err  error
+	)
+	if opts.SSZ {
+		body, err = bid.MarshalSSZ()
+		header.Set("Content-Type", "application/octet-stream")
+	} else {
+		body, err = bid.MarshalJSON()
+		header.Set("Content-Type", "application/json")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not encode bid: %w", err)
+	}
+
+	if opts.Gzip {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(body); err != nil {
+			return nil, nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, nil, err
+		}
+		body = buf.Bytes()
+		header.Set("Content-Encoding", "gzip")
+	}
+	return body, header, nil
+}
+
+// metricsName returns the name of the metrics of the relay, based on its host.
+func metricsName(relay string) string {
+	host := relay
+	if u, err := url.Parse(relay); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	return "suave/relay/" + strings.NewReplacer(".", "_", ":", "_").Replace(host)
+}
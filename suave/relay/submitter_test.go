@@ -0,0 +1,142 @@
+package relay
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	builderDeneb "github.com/attestantio/go-builder-client/api/deneb"
+	builderV1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBid() *builderDeneb.SubmitBlockRequest {
+	return &builderDeneb.SubmitBlockRequest{
+		Message: &builderV1.BidTrace{Slot: 1, Value: uint256.NewInt(1)},
+		ExecutionPayload: &deneb.ExecutionPayload{
+			BaseFeePerGas: uint256.NewInt(1),
+			ExtraData:     []byte{},
+			Transactions:  []bellatrix.Transaction{},
+			Withdrawals:   []*capella.Withdrawal{},
+		},
+		BlobsBundle: &builderDeneb.BlobsBundle{},
+	}
+}
+
+type testRelay struct {
+	*httptest.Server
+	requests atomic.Int32
+}
+
+// newTestRelay returns a relay answering with the given status codes, the last one repeats.
+func newTestRelay(t *testing.T, check func(r *http.Request, body []byte), statuses ...int) *testRelay {
+	relay := &testRelay{}
+	relay.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, submitBlockPath, r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if check != nil {
+			check(r, body)
+		}
+
+		indx := int(relay.requests.Add(1)) - 1
+		if indx >= len(statuses) {
+			indx = len(statuses) - 1
+		}
+		w.WriteHeader(statuses[indx])
+	}))
+	t.Cleanup(relay.Close)
+	return relay
+}
+
+func TestSubmitter_Submit(t *testing.T) {
+	ok := newTestRelay(t, nil, http.StatusOK)
+	flaky := newTestRelay(t, nil, http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK)
+	rejecting := newTestRelay(t, nil, http.StatusBadRequest)
+
+	results, err := NewSubmitter(nil).Submit(context.Background(), []string{ok.URL, flaky.URL, rejecting.URL, "http://127.0.0.1:1"}, newTestBid(), Options{MaxRetries: 3})
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	require.Equal(t, &Result{Relay: ok.URL, Success: true, StatusCode: http.StatusOK, Attempts: 1}, results[0])
+	require.Equal(t, &Result{Relay: flaky.URL, Success: true, StatusCode: http.StatusOK, Attempts: 3}, results[1])
+
+	require.False(t, results[2].Success)
+	require.Equal(t, http.StatusBadRequest, results[2].StatusCode)
+	require.Equal(t, 1, results[2].Attempts)
+	require.NotEmpty(t, results[2].Error)
+
+	// unreachable relays are retried as well
+	require.False(t, results[3].Success)
+	require.Equal(t, 4, results[3].Attempts)
+}
+
+func TestSubmitter_Encoding(t *testing.T) {
+	bid := newTestBid()
+
+	relay := newTestRelay(t, func(r *http.Request, body []byte) {
+		require.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+		require.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+
+		zr, err := gzip.NewReader(bytes.NewReader(body))
+		require.NoError(t, err)
+		data, err := io.ReadAll(zr)
+		require.NoError(t, err)
+
+		var received builderDeneb.SubmitBlockRequest
+		require.NoError(t, received.UnmarshalSSZ(data))
+		require.Equal(t, bid.Message.Slot, received.Message.Slot)
+	}, http.StatusOK)
+
+	results, err := NewSubmitter(nil).Submit(context.Background(), []string{relay.URL}, bid, Options{SSZ: true, Gzip: true})
+	require.NoError(t, err)
+	require.True(t, results[0].Success, results[0].Error)
+}
+
+func TestSubmitter_Deadline(t *testing.T) {
+	failing := newTestRelay(t, nil, http.StatusInternalServerError)
+
+	start := time.Now()
+	results, err := NewSubmitter(nil).Submit(context.Background(), []string{failing.URL}, newTestBid(), Options{MaxRetries: 100, Deadline: time.Now().Add(250 * time.Millisecond)})
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), 2*time.Second)
+
+	require.False(t, results[0].Success)
+	require.Greater(t, results[0].Attempts, 1)
+	require.Less(t, results[0].Attempts, 100)
+}
+
+func TestSubmitter_Limits(t *testing.T) {
+	defer func(backoff, deadline time.Duration) {
+		retryBackoff, DefaultDeadline = backoff, deadline
+	}(retryBackoff, DefaultDeadline)
+	failing := newTestRelay(t, nil, http.StatusInternalServerError)
+
+	// the retries are capped
+	retryBackoff = time.Millisecond
+	results, err := NewSubmitter(nil).Submit(context.Background(), []string{failing.URL}, newTestBid(), Options{MaxRetries: math.MaxInt})
+	require.NoError(t, err)
+	require.Equal(t, MaxRetries+1, results[0].Attempts)
+
+	// the deadline is at most one slot from now
+	retryBackoff = time.Second
+	DefaultDeadline = 100 * time.Millisecond
+
+	start := time.Now()
+	results, err = NewSubmitter(nil).Submit(context.Background(), []string{failing.URL}, newTestBid(), Options{MaxRetries: 3, Deadline: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), time.Second)
+	require.Equal(t, 1, results[0].Attempts)
+}
@@ -0,0 +1,294 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	// kettleHealthCheckInterval is how often the kettles of a multi-kettle
+	// client are health-checked.
+	kettleHealthCheckInterval = 10 * time.Second
+
+	// kettleHealthCheckTimeout bounds a single health check of a kettle.
+	kettleHealthCheckTimeout = 5 * time.Second
+)
+
+// Kettle is an execution node endpoint together with the address of the kettle
+// serving confidential compute requests on it.
+type Kettle struct {
+	RPC     *rpc.Client
+	Address common.Address
+}
+
+// KettleSelection is the strategy a multi-kettle client picks the kettle of a
+// request with.
+type KettleSelection int
+
+const (
+	// RoundRobin spreads the requests over the healthy kettles in turn.
+	RoundRobin KettleSelection = iota
+
+	// StickyByRecord sends the requests bound to the same data record to the
+	// same kettle, and spreads the other ones in turn.
+	StickyByRecord
+
+	// LowestLatency sends the requests to the kettle that answered its last
+	// health check the fastest.
+	LowestLatency
+)
+
+// kettleEndpoint is a kettle of the client along with its last known health.
+type kettleEndpoint struct {
+	rpc     *ethclient.Client
+	address common.Address
+
+	healthy bool
+	latency time.Duration
+}
+
+// kettleSet picks the kettles serving the requests of a client, failing over
+// to the next one when a kettle is unreachable.
+type kettleSet struct {
+	selection KettleSelection
+	kettles   []*kettleEndpoint
+	turn      uint64 // round-robin counter, accessed atomically
+	last      *kettleEndpoint
+
+	lock sync.RWMutex // protects the health of the kettles and the last one
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newKettleSet(selection KettleSelection, kettles []*kettleEndpoint) *kettleSet {
+	return &kettleSet{
+		selection: selection,
+		kettles:   kettles,
+		last:      kettles[0],
+		quit:      make(chan struct{}),
+	}
+}
+
+// NewMultiKettleClient creates a client sending its requests to a set of kettles
+// sharing a confidential store. The kettles are health-checked periodically and
+// picked per request with the selection strategy, failing over to the next one
+// if a kettle is unreachable.
+func NewMultiKettleClient(kettles []Kettle, key *ecdsa.PrivateKey, selection KettleSelection) (*Client, error) {
+	if len(kettles) == 0 {
+		return nil, errors.New("no kettles")
+	}
+	endpoints := make([]*kettleEndpoint, len(kettles))
+	for i, kettle := range kettles {
+		endpoints[i] = &kettleEndpoint{
+			rpc:     ethclient.NewClient(kettle.RPC),
+			address: kettle.Address,
+		}
+	}
+	set := newKettleSet(selection, endpoints)
+	if !set.check() {
+		return nil, errors.New("no healthy kettle")
+	}
+	set.wg.Add(1)
+	go set.loop()
+
+	c := &Client{
+		key:     key,
+		kettles: set,
+	}
+	c.nonces = newNonceManager(crypto.PubkeyToAddress(key.PublicKey))
+	return c, nil
+}
+
+// loop health-checks the kettles until the set is closed.
+func (s *kettleSet) loop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(kettleHealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.check()
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// check health-checks all the kettles concurrently, and reports whether any of
+// them is healthy.
+func (s *kettleSet) check() bool {
+	var wg sync.WaitGroup
+	for _, kettle := range s.kettles {
+		wg.Add(1)
+		go func(kettle *kettleEndpoint) {
+			defer wg.Done()
+
+			latency, err := checkKettle(kettle)
+
+			s.lock.Lock()
+			kettle.healthy, kettle.latency = err == nil, latency
+			s.lock.Unlock()
+		}(kettle)
+	}
+	wg.Wait()
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for _, kettle := range s.kettles {
+		if kettle.healthy {
+			return true
+		}
+	}
+	return false
+}
+
+// checkKettle ensures the node still serves the kettle through eth_kettleAddress,
+// and returns how long it took to answer.
+func checkKettle(kettle *kettleEndpoint) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), kettleHealthCheckTimeout)
+	defer cancel()
+
+	var (
+		start = time.Now()
+		addrs []common.Address
+	)
+	if err := kettle.rpc.Client().CallContext(ctx, &addrs, "eth_kettleAddress"); err != nil {
+		return 0, err
+	}
+	latency := time.Since(start)
+
+	for _, addr := range addrs {
+		if addr == kettle.address {
+			return latency, nil
+		}
+	}
+	return 0, fmt.Errorf("kettle %s not served by the node", kettle.address)
+}
+
+// candidates returns the kettles to send a request to in order of preference.
+// The healthy kettles are ordered by the selection strategy, with the unhealthy
+// ones left as the last resort. The key binds the request to a data record.
+func (s *kettleSet) candidates(key []byte) []*kettleEndpoint {
+	if len(s.kettles) == 1 {
+		return s.kettles
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	healthy, unhealthy := s.partition()
+	switch {
+	case s.selection == LowestLatency:
+		sort.SliceStable(healthy, func(i, j int) bool {
+			return healthy[i].latency < healthy[j].latency
+		})
+	case s.selection == StickyByRecord && key != nil:
+		// Rendezvous hashing keeps the records of the other kettles in place
+		// when one of them becomes unavailable.
+		weight := func(kettle *kettleEndpoint) []byte {
+			return crypto.Keccak256(key, kettle.address.Bytes())
+		}
+		sort.SliceStable(healthy, func(i, j int) bool {
+			return bytes.Compare(weight(healthy[i]), weight(healthy[j])) > 0
+		})
+	case len(healthy) > 0:
+		turn := int((atomic.AddUint64(&s.turn, 1) - 1) % uint64(len(healthy)))
+		healthy = append(append([]*kettleEndpoint{}, healthy[turn:]...), healthy[:turn]...)
+	}
+	return append(healthy, unhealthy...)
+}
+
+// do runs the call on the kettles, healthy ones first, until one of them is
+// reachable to serve it.
+func (s *kettleSet) do(call func(kettle *kettleEndpoint) error) error {
+	s.lock.RLock()
+	healthy, unhealthy := s.partition()
+	s.lock.RUnlock()
+
+	kettles := append(healthy, unhealthy...)
+	for i, kettle := range kettles {
+		err := call(kettle)
+		if !isKettleUnreachable(err) {
+			s.served(kettle)
+			return err
+		}
+		if i == len(kettles)-1 {
+			return err
+		}
+		s.markUnhealthy(kettle)
+	}
+	return nil
+}
+
+// partition splits the kettles into the healthy and unhealthy ones. The lock
+// must be held.
+func (s *kettleSet) partition() (healthy []*kettleEndpoint, unhealthy []*kettleEndpoint) {
+	for _, kettle := range s.kettles {
+		if kettle.healthy {
+			healthy = append(healthy, kettle)
+		} else {
+			unhealthy = append(unhealthy, kettle)
+		}
+	}
+	return healthy, unhealthy
+}
+
+// served records the kettle as the last one a request reached.
+func (s *kettleSet) served(kettle *kettleEndpoint) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.last = kettle
+}
+
+// lastServed returns the last kettle a request reached.
+func (s *kettleSet) lastServed() *kettleEndpoint {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.last
+}
+
+// markUnhealthy takes the kettle out of rotation until its next health check.
+func (s *kettleSet) markUnhealthy(kettle *kettleEndpoint) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	kettle.healthy = false
+}
+
+// Close stops the health checks of the kettles.
+func (s *kettleSet) Close() {
+	select {
+	case <-s.quit:
+	default:
+		close(s.quit)
+	}
+	s.wg.Wait()
+}
+
+// isKettleUnreachable reports whether a request failed because the connection
+// to the kettle could not be established, so that it never reached the kettle.
+// Any other failure, like a timeout or a dropped connection once the request is
+// sent, may happen after the kettle executed the request, and is not retried on
+// another kettle.
+func isKettleUnreachable(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -0,0 +1,65 @@
+package sdk
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// nonceManager hands out the nonces of a single sender locally, so that
+// concurrent requests from the same key do not collide on the pending nonce
+// of the node.
+type nonceManager struct {
+	addr common.Address
+
+	lock   sync.Mutex
+	synced bool
+	next   uint64
+}
+
+func newNonceManager(addr common.Address) *nonceManager {
+	return &nonceManager{
+		addr: addr,
+	}
+}
+
+// Next returns the next nonce of the sender, syncing it with the pending nonce
+// of the node first if needed.
+func (n *nonceManager) Next(ctx context.Context, rpc *ethclient.Client) (uint64, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if !n.synced {
+		nonce, err := rpc.PendingNonceAt(ctx, n.addr)
+		if err != nil {
+			return 0, err
+		}
+		n.next, n.synced = nonce, true
+	}
+	nonce := n.next
+	n.next++
+	return nonce, nil
+}
+
+// Release returns a nonce that was not used. The last handed out nonce is
+// reused right away, any other one leaves a gap so the nonces are resynced.
+func (n *nonceManager) Release(nonce uint64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.synced && nonce+1 == n.next {
+		n.next = nonce
+	} else {
+		n.synced = false
+	}
+}
+
+// Reset drops the local nonce so the next one is synced with the node.
+func (n *nonceManager) Reset() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.synced = false
+}
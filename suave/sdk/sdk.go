@@ -3,22 +3,46 @@ package sdk
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
+	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/rpc"
+	suave "github.com/ethereum/go-ethereum/suave/core"
 )
 
 var defaultGasLimit = uint64(10000000)
 
+const (
+	// maxSendAttempts is the number of times a request is submitted before
+	// giving up on nonce or underpriced errors.
+	maxSendAttempts = 5
+
+	// gasPriceBump is the percentage the gas price of an underpriced request is
+	// bumped by, matching the default price bump of the transaction pool.
+	gasPriceBump = 10
+
+	// gasPriceCacheTime is how long the suggested gas price is reused for.
+	gasPriceCacheTime = 5 * time.Second
+
+	// receiptPollInterval is how often receipts are polled for when the node
+	// does not support new head subscriptions.
+	receiptPollInterval = 100 * time.Millisecond
+)
+
 func SetDefaultGasLimit(gasLimit uint64) {
 	defaultGasLimit = gasLimit
 }
@@ -34,6 +58,7 @@ type Contract struct {
 	addr   common.Address
 	abi    *abi.ABI
 	client *Client
+	record []byte // data record the requests are bound to, if any
 }
 
 func GetContract(addr common.Address, abi *abi.ABI, client *Client) *Contract {
@@ -49,24 +74,26 @@ func (c *Contract) Address() common.Address {
 	return c.addr
 }
 
-func (c *Contract) SendTransaction(method string, args []interface{}, confidentialDataBytes []byte) (*TransactionResult, error) {
-	signer, err := c.client.GetSigner()
-	if err != nil {
-		return nil, err
-	}
+// WithRecord returns a copy of the contract whose requests are bound to the data
+// record, sending them to the same kettle with the StickyByRecord selection.
+func (c *Contract) WithRecord(id types.DataId) *Contract {
+	cpy := *c
+	cpy.record = id[:]
+	return &cpy
+}
 
+func (c *Contract) SendTransaction(method string, args []interface{}, confidentialDataBytes []byte) (*TransactionResult, error) {
 	calldata, err := c.abi.Pack(method, args...)
 	if err != nil {
 		return nil, err
 	}
+	return c.client.sendConfidentialRequest(context.Background(), c.addr, c.record, calldata, confidentialDataBytes)
+}
 
-	senderAddr := crypto.PubkeyToAddress(c.client.key.PublicKey)
-	nonce, err := c.client.rpc.PendingNonceAt(context.Background(), senderAddr)
-	if err != nil {
-		return nil, err
-	}
-
-	gasPrice, err := c.client.rpc.SuggestGasPrice(context.Background())
+// sendConfidentialRequest sends a confidential compute request calling the
+// contract with the calldata, to the kettle picked for the record key.
+func (c *Client) sendConfidentialRequest(ctx context.Context, addr common.Address, key []byte, calldata []byte, confidentialDataBytes []byte) (*TransactionResult, error) {
+	signer, err := c.GetSigner()
 	if err != nil {
 		return nil, err
 	}
@@ -75,11 +102,14 @@ func (c *Contract) SendTransaction(method string, args []interface{}, confidenti
 
 	if gasLimit == 0 {
 		var estimatedGasLimit hexutil.Uint64
-		err = c.client.rpc.Client().Call(&estimatedGasLimit, "eth_estimateGas", ethapi.TransactionArgs{
-			To:                 &c.addr,
-			IsConfidential:     true,
-			ConfidentialInputs: (*hexutil.Bytes)(&confidentialDataBytes),
-			Data:               (*hexutil.Bytes)(&calldata),
+		err = c.kettles.do(func(kettle *kettleEndpoint) error {
+			return kettle.rpc.Client().CallContext(ctx, &estimatedGasLimit, "eth_estimateGas", ethapi.TransactionArgs{
+				To:                 &addr,
+				IsConfidential:     true,
+				KettleAddress:      &kettle.address,
+				ConfidentialInputs: (*hexutil.Bytes)(&confidentialDataBytes),
+				Data:               (*hexutil.Bytes)(&calldata),
+			})
 		})
 		if err != nil {
 			return nil, err
@@ -87,49 +117,87 @@ func (c *Contract) SendTransaction(method string, args []interface{}, confidenti
 		gasLimit = uint64(estimatedGasLimit)
 	}
 
-	record := types.ConfidentialComputeRecord{
-		KettleAddress: c.client.kettleAddress,
-		Nonce:         nonce,
-		To:            &c.addr,
-		Value:         nil,
-		GasPrice:      gasPrice,
-		Gas:           gasLimit,
-		Data:          calldata,
-	}
-	if c.client.useEIP712 {
-		record.ChainID = signer.ChainID()
-		record.IsEIP712 = true
-	}
+	return c.send(ctx, key, nil, nil, func(nonce uint64, gasPrice *big.Int, kettleAddress common.Address) (*types.Transaction, error) {
+		record := types.ConfidentialComputeRecord{
+			KettleAddress: kettleAddress,
+			Nonce:         nonce,
+			To:            &addr,
+			Value:         nil,
+			GasPrice:      gasPrice,
+			Gas:           gasLimit,
+			Data:          calldata,
+		}
+		if c.useEIP712 {
+			record.ChainID = signer.ChainID()
+			record.IsEIP712 = true
+		}
 
-	computeRequest, err := types.SignTx(types.NewTx(&types.ConfidentialComputeRequest{
-		ConfidentialComputeRecord: record,
-		ConfidentialInputs:        confidentialDataBytes,
-	}), signer, c.client.key)
-	if err != nil {
-		return nil, err
-	}
+		return types.SignTx(types.NewTx(&types.ConfidentialComputeRequest{
+			ConfidentialComputeRecord: record,
+			ConfidentialInputs:        confidentialDataBytes,
+		}), signer, c.key)
+	})
+}
 
-	computeRequestBytes, err := computeRequest.MarshalBinary()
+// Call runs the method as a confidential compute request on the kettle without
+// sending it, and unpacks the values returned by the method.
+func (c *Contract) Call(method string, args []interface{}, confidentialDataBytes []byte) ([]interface{}, error) {
+	calldata, err := c.abi.Pack(method, args...)
 	if err != nil {
 		return nil, err
 	}
 
-	var hash common.Hash
-	if err = c.client.rpc.Client().Call(&hash, "eth_sendRawTransaction", hexutil.Encode(computeRequestBytes)); err != nil {
-		return nil, err
+	senderAddr := crypto.PubkeyToAddress(c.client.key.PublicKey)
+
+	kettles := c.client.kettles.candidates(c.record)
+	for i, kettle := range kettles {
+		var result hexutil.Bytes
+		err = kettle.rpc.Client().Call(&result, "eth_call", ethapi.TransactionArgs{
+			From:               &senderAddr,
+			To:                 &c.addr,
+			IsConfidential:     true,
+			KettleAddress:      &kettle.address,
+			ConfidentialInputs: (*hexutil.Bytes)(&confidentialDataBytes),
+			Data:               (*hexutil.Bytes)(&calldata),
+		}, "latest")
+		if !isKettleUnreachable(err) {
+			c.client.kettles.served(kettle)
+			if err != nil {
+				break
+			}
+			return c.abi.Unpack(method, result)
+		}
+		if i == len(kettles)-1 {
+			break
+		}
+		c.client.kettles.markUnhealthy(kettle)
 	}
+	return nil, err
+}
 
-	res := &TransactionResult{
-		clt:  c.client,
-		hash: hash,
+// PackConfidentialInputs ABI encodes the values as the confidential inputs of a
+// request, following the solidity types the contract decodes them into.
+func PackConfidentialInputs(kinds []string, values ...interface{}) ([]byte, error) {
+	if len(kinds) != len(values) {
+		return nil, fmt.Errorf("confidential inputs mismatch: %d types, %d values", len(kinds), len(values))
 	}
-	return res, nil
+	args := make(abi.Arguments, len(kinds))
+	for i, kind := range kinds {
+		typ, err := abi.NewType(kind, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = abi.Argument{Type: typ}
+	}
+	return args.Pack(values...)
 }
 
 type TransactionResult struct {
 	clt     *Client
+	rpc     *ethclient.Client // node of the kettle the transaction was sent to
 	hash    common.Hash
 	receipt *types.Receipt
+	suaveTx *types.Transaction
 }
 
 func (t *TransactionResult) Wait() (*types.Receipt, error) {
@@ -141,59 +209,156 @@ func (t *TransactionResult) WaitWithTimeout(timeout time.Duration) (*types.Recei
 		return t.receipt, nil
 	}
 
-	timer := time.NewTimer(timeout)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 
-	var receipt *types.Receipt
-	var err error
+	// Check the receipt on every new head if the node can notify about them,
+	// and fall back to polling for it otherwise.
+	heads := make(chan *types.Header, 1)
+	sub, err := t.rpc.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return t.pollReceipt(ctx)
+	}
+	defer sub.Unsubscribe()
 
 	for {
+		if receipt, err := t.fetchReceipt(ctx); receipt != nil || err != nil {
+			return receipt, err
+		}
 		select {
-		case <-timer.C:
+		case <-heads:
+		case <-sub.Err():
+			return t.pollReceipt(ctx)
+		case <-ctx.Done():
 			return nil, fmt.Errorf("timeout")
-		case <-time.After(100 * time.Millisecond):
-			receipt, err = t.clt.rpc.TransactionReceipt(context.Background(), t.hash)
-			if err != nil && err != ethereum.NotFound {
-				return nil, err
-			}
-			if receipt != nil {
-				t.receipt = receipt
-				return t.receipt, nil
+		}
+	}
+}
+
+func (t *TransactionResult) pollReceipt(ctx context.Context) (*types.Receipt, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timeout")
+		case <-time.After(receiptPollInterval):
+			if receipt, err := t.fetchReceipt(ctx); receipt != nil || err != nil {
+				return receipt, err
 			}
 		}
 	}
 }
 
+// fetchReceipt returns the receipt of the transaction, or nil if it has not
+// been included yet.
+func (t *TransactionResult) fetchReceipt(ctx context.Context) (*types.Receipt, error) {
+	receipt, err := t.rpc.TransactionReceipt(ctx, t.hash)
+	if err != nil {
+		if err == ethereum.NotFound {
+			return nil, nil
+		}
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("timeout")
+		}
+		return nil, err
+	}
+	t.receipt = receipt
+	return receipt, nil
+}
+
 func (t *TransactionResult) Hash() common.Hash {
 	return t.hash
 }
 
+// ComputeResult waits for the SUAVE transaction of the request and returns its
+// confidential compute result, without the logs emitted while computing it.
+func (t *TransactionResult) ComputeResult() ([]byte, error) {
+	tx, err := t.suaveTransaction()
+	if err != nil {
+		return nil, err
+	}
+	result, _ := suave.SplitComputeResult(tx.Data())
+	return result, nil
+}
+
+// Logs waits for the SUAVE transaction of the request and returns the logs
+// emitted while computing its confidential compute result.
+func (t *TransactionResult) Logs() ([]*types.Log, error) {
+	tx, err := t.suaveTransaction()
+	if err != nil {
+		return nil, err
+	}
+	_, execResult := suave.SplitComputeResult(tx.Data())
+
+	for i, log := range execResult.Logs {
+		log.BlockNumber = t.receipt.BlockNumber.Uint64()
+		log.TxHash = t.receipt.TxHash
+		log.TxIndex = t.receipt.TransactionIndex
+		log.BlockHash = t.receipt.BlockHash
+		log.Index = uint(i)
+	}
+	return execResult.Logs, nil
+}
+
+func (t *TransactionResult) suaveTransaction() (*types.Transaction, error) {
+	if t.suaveTx != nil {
+		return t.suaveTx, nil
+	}
+	if _, err := t.Wait(); err != nil {
+		return nil, err
+	}
+	tx, _, err := t.rpc.TransactionByHash(context.Background(), t.hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Type() != types.SuaveTxType {
+		return nil, fmt.Errorf("transaction %s is not a SUAVE transaction", t.hash)
+	}
+	t.suaveTx = tx
+	return tx, nil
+}
+
+var _ bind.SuaveBackend = &Client{}
+
 type Client struct {
-	rpc           *ethclient.Client
-	key           *ecdsa.PrivateKey
-	kettleAddress common.Address
-	useEIP712     bool
+	key       *ecdsa.PrivateKey
+	useEIP712 bool
+
+	kettles *kettleSet
+	nonces  *nonceManager
+
+	gasPriceLock sync.Mutex
+	gasPrice     *big.Int
+	gasPriceTime time.Time
 }
 
 func NewClient(rpc *rpc.Client, key *ecdsa.PrivateKey, kettleAddress common.Address) *Client {
 	c := &Client{
-		rpc:           ethclient.NewClient(rpc),
-		key:           key,
-		kettleAddress: kettleAddress,
+		key:     key,
+		kettles: newKettleSet(RoundRobin, []*kettleEndpoint{{rpc: ethclient.NewClient(rpc), address: kettleAddress, healthy: true}}),
 	}
+	c.nonces = newNonceManager(crypto.PubkeyToAddress(key.PublicKey))
 	return c
 }
 
+// Close stops the health checks of the kettles of the client.
+func (c *Client) Close() {
+	c.kettles.Close()
+}
+
 func (c *Client) WithEIP712() *Client {
 	c.useEIP712 = true
 	return c
 }
 
+// KettleAddress returns the address of the last kettle a request of the client
+// reached.
 func (c *Client) KettleAddress() common.Address {
-	return c.kettleAddress
+	return c.kettles.lastServed().address
 }
 
+// RPC returns the node of the last kettle a request of the client reached.
 func (c *Client) RPC() *ethclient.Client {
-	return c.rpc
+	return c.kettles.lastServed().rpc
 }
 
 func (c *Client) Key() *ecdsa.PrivateKey {
@@ -205,7 +370,11 @@ func (c *Client) SenderAddr() common.Address {
 }
 
 func (c *Client) GetSigner() (types.Signer, error) {
-	chainID, err := c.rpc.ChainID(context.TODO())
+	var chainID *big.Int
+	err := c.kettles.do(func(kettle *kettleEndpoint) (err error) {
+		chainID, err = kettle.rpc.ChainID(context.TODO())
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -233,55 +402,190 @@ func (c *Client) SignTxn(txn *types.LegacyTx) (*types.Transaction, error) {
 func (c *Client) SendTransaction(wrappedTxData *types.LegacyTx) (*TransactionResult, error) {
 	senderAddr := crypto.PubkeyToAddress(c.key.PublicKey)
 
-	if wrappedTxData.Nonce == 0 {
-		nonce, err := c.rpc.PendingNonceAt(context.Background(), senderAddr)
+	if wrappedTxData.Gas == 0 {
+		err := c.kettles.do(func(kettle *kettleEndpoint) error {
+			gasPrice := wrappedTxData.GasPrice
+			if gasPrice == nil {
+				var err error
+				if gasPrice, err = c.suggestGasPrice(context.Background(), kettle.rpc); err != nil {
+					return err
+				}
+			}
+			estimateMsg := ethereum.CallMsg{
+				From:     senderAddr,
+				To:       wrappedTxData.To,
+				GasPrice: gasPrice,
+				Value:    wrappedTxData.Value,
+				Data:     wrappedTxData.Data,
+			}
+			gasLimit, err := kettle.rpc.EstimateGas(context.Background(), estimateMsg)
+			if err != nil {
+				return err
+			}
+			wrappedTxData.Gas = gasLimit
+			return nil
+		})
 		if err != nil {
 			return nil, err
 		}
-		wrappedTxData.Nonce = nonce
 	}
 
-	if wrappedTxData.GasPrice == nil {
-		gasPrice, err := c.rpc.SuggestGasPrice(context.Background())
-		if err != nil {
-			return nil, err
-		}
-		wrappedTxData.GasPrice = gasPrice
+	// An explicit nonce or gas price is kept as is instead of being managed
+	var nonce *uint64
+	if wrappedTxData.Nonce != 0 {
+		nonce = &wrappedTxData.Nonce
 	}
+	return c.send(context.Background(), nil, nonce, wrappedTxData.GasPrice, func(nonce uint64, gasPrice *big.Int, kettleAddress common.Address) (*types.Transaction, error) {
+		txData := *wrappedTxData
+		txData.Nonce, txData.GasPrice = nonce, gasPrice
+		return c.SignTxn(&txData)
+	})
+}
 
-	if wrappedTxData.Gas == 0 {
-		estimateMsg := ethereum.CallMsg{
-			From:     senderAddr,
-			To:       wrappedTxData.To,
-			GasPrice: wrappedTxData.GasPrice,
-			Value:    wrappedTxData.Value,
-			Data:     wrappedTxData.Data,
-		}
-		gasLimit, err := c.rpc.EstimateGas(context.Background(), estimateMsg)
-		if err != nil {
-			return nil, err
-		}
-		wrappedTxData.Gas = gasLimit
+// SendConfidentialRequest sends a confidential compute request calling the
+// contract with the input data and confidential inputs, implementing the
+// bind.SuaveBackend of the SUAVE bindings.
+func (c *Client) SendConfidentialRequest(ctx context.Context, contract common.Address, data []byte, confidentialInputs []byte) (bind.SuaveTransaction, error) {
+	res, err := c.sendConfidentialRequest(ctx, contract, nil, data, confidentialInputs)
+	if err != nil {
+		return nil, err
 	}
+	return res, nil
+}
 
-	txn, err := c.SignTxn(wrappedTxData)
+// DeployContract sends a transaction creating a contract with the code,
+// implementing the bind.SuaveBackend of the SUAVE bindings.
+func (c *Client) DeployContract(ctx context.Context, code []byte) (bind.SuaveTransaction, error) {
+	res, err := DeployContract(code, c)
 	if err != nil {
 		return nil, err
 	}
+	return res, nil
+}
+
+// send signs the transaction built for the next nonce of the client and sends
+// it to the kettle picked for the record key. Transactions rejected for a stale
+// nonce are resubmitted with a synced nonce, underpriced ones with a bumped gas
+// price, and the ones failing to connect to the kettle are signed for and sent
+// to the next kettle instead.
+//
+// The gas price of a confidential request is only checked by the pool once the
+// request executed, so an underpriced confidential request is never resubmitted.
+//
+// Resending a confidential request executes its computation again, with its
+// confidential store writes and external calls. Thus, only the errors the kettle
+// returns before executing the request are retried, and they are matched
+// exactly: the kettle wraps the errors of the pool once the request executed.
+// A replacement underpriced error means that another request holds the nonce,
+// so its gas price is never bumped to replace it.
+func (c *Client) send(ctx context.Context, key []byte, nonce *uint64, gasPrice *big.Int, build func(nonce uint64, gasPrice *big.Int, kettleAddress common.Address) (*types.Transaction, error)) (*TransactionResult, error) {
+	var (
+		kettles      = c.kettles.candidates(key)
+		managed      = nonce == nil
+		synced       = !managed
+		confidential bool
+		next         uint64
+		err          error
+	)
+	if !managed {
+		next = *nonce
+	}
 
+loop:
+	for attempt := 1; ; attempt++ {
+		kettle := kettles[0]
+		err = nil
+		if !synced {
+			if next, err = c.nonces.Next(ctx, kettle.rpc); err == nil {
+				synced = true
+			}
+		}
+		if err == nil && gasPrice == nil {
+			gasPrice, err = c.suggestGasPrice(ctx, kettle.rpc)
+		}
+		if err == nil {
+			var txn *types.Transaction
+			if txn, err = build(next, gasPrice, kettle.address); err != nil {
+				break
+			}
+			confidential = txn.Type() == types.ConfidentialComputeRequestTxType
+			var hash common.Hash
+			hash, err = sendRawTransaction(ctx, kettle.rpc, txn)
+			if !isKettleUnreachable(err) {
+				c.kettles.served(kettle)
+			}
+			if err == nil {
+				return &TransactionResult{
+					clt:  c,
+					rpc:  kettle.rpc,
+					hash: hash,
+				}, nil
+			}
+		}
+		if attempt == maxSendAttempts {
+			break
+		}
+		switch {
+		case isKettleUnreachable(err) && len(kettles) > 1:
+			c.kettles.markUnhealthy(kettle)
+			kettles = kettles[1:]
+		case managed && synced && isError(err, core.ErrNonceTooLow):
+			c.nonces.Reset()
+			synced = false
+		case gasPrice != nil && !confidential && isError(err, txpool.ErrUnderpriced):
+			gasPrice = bumpGasPrice(gasPrice)
+		default:
+			break loop
+		}
+	}
+	if managed && synced {
+		c.nonces.Release(next)
+	}
+	return nil, err
+}
+
+// isError reports whether the error returned by the kettle is exactly the
+// target error, the errors being sent over JSON-RPC as their message.
+func isError(err error, target error) bool {
+	return err != nil && (errors.Is(err, target) || err.Error() == target.Error())
+}
+
+// bumpGasPrice raises the gas price by gasPriceBump percent, and at least by
+// one wei.
+func bumpGasPrice(gasPrice *big.Int) *big.Int {
+	bump := new(big.Int).Div(new(big.Int).Mul(gasPrice, big.NewInt(gasPriceBump)), big.NewInt(100))
+	if bump.Sign() == 0 {
+		bump.SetInt64(1)
+	}
+	return bump.Add(bump, gasPrice)
+}
+
+func sendRawTransaction(ctx context.Context, rpc *ethclient.Client, txn *types.Transaction) (common.Hash, error) {
 	txnBytes, err := txn.MarshalBinary()
 	if err != nil {
-		return nil, err
+		return common.Hash{}, err
 	}
 
 	var hash common.Hash
-	if err = c.rpc.Client().Call(&hash, "eth_sendRawTransaction", hexutil.Encode(txnBytes)); err != nil {
-		return nil, err
+	if err = rpc.Client().CallContext(ctx, &hash, "eth_sendRawTransaction", hexutil.Encode(txnBytes)); err != nil {
+		return common.Hash{}, err
 	}
+	return hash, nil
+}
+
+// suggestGasPrice returns the gas price suggested by the node, cached for a
+// short while to not query it on every request.
+func (c *Client) suggestGasPrice(ctx context.Context, rpc *ethclient.Client) (*big.Int, error) {
+	c.gasPriceLock.Lock()
+	defer c.gasPriceLock.Unlock()
 
-	res := &TransactionResult{
-		clt:  c,
-		hash: hash,
+	if c.gasPrice != nil && time.Since(c.gasPriceTime) < gasPriceCacheTime {
+		return new(big.Int).Set(c.gasPrice), nil
 	}
-	return res, nil
+	gasPrice, err := rpc.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.gasPrice, c.gasPriceTime = gasPrice, time.Now()
+	return new(big.Int).Set(gasPrice), nil
 }
@@ -0,0 +1,436 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// mockEthAPI is a minimal eth namespace recording the transactions sent to it.
+type mockEthAPI struct {
+	lock      sync.Mutex
+	gasPrice  *big.Int
+	nonce     uint64 // nonce of the sender in the latest state
+	sent      map[uint64]*types.Transaction
+	kettles   map[uint64]common.Address // kettle each confidential request was signed for
+	sendErrs  []error                   // errors returned on the next sends
+	gasCalls  int
+	receipts  map[common.Hash]*types.Receipt
+	headsFeed chan *types.Header
+}
+
+func newMockEthAPI() *mockEthAPI {
+	return &mockEthAPI{
+		gasPrice:  big.NewInt(100),
+		sent:      make(map[uint64]*types.Transaction),
+		kettles:   make(map[uint64]common.Address),
+		receipts:  make(map[common.Hash]*types.Receipt),
+		headsFeed: make(chan *types.Header, 1),
+	}
+}
+
+func (m *mockEthAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(1))
+}
+
+func (m *mockEthAPI) GasPrice() *hexutil.Big {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.gasCalls++
+	return (*hexutil.Big)(m.gasPrice)
+}
+
+func (m *mockEthAPI) GetTransactionCount(addr common.Address, blockNrOrHash rpc.BlockNumberOrHash) hexutil.Uint64 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	nonce := m.nonce
+	for m.sent[nonce] != nil {
+		nonce++
+	}
+	return hexutil.Uint64(nonce)
+}
+
+func (m *mockEthAPI) SendRawTransaction(input hexutil.Bytes) (common.Hash, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if len(m.sendErrs) != 0 {
+		err := m.sendErrs[0]
+		m.sendErrs = m.sendErrs[1:]
+		return common.Hash{}, err
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	if tx.Nonce() < m.nonce {
+		return common.Hash{}, core.ErrNonceTooLow
+	}
+	if prev := m.sent[tx.Nonce()]; prev != nil && prev.GasPrice().Cmp(tx.GasPrice()) >= 0 {
+		return common.Hash{}, txpool.ErrReplaceUnderpriced
+	}
+	m.sent[tx.Nonce()] = tx
+	if request, ok := types.CastTxInner[*types.ConfidentialComputeRequest](tx); ok {
+		m.kettles[tx.Nonce()] = request.KettleAddress
+	}
+	return tx.Hash(), nil
+}
+
+func (m *mockEthAPI) GetTransactionReceipt(hash common.Hash) *types.Receipt {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.receipts[hash]
+}
+
+func (m *mockEthAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	sub := notifier.CreateSubscription()
+
+	go func() {
+		for {
+			select {
+			case head := <-m.headsFeed:
+				notifier.Notify(sub.ID, head)
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+// include marks the transaction as included in a new head.
+func (m *mockEthAPI) include(hash common.Hash) {
+	m.lock.Lock()
+	m.receipts[hash] = &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		TxHash:      hash,
+		BlockNumber: big.NewInt(1),
+		Logs:        []*types.Log{},
+	}
+	m.lock.Unlock()
+
+	m.headsFeed <- &types.Header{Number: big.NewInt(1), Difficulty: new(big.Int)}
+}
+
+// mockKettleAPI is the eth namespace of a kettle on the chain of the mockEthAPI.
+type mockKettleAPI struct {
+	*mockEthAPI
+	address common.Address
+	delay   time.Duration
+}
+
+func (m *mockKettleAPI) KettleAddress() []common.Address {
+	time.Sleep(m.delay)
+	return []common.Address{m.address}
+}
+
+// mockKettleServer serves a kettle over HTTP, and drops the connection of the
+// requests it receives once drop is set.
+type mockKettleServer struct {
+	*httptest.Server
+	drop atomic.Bool
+}
+
+// newMockKettles starts a kettle per delay, all sharing the same chain.
+func newMockKettles(t *testing.T, delays ...time.Duration) ([]Kettle, []*mockKettleServer, *mockEthAPI) {
+	var (
+		api     = newMockEthAPI()
+		kettles []Kettle
+		servers []*mockKettleServer
+	)
+	for i, delay := range delays {
+		srv := rpc.NewServer()
+		require.NoError(t, srv.RegisterName("eth", &mockKettleAPI{mockEthAPI: api, address: common.Address{byte(i + 1)}, delay: delay}))
+		t.Cleanup(srv.Stop)
+
+		kettleSrv := new(mockKettleServer)
+		kettleSrv.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if kettleSrv.drop.Load() {
+				conn, _, err := w.(http.Hijacker).Hijack()
+				if err == nil {
+					conn.Close()
+				}
+				return
+			}
+			srv.ServeHTTP(w, r)
+		}))
+		t.Cleanup(kettleSrv.Close)
+
+		rpcClient, err := rpc.DialHTTP(kettleSrv.URL)
+		require.NoError(t, err)
+		t.Cleanup(rpcClient.Close)
+
+		kettles = append(kettles, Kettle{RPC: rpcClient, Address: common.Address{byte(i + 1)}})
+		servers = append(servers, kettleSrv)
+	}
+	return kettles, servers, api
+}
+
+func newMockMultiKettleClient(t *testing.T, kettles []Kettle, selection KettleSelection) *Client {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	clt, err := NewMultiKettleClient(kettles, key, selection)
+	require.NoError(t, err)
+	t.Cleanup(clt.Close)
+
+	return clt
+}
+
+var mockContractABI, _ = abi.JSON(strings.NewReader(`[{"inputs":[],"name":"offchain","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"nonpayable","type":"function"}]`))
+
+func newMockClient(t *testing.T) (*Client, *mockEthAPI) {
+	api := newMockEthAPI()
+
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("eth", api))
+	t.Cleanup(srv.Stop)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	return NewClient(rpc.DialInProc(srv), key, common.Address{}), api
+}
+
+func TestClient_ConcurrentNonces(t *testing.T) {
+	clt, api := newMockClient(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := clt.SendTransaction(&types.LegacyTx{To: &common.Address{}, Gas: 21000}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// every request got its own nonce and the gas price was only queried once
+	require.Len(t, api.sent, 20)
+	for i := uint64(0); i < 20; i++ {
+		require.NotNil(t, api.sent[i])
+		require.Equal(t, api.gasPrice, api.sent[i].GasPrice())
+	}
+	require.Equal(t, 1, api.gasCalls)
+}
+
+func TestClient_Resubmission(t *testing.T) {
+	clt, api := newMockClient(t)
+
+	_, err := clt.SendTransaction(&types.LegacyTx{To: &common.Address{}, Gas: 21000})
+	require.NoError(t, err)
+
+	// the nonce is resynced when other requests from the key were included
+	api.nonce = 5
+	_, err = clt.SendTransaction(&types.LegacyTx{To: &common.Address{}, Gas: 21000})
+	require.NoError(t, err)
+	require.NotNil(t, api.sent[5])
+
+	// underpriced requests are resubmitted with a bumped gas price
+	api.sendErrs = []error{txpool.ErrUnderpriced}
+	_, err = clt.SendTransaction(&types.LegacyTx{To: &common.Address{}, Gas: 21000})
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(110), api.sent[6].GasPrice())
+
+	// other errors are not retried and do not leave a nonce gap
+	api.sendErrs = []error{errors.New("execution reverted")}
+	_, err = clt.SendTransaction(&types.LegacyTx{To: &common.Address{}, Gas: 21000})
+	require.Error(t, err)
+
+	_, err = clt.SendTransaction(&types.LegacyTx{To: &common.Address{}, Gas: 21000})
+	require.NoError(t, err)
+	require.NotNil(t, api.sent[7])
+
+	// a request holding the nonce is never replaced with a bumped gas price
+	api.sendErrs = []error{txpool.ErrReplaceUnderpriced}
+	_, err = clt.SendTransaction(&types.LegacyTx{To: &common.Address{}, Gas: 21000})
+	require.ErrorContains(t, err, txpool.ErrReplaceUnderpriced.Error())
+	require.Nil(t, api.sent[8])
+
+	// the pool errors of executed requests are not retried, since resending them
+	// would execute them again
+	api.sendErrs = []error{fmt.Errorf("confidential request executed, but its result was rejected: %w", core.ErrNonceTooLow)}
+	_, err = clt.SendTransaction(&types.LegacyTx{To: &common.Address{}, Gas: 21000})
+	require.Error(t, err)
+	require.Nil(t, api.sent[8])
+
+	_, err = clt.SendTransaction(&types.LegacyTx{To: &common.Address{}, Gas: 21000})
+	require.NoError(t, err)
+	require.NotNil(t, api.sent[8])
+
+	// requests are given up on after too many attempts
+	api.sendErrs = make([]error, maxSendAttempts)
+	for i := range api.sendErrs {
+		api.sendErrs[i] = txpool.ErrUnderpriced
+	}
+	_, err = clt.SendTransaction(&types.LegacyTx{To: &common.Address{}, Gas: 21000})
+	require.Error(t, err)
+	require.Nil(t, api.sent[9])
+}
+
+func TestClient_ConfidentialRequestUnderpriced(t *testing.T) {
+	clt, api := newMockClient(t)
+	contract := GetContract(common.Address{}, &mockContractABI, clt)
+
+	// an underpriced confidential request already executed, so it is not
+	// resubmitted with a bumped gas price
+	api.sendErrs = []error{txpool.ErrUnderpriced, txpool.ErrUnderpriced}
+	_, err := contract.SendTransaction("offchain", nil, nil)
+	require.ErrorContains(t, err, txpool.ErrUnderpriced.Error())
+	require.Len(t, api.sendErrs, 1)
+	require.Empty(t, api.sent)
+
+	// its nonce is released for the next request
+	api.sendErrs = nil
+	_, err = contract.SendTransaction("offchain", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, api.gasPrice, api.sent[0].GasPrice())
+}
+
+func TestTransactionResult_WaitNewHeads(t *testing.T) {
+	clt, api := newMockClient(t)
+
+	res, err := clt.SendTransaction(&types.LegacyTx{To: &common.Address{}, Gas: 21000})
+	require.NoError(t, err)
+
+	_, err = res.WaitWithTimeout(50 * time.Millisecond)
+	require.Error(t, err)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		api.include(res.Hash())
+	}()
+
+	receipt, err := res.WaitWithTimeout(time.Second)
+	require.NoError(t, err)
+	require.Equal(t, res.Hash(), receipt.TxHash)
+}
+
+func TestMultiKettleClient_HealthCheck(t *testing.T) {
+	kettles, _, _ := newMockKettles(t, 0, 0)
+
+	// kettles not served by their node are unhealthy
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	kettles[1].Address = common.Address{0xff}
+	clt, err := NewMultiKettleClient(kettles, key, RoundRobin)
+	require.NoError(t, err)
+	defer clt.Close()
+
+	for i := 0; i < 4; i++ {
+		require.Equal(t, kettles[0].Address, clt.kettles.candidates(nil)[0].address)
+	}
+
+	kettles[0].Address = common.Address{0xff}
+	_, err = NewMultiKettleClient(kettles, key, RoundRobin)
+	require.Error(t, err)
+}
+
+func TestMultiKettleClient_Failover(t *testing.T) {
+	kettles, servers, api := newMockKettles(t, 0, 0, 0)
+	clt := newMockMultiKettleClient(t, kettles, RoundRobin)
+	contract := GetContract(common.Address{}, &mockContractABI, clt)
+
+	// requests are spread over the kettles in turn
+	for i := 0; i < 3; i++ {
+		_, err := contract.SendTransaction("offchain", nil, nil)
+		require.NoError(t, err)
+	}
+	for i := 0; i < 3; i++ {
+		require.Equal(t, kettles[i].Address, api.kettles[uint64(i)])
+	}
+
+	// requests to an unreachable kettle are signed for and sent to the next one
+	servers[0].Close()
+	for i := 3; i < 9; i++ {
+		_, err := contract.SendTransaction("offchain", nil, nil)
+		require.NoError(t, err)
+		require.NotEqual(t, kettles[0].Address, api.kettles[uint64(i)])
+		require.Equal(t, api.kettles[uint64(i)], clt.KettleAddress())
+	}
+	require.Len(t, api.sent, 9)
+	require.False(t, clt.kettles.kettles[0].healthy)
+}
+
+func TestMultiKettleClient_NoFailoverAfterSend(t *testing.T) {
+	kettles, servers, api := newMockKettles(t, 0, 0)
+	clt := newMockMultiKettleClient(t, kettles, RoundRobin)
+	contract := GetContract(common.Address{}, &mockContractABI, clt)
+
+	// a request whose connection drops once sent may have been executed by the
+	// kettle, so it is not sent to another one
+	_, err := contract.SendTransaction("offchain", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, kettles[0].Address, clt.KettleAddress())
+
+	servers[1].drop.Store(true)
+	_, err = contract.SendTransaction("offchain", nil, nil)
+	require.Error(t, err)
+	require.Len(t, api.sent, 1)
+	require.True(t, clt.kettles.kettles[1].healthy)
+	require.Equal(t, kettles[1].Address, clt.KettleAddress())
+}
+
+func TestMultiKettleClient_StickyByRecord(t *testing.T) {
+	kettles, servers, api := newMockKettles(t, 0, 0, 0)
+	clt := newMockMultiKettleClient(t, kettles, StickyByRecord)
+	contract := GetContract(common.Address{}, &mockContractABI, clt).WithRecord(types.DataId{0x1})
+
+	// the requests of a record stick to the same kettle
+	for i := 0; i < 3; i++ {
+		_, err := contract.SendTransaction("offchain", nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, api.kettles[0], api.kettles[uint64(i)])
+	}
+
+	// and move together to another kettle when it becomes unavailable
+	for i, kettle := range kettles {
+		if kettle.Address == api.kettles[0] {
+			servers[i].Close()
+		}
+	}
+	for i := 3; i < 6; i++ {
+		_, err := contract.SendTransaction("offchain", nil, nil)
+		require.NoError(t, err)
+		require.NotEqual(t, api.kettles[0], api.kettles[uint64(i)])
+		require.Equal(t, api.kettles[3], api.kettles[uint64(i)])
+	}
+}
+
+func TestMultiKettleClient_LowestLatency(t *testing.T) {
+	kettles, _, api := newMockKettles(t, 50*time.Millisecond, 0, 50*time.Millisecond)
+	clt := newMockMultiKettleClient(t, kettles, LowestLatency)
+	contract := GetContract(common.Address{}, &mockContractABI, clt)
+
+	for i := 0; i < 3; i++ {
+		_, err := contract.SendTransaction("offchain", nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, kettles[1].Address, api.kettles[uint64(i)])
+	}
+}
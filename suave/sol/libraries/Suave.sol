@@ -5,6 +5,19 @@ pragma solidity ^0.8.8;
 library Suave {
     error PeekerReverted(address, bytes);
 
+    /// @notice The key is not set in the context.
+    /// @param key Key of the value
+    error ContextValueNotFound(string key);
+
+    /// @notice The domain of the request is not allowed by the kettle.
+    /// @param domain Domain of the request
+    error DomainNotAllowed(string domain);
+
+    /// @notice The HTTP request returned an unsuccessful status code.
+    /// @param status Status code of the response
+    /// @param body Body of the response
+    error HttpRequestFailed(uint64 status, bytes body);
+
     enum CryptoSignature {
         SECP256,
         BLS
@@ -12,6 +25,34 @@ library Suave {
 
     type DataId is bytes16;
 
+    /// @notice Entry of the access list of a transaction.
+    /// @param addr Address accessed by the transaction
+    /// @param storageKeys Storage slots of the address accessed by the transaction
+    struct AccessListEntry {
+        address addr;
+        bytes32[] storageKeys;
+    }
+
+    /// @notice Beacon chain data required to build the block of a slot.
+    /// @param slot Slot of the block
+    /// @param timestamp Timestamp of the block
+    /// @param proposerIndex Index of the proposer
+    /// @param proposerPubkey Public key of the proposer
+    /// @param random Randao mix of the block
+    /// @param withdrawals Withdrawals expected in the block
+    /// @param beaconRoot Root of the parent beacon block
+    /// @param parentHash Hash of the parent execution block
+    struct BeaconContext {
+        uint64 slot;
+        uint64 timestamp;
+        uint64 proposerIndex;
+        bytes proposerPubkey;
+        bytes32 random;
+        Withdrawal[] withdrawals;
+        bytes32 beaconRoot;
+        bytes32 parentHash;
+    }
+
     /// @notice Arguments to build the block.
     /// @param slot Slot number of the block
     /// @param proposerPubkey Public key of the proposer
@@ -24,6 +65,8 @@ library Suave {
     /// @param extra Extra data of the block
     /// @param beaconRoot Root of the beacon chain
     /// @param fillPending Whether to fill the block with pending transactions
+    /// @param algorithm Algorithm used to build the block from bundles: 'default', 'discard-failing', 'greedy' or 'greedy-merge'
+    /// @param fillPendingTimeout Maximum time in milliseconds spent filling the block with pending transactions, 0 for the node default
     struct BuildBlockArgs {
         uint64 slot;
         bytes proposerPubkey;
@@ -36,6 +79,16 @@ library Suave {
         bytes extra;
         bytes32 beaconRoot;
         bool fillPending;
+        string algorithm;
+        uint64 fillPendingTimeout;
+    }
+
+    /// @notice Hints of a bundle shared in an orderflow auction.
+    /// @param txs Hints of the transactions of the bundle, in order
+    /// @param logs Logs emitted during the simulation of the bundle
+    struct BundleHint {
+        TxHint[] txs;
+        SimulatedLog[] logs;
     }
 
     /// @notice A record of data stored in the ConfidentialStore.
@@ -54,6 +107,88 @@ library Suave {
         string version;
     }
 
+    /// @notice Header of a block of the execution node.
+    /// @param hash Hash of the block
+    /// @param parentHash Hash of the parent block
+    /// @param number Number of the block
+    /// @param timestamp Timestamp of the block
+    /// @param coinbase Fee recipient of the block
+    /// @param stateRoot Root of the state trie after the block
+    /// @param receiptsRoot Root of the receipts trie of the block
+    /// @param gasLimit Gas limit of the block
+    /// @param gasUsed Gas used by the transactions of the block
+    /// @param baseFee Base fee per gas of the block
+    /// @param prevRandao Randomness of the beacon chain for the block
+    /// @param extraData Extra data of the block
+    struct EthBlockHeader {
+        bytes32 hash;
+        bytes32 parentHash;
+        uint64 number;
+        uint64 timestamp;
+        address coinbase;
+        bytes32 stateRoot;
+        bytes32 receiptsRoot;
+        uint64 gasLimit;
+        uint64 gasUsed;
+        uint256 baseFee;
+        bytes32 prevRandao;
+        bytes extraData;
+    }
+
+    /// @notice Receipt of a transaction included by the execution node.
+    /// @param txHash Hash of the transaction
+    /// @param blockHash Hash of the block of the transaction
+    /// @param blockNumber Number of the block of the transaction
+    /// @param transactionIndex Index of the transaction in the block
+    /// @param status 1 if the transaction succeeded, 0 if it reverted
+    /// @param gasUsed Gas used by the transaction
+    /// @param cumulativeGasUsed Gas used by the block up to and including the transaction
+    /// @param effectiveGasPrice Price per gas paid by the transaction
+    /// @param contractAddress Address of the contract created by the transaction, if any
+    /// @param logs Logs emitted by the transaction
+    struct EthReceipt {
+        bytes32 txHash;
+        bytes32 blockHash;
+        uint64 blockNumber;
+        uint64 transactionIndex;
+        uint64 status;
+        uint64 gasUsed;
+        uint64 cumulativeGasUsed;
+        uint256 effectiveGasPrice;
+        address contractAddress;
+        SimulatedLog[] logs;
+    }
+
+    /// @notice Unsigned Ethereum transaction of any type. The fields which do not apply to the type are ignored.
+    /// @param txType Type of the transaction: 0 (legacy), 1 (EIP-2930), 2 (EIP-1559) or 3 (EIP-4844)
+    /// @param chainId Id of the chain to sign for
+    /// @param nonce Nonce of the sender
+    /// @param gasPrice Gas price of the legacy and EIP-2930 transactions
+    /// @param gasTipCap Maximum priority fee per gas of the EIP-1559 and EIP-4844 transactions
+    /// @param gasFeeCap Maximum fee per gas of the EIP-1559 and EIP-4844 transactions
+    /// @param gas Gas limit of the transaction
+    /// @param to Recipient of the transaction, the zero address for a contract creation
+    /// @param value Value transferred by the transaction
+    /// @param data Calldata of the transaction
+    /// @param accessList Access list of the typed transactions
+    /// @param blobFeeCap Maximum fee per blob gas of the EIP-4844 transactions
+    /// @param blobHashes Versioned hashes of the blobs of the EIP-4844 transactions
+    struct EthTransaction {
+        uint8 txType;
+        uint256 chainId;
+        uint64 nonce;
+        uint256 gasPrice;
+        uint256 gasTipCap;
+        uint256 gasFeeCap;
+        uint64 gas;
+        address to;
+        uint256 value;
+        bytes data;
+        AccessListEntry[] accessList;
+        uint256 blobFeeCap;
+        bytes32[] blobHashes;
+    }
+
     /// @notice Description of an HTTP request.
     /// @param url Target url of the request
     /// @param method HTTP method of the request
@@ -80,16 +215,94 @@ library Suave {
         bytes error;
     }
 
+    /// @notice Bid received or delivered by a relay.
+    /// @param slot Slot of the bid
+    /// @param parentHash Hash of the parent block
+    /// @param blockHash Hash of the block
+    /// @param builderPubkey Public key of the builder
+    /// @param proposerPubkey Public key of the proposer
+    /// @param proposerFeeRecipient Fee recipient of the proposer
+    /// @param gasLimit Gas limit of the block
+    /// @param gasUsed Gas used by the block
+    /// @param value Value paid to the proposer in wei
+    /// @param blockNumber Number of the block
+    /// @param numTx Number of transactions in the block
+    /// @param timestampMs Time the relay received the bid in milliseconds, 0 for delivered payloads
+    struct RelayBidTrace {
+        uint64 slot;
+        bytes32 parentHash;
+        bytes32 blockHash;
+        bytes builderPubkey;
+        bytes proposerPubkey;
+        address proposerFeeRecipient;
+        uint64 gasLimit;
+        uint64 gasUsed;
+        uint256 value;
+        uint64 blockNumber;
+        uint64 numTx;
+        uint64 timestampMs;
+    }
+
+    /// @notice Options of the submission of a block bid to the relays.
+    /// @param ssz Whether to encode the bid with SSZ instead of JSON
+    /// @param gzip Whether to compress the bid with gzip
+    /// @param maxRetries Number of retries of a submission failing with a transient error, at most 5
+    /// @param deadline Unix timestamp in milliseconds after which no more attempts are made, at most and by default one slot from now
+    struct RelaySubmissionOptions {
+        bool ssz;
+        bool gzip;
+        uint64 maxRetries;
+        uint64 deadline;
+    }
+
+    /// @notice Result of the submission of a block bid to a relay.
+    /// @param relayUrl URL of the relay
+    /// @param success Whether the relay accepted the bid
+    /// @param statusCode HTTP status code of the last attempt, 0 if the relay could not be reached
+    /// @param attempts Number of attempts made
+    /// @param error Error of the last attempt if the submission failed
+    struct RelaySubmissionResult {
+        string relayUrl;
+        bool success;
+        uint64 statusCode;
+        uint64 attempts;
+        string error;
+    }
+
+    /// @notice Result of a simulated bundle.
+    /// @param success Whether the bundle can be included in the block
+    /// @param error Error message if any
+    /// @param gasUsed Gas used by the bundle
+    /// @param coinbaseProfit Profit of the fee recipient net of the base fee
+    /// @param effectiveGasPrice Profit of the fee recipient per unit of gas used
+    /// @param logs Logs emitted during the simulation
+    struct SimulateBundleResult {
+        bool success;
+        string error;
+        uint64 gasUsed;
+        uint256 coinbaseProfit;
+        uint64 effectiveGasPrice;
+        SimulatedLog[] logs;
+    }
+
     /// @notice Result of a simulated transaction.
     /// @param egp Effective Gas Price of the transaction
     /// @param logs Logs emitted during the simulation
     /// @param success Whether the transaction was successful or not
     /// @param error Error message if any
+    /// @param gasUsed Gas used by the transaction
+    /// @param coinbasePayment Direct transfers to the coinbase of the block, the transaction fees are not included
+    /// @param revertReason Decoded revert reason if the transaction reverted
+    /// @param touchedSlots Storage slots accessed during the simulation
     struct SimulateTransactionResult {
         uint64 egp;
         SimulatedLog[] logs;
         bool success;
         string error;
+        uint64 gasUsed;
+        uint256 coinbasePayment;
+        string revertReason;
+        SimulatedStorageAccess[] touchedSlots;
     }
 
     /// @notice A log emitted during the simulation of a transaction.
@@ -102,6 +315,44 @@ library Suave {
         bytes32[] topics;
     }
 
+    /// @notice Storage slots of a contract accessed during the simulation of a transaction.
+    /// @param addr Address of the contract
+    /// @param slots Storage slots accessed
+    struct SimulatedStorageAccess {
+        address addr;
+        bytes32[] slots;
+    }
+
+    /// @notice Hint of a transaction of a bundle. The fields not selected by the hint policy are left empty.
+    /// @param hash Hash of the transaction
+    /// @param to Recipient of the transaction
+    /// @param value Value transferred by the transaction
+    /// @param functionSelector First four bytes of the calldata
+    /// @param callData Calldata of the transaction
+    struct TxHint {
+        bytes32 hash;
+        address to;
+        uint256 value;
+        bytes4 functionSelector;
+        bytes callData;
+    }
+
+    /// @notice Validator registered in a relay which proposes in an upcoming slot.
+    /// @param slot Slot of the proposal
+    /// @param validatorIndex Index of the validator
+    /// @param pubkey Public key of the validator
+    /// @param feeRecipient Fee recipient registered by the validator
+    /// @param gasLimit Gas limit registered by the validator
+    /// @param timestamp Timestamp of the registration
+    struct ValidatorDuty {
+        uint64 slot;
+        uint64 validatorIndex;
+        bytes pubkey;
+        address feeRecipient;
+        uint64 gasLimit;
+        uint64 timestamp;
+    }
+
     /// @notice A withdrawal from the beacon chain.
     /// @param index Index of the withdrawal
     /// @param validator ID of the validator
@@ -142,12 +393,34 @@ library Suave {
 
     address public constant EXTRACT_HINT = 0x0000000000000000000000000000000042100037;
 
+    address public constant EXTRACT_HINTS = 0x000000000000000000000000000000004210000b;
+
     address public constant FETCH_DATA_RECORDS = 0x0000000000000000000000000000000042030001;
 
     address public constant FILL_MEV_SHARE_BUNDLE = 0x0000000000000000000000000000000043200001;
 
+    address public constant GET_BALANCE = 0x000000000000000000000000000000004210000D;
+
+    address public constant GET_BEACON_CONTEXT = 0x000000000000000000000000000000004210000A;
+
+    address public constant GET_BLOCK_HEADER = 0x0000000000000000000000000000000042100010;
+
+    address public constant GET_CODE = 0x000000000000000000000000000000004210000e;
+
     address public constant GET_INSECURE_TIME = 0x000000000000000000000000000000007770000c;
 
+    address public constant GET_NONCE = 0x000000000000000000000000000000004210000c;
+
+    address public constant GET_RELAY_BID_TRACES = 0x0000000000000000000000000000000042100008;
+
+    address public constant GET_RELAY_DELIVERED_PAYLOADS = 0x0000000000000000000000000000000042100009;
+
+    address public constant GET_RELAY_VALIDATORS = 0x0000000000000000000000000000000042100007;
+
+    address public constant GET_STORAGE_AT = 0x000000000000000000000000000000004210000f;
+
+    address public constant GET_TRANSACTION_RECEIPT = 0x0000000000000000000000000000000042100011;
+
     address public constant NEW_BUILDER = 0x0000000000000000000000000000000053200001;
 
     address public constant NEW_DATA_RECORD = 0x0000000000000000000000000000000042030000;
@@ -158,16 +431,22 @@ library Suave {
 
     address public constant SIGN_ETH_TRANSACTION = 0x0000000000000000000000000000000040100001;
 
+    address public constant SIGN_ETH_TYPED_TRANSACTION = 0x0000000000000000000000000000000040100002;
+
     address public constant SIGN_MESSAGE = 0x0000000000000000000000000000000040100003;
 
     address public constant SIMULATE_BUNDLE = 0x0000000000000000000000000000000042100000;
 
+    address public constant SIMULATE_BUNDLE_WITH_ARGS = 0x0000000000000000000000000000000042100004;
+
     address public constant SIMULATE_TRANSACTION = 0x0000000000000000000000000000000053200002;
 
     address public constant SUBMIT_BUNDLE_JSON_RPC = 0x0000000000000000000000000000000043000001;
 
     address public constant SUBMIT_ETH_BLOCK_TO_RELAY = 0x0000000000000000000000000000000042100002;
 
+    address public constant SUBMIT_ETH_BLOCK_TO_RELAYS = 0x0000000000000000000000000000000042100005;
+
     /// @notice Returns whether execution is off- or on-chain
     /// @return b Whether execution is off- or on-chain
     function isConfidential() internal returns (bool b) {
@@ -287,6 +566,7 @@ library Suave {
     /// @notice Retrieves a value from the context
     /// @param key Key of the value to retrieve
     /// @return value Value of the key
+    /// @custom:error ContextValueNotFound wrapped in PeekerReverted
     function contextGet(string memory key) internal returns (bytes memory) {
         (bool success, bytes memory data) = CONTEXT_GET.call(abi.encode(key));
         if (!success) {
@@ -299,6 +579,8 @@ library Suave {
     /// @notice Performs an HTTP request and returns the response. `request` is the request to perform.
     /// @param request Request to perform
     /// @return httpResponse Body of the response
+    /// @custom:error DomainNotAllowed wrapped in PeekerReverted
+    /// @custom:error HttpRequestFailed wrapped in PeekerReverted
     function doHTTPRequest(HttpRequest memory request) internal returns (bytes memory) {
         (bool success, bytes memory data) = DO_HTTPREQUEST.call(abi.encode(request));
         if (!success) {
@@ -311,6 +593,7 @@ library Suave {
     /// @notice Performs an HTTP request and returns the response. `request` is the request to perform.
     /// @param request Request to perform
     /// @return httpResponse Response of the request
+    /// @custom:error DomainNotAllowed wrapped in PeekerReverted
     function doHTTPRequest2(HttpRequest memory request) internal returns (HttpResponse memory) {
         (bool success, bytes memory data) = DO_HTTPREQUEST2.call(abi.encode(request));
         if (!success) {
@@ -333,9 +616,10 @@ library Suave {
         return abi.decode(data, (bytes));
     }
 
-    /// @notice Interprets the bundle data and extracts hints, such as the `To` address and calldata.
+    /// @notice Interprets the bundle data and extracts the `To` address and calldata of its first transaction. Use `extractHints` to select the hints.
     /// @param bundleData Bundle object encoded in JSON
     /// @return hints List of hints encoded in JSON
+    /// @custom:deprecated Use extractHints, which selects the hints with a policy.
     function extractHint(bytes memory bundleData) internal returns (bytes memory) {
         require(isConfidential());
         (bool success, bytes memory data) = EXTRACT_HINT.call(abi.encode(bundleData));
@@ -346,6 +630,20 @@ library Suave {
         return data;
     }
 
+    /// @notice Extracts the hints of the bundle selected by the policy. The policy is a bitmask of: 1 contract address, 2 function selector, 4 calldata, 8 logs of the simulation on top of the latest block, 16 transaction hash, 32 value.
+    /// @param bundleData Bundle object encoded in JSON
+    /// @param policy Bitmask of the hints to extract
+    /// @return hint Hints of the bundle
+    function extractHints(bytes memory bundleData, uint64 policy) internal returns (BundleHint memory) {
+        require(isConfidential());
+        (bool success, bytes memory data) = EXTRACT_HINTS.call(abi.encode(bundleData, policy));
+        if (!success) {
+            revert PeekerReverted(EXTRACT_HINTS, data);
+        }
+
+        return abi.decode(data, (BundleHint));
+    }
+
     /// @notice Retrieves all data records correlating with a specified decryption condition and namespace
     /// @param cond Filter for the decryption condition
     /// @param namespace Filter for the namespace of the data records
@@ -372,6 +670,58 @@ library Suave {
         return data;
     }
 
+    /// @notice Returns the balance of an account in the latest state of the execution node.
+    /// @param account Address of the account
+    /// @return balance Balance of the account in wei
+    function getBalance(address account) internal returns (uint256) {
+        require(isConfidential());
+        (bool success, bytes memory data) = GET_BALANCE.call(abi.encode(account));
+        if (!success) {
+            revert PeekerReverted(GET_BALANCE, data);
+        }
+
+        return abi.decode(data, (uint256));
+    }
+
+    /// @notice Returns the proposer, randao, withdrawals and parent roots of the slot after the beacon head, as followed by the kettle from its beacon node.
+    /// @param slot Slot after the beacon head to build the block for
+    /// @return beaconContext Beacon chain data of the slot
+    function getBeaconContext(uint64 slot) internal returns (BeaconContext memory) {
+        require(isConfidential());
+        (bool success, bytes memory data) = GET_BEACON_CONTEXT.call(abi.encode(slot));
+        if (!success) {
+            revert PeekerReverted(GET_BEACON_CONTEXT, data);
+        }
+
+        return abi.decode(data, (BeaconContext));
+    }
+
+    /// @notice Returns the header of a block of the execution node.
+    /// @param number Number of the block, type(uint64).max for the latest block
+    /// @return header Header of the block
+    function getBlockHeader(uint64 number) internal returns (EthBlockHeader memory) {
+        require(isConfidential());
+        (bool success, bytes memory data) = GET_BLOCK_HEADER.call(abi.encode(number));
+        if (!success) {
+            revert PeekerReverted(GET_BLOCK_HEADER, data);
+        }
+
+        return abi.decode(data, (EthBlockHeader));
+    }
+
+    /// @notice Returns the code of an account in the latest state of the execution node.
+    /// @param account Address of the account
+    /// @return code Code of the account, empty if the account is not a contract
+    function getCode(address account) internal returns (bytes memory) {
+        require(isConfidential());
+        (bool success, bytes memory data) = GET_CODE.call(abi.encode(account));
+        if (!success) {
+            revert PeekerReverted(GET_CODE, data);
+        }
+
+        return abi.decode(data, (bytes));
+    }
+
     /// @notice Returns the current Kettle Unix time in milliseconds. Insecure because it assumes trust in Kettle's clock.
     /// @return time Current Unix time in milliseconds
     function getInsecureTime() internal returns (uint256) {
@@ -383,6 +733,87 @@ library Suave {
         return abi.decode(data, (uint256));
     }
 
+    /// @notice Returns the pending nonce of an account in the execution node, counting its transactions in the pool.
+    /// @param account Address of the account
+    /// @return nonce Nonce of the account
+    function getNonce(address account) internal returns (uint64) {
+        require(isConfidential());
+        (bool success, bytes memory data) = GET_NONCE.call(abi.encode(account));
+        if (!success) {
+            revert PeekerReverted(GET_NONCE, data);
+        }
+
+        return abi.decode(data, (uint64));
+    }
+
+    /// @notice Returns the bids received by a relay for a slot sorted by descending value, the first one being the top bid.
+    /// @param relayUrl URL (or service name) of the relay
+    /// @param slot Slot of the bids
+    /// @return bids Bids received for the slot
+    function getRelayBidTraces(string memory relayUrl, uint64 slot) internal returns (RelayBidTrace[] memory) {
+        require(isConfidential());
+        (bool success, bytes memory data) = GET_RELAY_BID_TRACES.call(abi.encode(relayUrl, slot));
+        if (!success) {
+            revert PeekerReverted(GET_RELAY_BID_TRACES, data);
+        }
+
+        return abi.decode(data, (RelayBidTrace[]));
+    }
+
+    /// @notice Returns the payloads delivered by a relay to the proposer of a slot.
+    /// @param relayUrl URL (or service name) of the relay
+    /// @param slot Slot of the payloads
+    /// @return payloads Payloads delivered for the slot
+    function getRelayDeliveredPayloads(string memory relayUrl, uint64 slot) internal returns (RelayBidTrace[] memory) {
+        require(isConfidential());
+        (bool success, bytes memory data) = GET_RELAY_DELIVERED_PAYLOADS.call(abi.encode(relayUrl, slot));
+        if (!success) {
+            revert PeekerReverted(GET_RELAY_DELIVERED_PAYLOADS, data);
+        }
+
+        return abi.decode(data, (RelayBidTrace[]));
+    }
+
+    /// @notice Returns the proposer duties of the current and next epoch registered in a relay.
+    /// @param relayUrl URL (or service name) of the relay
+    /// @return duties Duties of the validators registered in the relay
+    function getRelayValidators(string memory relayUrl) internal returns (ValidatorDuty[] memory) {
+        require(isConfidential());
+        (bool success, bytes memory data) = GET_RELAY_VALIDATORS.call(abi.encode(relayUrl));
+        if (!success) {
+            revert PeekerReverted(GET_RELAY_VALIDATORS, data);
+        }
+
+        return abi.decode(data, (ValidatorDuty[]));
+    }
+
+    /// @notice Returns the value of a storage slot of an account in the latest state of the execution node.
+    /// @param account Address of the account
+    /// @param slot Storage slot to read
+    /// @return value Value of the storage slot
+    function getStorageAt(address account, bytes32 slot) internal returns (bytes32) {
+        require(isConfidential());
+        (bool success, bytes memory data) = GET_STORAGE_AT.call(abi.encode(account, slot));
+        if (!success) {
+            revert PeekerReverted(GET_STORAGE_AT, data);
+        }
+
+        return abi.decode(data, (bytes32));
+    }
+
+    /// @notice Returns the receipt of a transaction included by the execution node. Reverts if the transaction is not included.
+    /// @param txHash Hash of the transaction
+    /// @return receipt Receipt of the transaction
+    function getTransactionReceipt(bytes32 txHash) internal returns (EthReceipt memory) {
+        require(isConfidential());
+        (bool success, bytes memory data) = GET_TRANSACTION_RECEIPT.call(abi.encode(txHash));
+        if (!success) {
+            revert PeekerReverted(GET_TRANSACTION_RECEIPT, data);
+        }
+
+        return abi.decode(data, (EthReceipt));
+    }
+
     /// @notice Initializes a new remote builder session
     /// @return sessionid ID of the remote builder session
     function newBuilder() internal returns (string memory) {
@@ -456,6 +887,24 @@ library Suave {
         return abi.decode(data, (bytes));
     }
 
+    /// @notice Builds a transaction of any type from its fields, signs it for the chain of the transaction and returns the raw signed transaction bytes and its hash.
+    /// @param txn Transaction to sign
+    /// @param signingKey Hex encoded string of the ECDSA private key (without 0x prefix)
+    /// @return signedTxn Signed transaction in its binary encoding
+    /// @return txHash Hash of the signed transaction
+    function signEthTypedTransaction(EthTransaction memory txn, string memory signingKey)
+        internal
+        returns (bytes memory, bytes32)
+    {
+        require(isConfidential());
+        (bool success, bytes memory data) = SIGN_ETH_TYPED_TRANSACTION.call(abi.encode(txn, signingKey));
+        if (!success) {
+            revert PeekerReverted(SIGN_ETH_TYPED_TRANSACTION, data);
+        }
+
+        return abi.decode(data, (bytes, bytes32));
+    }
+
     /// @notice Signs a message and returns the signature.
     /// @param digest Message to sign
     /// @param crypto Type of the private key to generate
@@ -474,7 +923,7 @@ library Suave {
         return abi.decode(data, (bytes));
     }
 
-    /// @notice Performs a simulation of the bundle by building a block that includes it.
+    /// @notice Performs a simulation of the bundle on top of the latest block and returns its effective gas price. Reverts if the bundle cannot be included.
     /// @param bundleData Bundle encoded in JSON
     /// @return effectiveGasPrice Effective Gas Price of the resultant block
     function simulateBundle(bytes memory bundleData) internal returns (uint64) {
@@ -486,6 +935,22 @@ library Suave {
         return abi.decode(data, (uint64));
     }
 
+    /// @notice Simulates a bundle on top of a block built with the given arguments. Honors the block range and the reverting hashes of the bundle.
+    /// @param blockArgs Arguments of the block to simulate the bundle in
+    /// @param bundleData Bundle encoded in JSON
+    /// @return simulationResult Result of the simulation
+    function simulateBundleWithArgs(BuildBlockArgs memory blockArgs, bytes memory bundleData)
+        internal
+        returns (SimulateBundleResult memory)
+    {
+        (bool success, bytes memory data) = SIMULATE_BUNDLE_WITH_ARGS.call(abi.encode(blockArgs, bundleData));
+        if (!success) {
+            revert PeekerReverted(SIMULATE_BUNDLE_WITH_ARGS, data);
+        }
+
+        return abi.decode(data, (SimulateBundleResult));
+    }
+
     /// @notice Simulates a transaction on a remote builder session
     /// @param sessionid ID of the remote builder session
     /// @param txn Txn to simulate encoded in RLP
@@ -533,4 +998,23 @@ library Suave {
 
         return data;
     }
+
+    /// @notice Submits a given builderBid to multiple mev-boost relays concurrently, retrying transient failures until the deadline.
+    /// @param relayUrls URLs (or service names) of the relays to submit to
+    /// @param builderBid Block bid to submit encoded in JSON
+    /// @param options Options of the submission
+    /// @return results Result of the submission to each relay, in the order of the relays
+    function submitEthBlockToRelays(
+        string[] memory relayUrls,
+        bytes memory builderBid,
+        RelaySubmissionOptions memory options
+    ) internal returns (RelaySubmissionResult[] memory) {
+        require(isConfidential());
+        (bool success, bytes memory data) = SUBMIT_ETH_BLOCK_TO_RELAYS.call(abi.encode(relayUrls, builderBid, options));
+        if (!success) {
+            revert PeekerReverted(SUBMIT_ETH_BLOCK_TO_RELAYS, data);
+        }
+
+        return abi.decode(data, (RelaySubmissionResult[]));
+    }
 }